@@ -91,9 +91,33 @@ func blanketAuthorization(ctx context.Context, req interface{}, _ *grpc.UnarySer
 	return handler(ctx, req)
 }
 
+// Registers HTTP handlers which, like the gRPC endpoints guarded by blanketAuthorization, require an authenticated
+// identity with the 'all' scope.
+type authorizedHandlerRegisterer struct {
+	ctx     context.Context
+	mux     *http.ServeMux
+	authCtx interfaces.AuthenticationContext
+}
+
+func (r *authorizedHandlerRegisterer) HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request)) {
+	r.mux.HandleFunc(pattern, func(writer http.ResponseWriter, request *http.Request) {
+		identityContext, err := auth.IdentityContextFromRequest(r.ctx, request, r.authCtx)
+		if err != nil {
+			logger.Infof(r.ctx, "Failed to authenticate request to [%s] with err: %v", request.URL.Path, err)
+			http.Error(writer, "unauthenticated request", http.StatusUnauthorized)
+			return
+		}
+		if !identityContext.Scopes().Has(auth.ScopeAll) {
+			http.Error(writer, "authenticated user doesn't have required scope", http.StatusForbidden)
+			return
+		}
+		handler(writer, request.WithContext(auth.SetContextForIdentity(request.Context(), identityContext)))
+	})
+}
+
 // Creates a new gRPC Server with all the configuration
 func newGRPCServer(ctx context.Context, cfg *config.ServerConfig, authCtx interfaces.AuthenticationContext,
-	opts ...grpc.ServerOption) (*grpc.Server, error) {
+	adminServer *adminservice.AdminService, opts ...grpc.ServerOption) (*grpc.Server, error) {
 	// Not yet implemented for streaming
 	var chainedUnaryInterceptors grpc.UnaryServerInterceptor
 	if cfg.Security.UseAuth {
@@ -116,7 +140,7 @@ func newGRPCServer(ctx context.Context, cfg *config.ServerConfig, authCtx interf
 	serverOpts = append(serverOpts, opts...)
 	grpcServer := grpc.NewServer(serverOpts...)
 	grpcPrometheus.Register(grpcServer)
-	flyteService.RegisterAdminServiceServer(grpcServer, adminservice.NewAdminServer(cfg.KubeConfig, cfg.Master))
+	flyteService.RegisterAdminServiceServer(grpcServer, adminServer)
 	if cfg.Security.UseAuth {
 		flyteService.RegisterAuthMetadataServiceServer(grpcServer, authCtx.AuthMetadataService())
 		flyteService.RegisterIdentityServiceServer(grpcServer, authCtx.IdentityService())
@@ -152,7 +176,7 @@ func healthCheckFunc(w http.ResponseWriter, r *http.Request) {
 }
 
 func newHTTPServer(ctx context.Context, cfg *config.ServerConfig, authCfg *authConfig.Config, authCtx interfaces.AuthenticationContext,
-	grpcAddress string, grpcConnectionOpts ...grpc.DialOption) (*http.ServeMux, error) {
+	adminServer *adminservice.AdminService, grpcAddress string, grpcConnectionOpts ...grpc.DialOption) (*http.ServeMux, error) {
 
 	// Register the server that will serve HTTP/REST Traffic
 	mux := http.NewServeMux()
@@ -164,6 +188,13 @@ func newHTTPServer(ctx context.Context, cfg *config.ServerConfig, authCfg *authC
 	// This endpoint will serve the OpenAPI2 spec generated by the swagger protoc plugin, and bundled by go-bindata
 	mux.HandleFunc("/api/v1/openapi", GetHandleOpenapiSpec(ctx))
 
+	// Register the admin endpoints which aren't served through the grpc-gateway
+	if cfg.Security.UseAuth {
+		adminServer.RegisterHTTPHandlers(&authorizedHandlerRegisterer{ctx: ctx, mux: mux, authCtx: authCtx})
+	} else {
+		adminServer.RegisterHTTPHandlers(mux)
+	}
+
 	var gwmuxOptions = make([]runtime.ServeMuxOption, 0)
 	// This option means that http requests are served with protobufs, instead of json. We always want this.
 	gwmuxOptions = append(gwmuxOptions, runtime.WithMarshalerOption("application/octet-stream", &runtime.ProtoMarshaller{}))
@@ -246,7 +277,8 @@ func serveGatewayInsecure(ctx context.Context, cfg *config.ServerConfig, authCfg
 		}
 	}
 
-	grpcServer, err := newGRPCServer(ctx, cfg, authCtx)
+	adminServer := adminservice.NewAdminServer(cfg.KubeConfig, cfg.Master)
+	grpcServer, err := newGRPCServer(ctx, cfg, authCtx, adminServer)
 	if err != nil {
 		return errors.Wrap(err, "failed to create GRPC server")
 	}
@@ -263,7 +295,7 @@ func serveGatewayInsecure(ctx context.Context, cfg *config.ServerConfig, authCfg
 	}()
 
 	logger.Infof(ctx, "Starting HTTP/1 Gateway server on %s", cfg.GetHostAddress())
-	httpServer, err := newHTTPServer(ctx, cfg, authCfg, authCtx, cfg.GetGrpcHostAddress(), grpc.WithInsecure(),
+	httpServer, err := newHTTPServer(ctx, cfg, authCfg, authCtx, adminServer, cfg.GetGrpcHostAddress(), grpc.WithInsecure(),
 		grpc.WithMaxHeaderListSize(common.MaxResponseStatusBytes))
 	if err != nil {
 		return err
@@ -340,7 +372,8 @@ func serveGatewaySecure(ctx context.Context, cfg *config.ServerConfig, authCfg *
 		}
 	}
 
-	grpcServer, err := newGRPCServer(ctx, cfg, authCtx,
+	adminServer := adminservice.NewAdminServer(cfg.KubeConfig, cfg.Master)
+	grpcServer, err := newGRPCServer(ctx, cfg, authCtx, adminServer,
 		grpc.Creds(credentials.NewServerTLSFromCert(cert)))
 	if err != nil {
 		return errors.Wrap(err, "failed to create GRPC server")
@@ -351,7 +384,7 @@ func serveGatewaySecure(ctx context.Context, cfg *config.ServerConfig, authCfg *
 		ServerName: cfg.GetHostAddress(),
 		RootCAs:    certPool,
 	})
-	httpServer, err := newHTTPServer(ctx, cfg, authCfg, authCtx, cfg.GetHostAddress(), grpc.WithTransportCredentials(dialCreds))
+	httpServer, err := newHTTPServer(ctx, cfg, authCfg, authCtx, adminServer, cfg.GetHostAddress(), grpc.WithTransportCredentials(dialCreds))
 	if err != nil {
 		return err
 	}
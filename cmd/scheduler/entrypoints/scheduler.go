@@ -8,7 +8,9 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	repositoryCommonConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
 	"github.com/flyteorg/flyteadmin/pkg/runtime"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyteadmin/scheduler"
+	schedulerCore "github.com/flyteorg/flyteadmin/scheduler/core"
 	schdulerRepoConfig "github.com/flyteorg/flyteadmin/scheduler/repositories"
 	"github.com/flyteorg/flyteidl/clients/go/admin"
 	"github.com/flyteorg/flytestdlib/contextutils"
@@ -16,6 +18,7 @@ import (
 	"github.com/flyteorg/flytestdlib/profutils"
 	"github.com/flyteorg/flytestdlib/promutils"
 	"github.com/flyteorg/flytestdlib/promutils/labeled"
+	"github.com/flyteorg/flytestdlib/storage"
 
 	_ "github.com/jinzhu/gorm/dialects/postgres" // Required to import database driver.
 	"github.com/spf13/cobra"
@@ -54,8 +57,32 @@ var schedulerRunCmd = &cobra.Command{
 		}
 		adminServiceClient := clientSet.AdminClient()
 
+		eventSchedulerConfig := schedulerConfiguration.GetEventSchedulerConfig()
+		flyteSchedulerConfig := eventSchedulerConfig.GetFlyteSchedulerConfig()
+		var storageLister schedulerCore.StorageLister
+		if flyteSchedulerConfig != nil && flyteSchedulerConfig.GetStorageTriggers().Enabled {
+			storageLister, err = schedulerCore.NewStowStorageLister(storage.GetConfig())
+			if err != nil {
+				logger.Fatalf(ctx, "Flyte native scheduler failed to set up the storage triggers due to %v", err)
+				return err
+			}
+		}
+		var scheduleUpdateListener schedulerCore.ScheduleUpdateListener
+		if flyteSchedulerConfig != nil {
+			switch notifier := flyteSchedulerConfig.GetScheduleUpdates().Notifier; notifier {
+			case "":
+			case runtimeInterfaces.ScheduleUpdatesNotifierPostgres:
+				scheduleUpdateListener = schedulerCore.NewPostgresScheduleUpdateListener(
+					repositoryCommonConfig.NewPostgresConfigProvider(dbConfig, schedulerScope).GetArgs())
+			default:
+				err = fmt.Errorf("unsupported schedule update notifier [%s]", notifier)
+				logger.Fatalf(ctx, "Flyte native scheduler failed to set up the schedule updates due to %v", err)
+				return err
+			}
+		}
 		scheduleExecutor := scheduler.NewScheduledExecutor(db,
-			configuration.ApplicationConfiguration().GetSchedulerConfig().GetWorkflowExecutorConfig(), schedulerScope, adminServiceClient)
+			schedulerConfiguration.GetWorkflowExecutorConfig(), flyteSchedulerConfig,
+			schedulerScope, adminServiceClient, storageLister, scheduleUpdateListener)
 
 		logger.Info(ctx, "Successfully initialized a native flyte scheduler")
 
@@ -9,6 +9,7 @@ import (
 
 	"github.com/flyteorg/flyteadmin/pkg/async/notifications/implementations"
 	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
 	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flytestdlib/logger"
 
@@ -74,7 +75,35 @@ func GetEmailer(config runtimeInterfaces.NotificationsConfig, scope promutils.Sc
 	}
 }
 
-func NewNotificationsProcessor(config runtimeInterfaces.NotificationsConfig, scope promutils.Scope) interfaces.Processor {
+// Returns the emailer used by notifications processors, which records delivery attempts when the delivery log is
+// enabled.
+func getProcessorEmailer(config runtimeInterfaces.NotificationsConfig, db repositories.RepositoryInterface,
+	scope promutils.Scope) interfaces.Emailer {
+	emailer := GetEmailer(config, scope)
+	if !config.DeliveryLogConfig.Enabled {
+		return emailer
+	}
+	return implementations.NewDeliveryLogEmailer(emailer, db.NotificationDeliveryRepo(), config.DeliveryLogConfig, scope)
+}
+
+// Returns the webhook sender used by notifications processors. The delivery log, when enabled, records every call and
+// decides which failed calls are redelivered. Otherwise only queues which count delivery attempts themselves redeliver
+// failed calls.
+func getProcessorWebhookSender(config runtimeInterfaces.NotificationsConfig, db repositories.RepositoryInterface,
+	queueCountsAttempts bool, scope promutils.Scope) interfaces.WebhookSender {
+	sender := implementations.NewHTTPWebhookSender(config.Webhooks, config.LaunchPlanWebhooks, scope)
+	if config.DeliveryLogConfig.Enabled {
+		return implementations.NewDeliveryLogWebhookSender(sender, db.NotificationDeliveryRepo(),
+			config.DeliveryLogConfig, scope)
+	}
+	if !queueCountsAttempts {
+		return implementations.NewSingleAttemptWebhookSender(sender)
+	}
+	return sender
+}
+
+func NewNotificationsProcessor(config runtimeInterfaces.NotificationsConfig, db repositories.RepositoryInterface,
+	scope promutils.Scope) interfaces.Processor {
 	reconnectAttempts := config.ReconnectAttempts
 	reconnectDelay := time.Duration(config.ReconnectDelaySeconds) * time.Second
 	var sub pubsub.Subscriber
@@ -102,8 +131,8 @@ func NewNotificationsProcessor(config runtimeInterfaces.NotificationsConfig, sco
 		if err != nil {
 			panic(err)
 		}
-		emailer = GetEmailer(config, scope)
-		return implementations.NewProcessor(sub, emailer, scope)
+		emailer = getProcessorEmailer(config, db, scope)
+		return implementations.NewProcessor(sub, emailer, getProcessorWebhookSender(config, db, false, scope), scope)
 	case common.GCP:
 		projectID := config.GCPConfig.ProjectID
 		subscription := config.NotificationsProcessorConfig.QueueName
@@ -118,9 +147,16 @@ func NewNotificationsProcessor(config runtimeInterfaces.NotificationsConfig, sco
 		if err != nil {
 			panic(err)
 		}
-		emailer = GetEmailer(config, scope)
-		return implementations.NewGcpProcessor(sub, emailer, scope)
+		emailer = getProcessorEmailer(config, db, scope)
+		return implementations.NewGcpProcessor(sub, emailer, getProcessorWebhookSender(config, db, false, scope),
+			scope)
 	case common.Local:
+		if config.DBQueueConfig.Enabled {
+			emailer = getProcessorEmailer(config, db, scope)
+			return implementations.NewDBQueueProcessor(db.NotificationQueueRepo(), emailer,
+				getProcessorWebhookSender(config, db, true, scope), config.DBQueueConfig, config.DeliveryLogConfig.Enabled,
+				scope)
+		}
 		fallthrough
 	default:
 		logger.Infof(context.Background(),
@@ -129,7 +165,8 @@ func NewNotificationsProcessor(config runtimeInterfaces.NotificationsConfig, sco
 	}
 }
 
-func NewNotificationsPublisher(config runtimeInterfaces.NotificationsConfig, scope promutils.Scope) interfaces.Publisher {
+func NewNotificationsPublisher(config runtimeInterfaces.NotificationsConfig, db repositories.RepositoryInterface,
+	scope promutils.Scope) interfaces.Publisher {
 	reconnectAttempts := config.ReconnectAttempts
 	reconnectDelay := time.Duration(config.ReconnectDelaySeconds) * time.Second
 	switch config.Type {
@@ -172,6 +209,9 @@ func NewNotificationsPublisher(config runtimeInterfaces.NotificationsConfig, sco
 		}
 		return implementations.NewPublisher(publisher, scope)
 	case common.Local:
+		if config.DBQueueConfig.Enabled {
+			return implementations.NewDBQueuePublisher(db.NotificationQueueRepo(), scope)
+		}
 		fallthrough
 	default:
 		logger.Infof(context.Background(),
@@ -180,6 +220,16 @@ func NewNotificationsPublisher(config runtimeInterfaces.NotificationsConfig, sco
 	}
 }
 
+// NewDeliveryLogPublisher wraps a notifications publisher to record the delivery of every published notification when
+// the delivery log is enabled.
+func NewDeliveryLogPublisher(config runtimeInterfaces.NotificationsConfig, db repositories.RepositoryInterface,
+	publisher interfaces.Publisher, scope promutils.Scope) interfaces.Publisher {
+	if !config.DeliveryLogConfig.Enabled {
+		return publisher
+	}
+	return implementations.NewDeliveryLogPublisher(publisher, db.NotificationDeliveryRepo(), scope)
+}
+
 func NewEventsPublisher(config runtimeInterfaces.ExternalEventsConfig, scope promutils.Scope) interfaces.Publisher {
 	if !config.Enable {
 		return implementations.NewNoopPublish()
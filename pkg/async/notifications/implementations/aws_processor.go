@@ -19,6 +19,7 @@ import (
 type Processor struct {
 	sub           pubsub.Subscriber
 	email         interfaces.Emailer
+	webhook       interfaces.WebhookSender
 	systemMetrics processorSystemMetrics
 }
 
@@ -82,6 +83,16 @@ func (p *Processor) run() error {
 			continue
 		}
 
+		// The SNS subject is the key the notification was published with.
+		if notificationType, _ := snsJSONFormat["Subject"].(string); notificationType == interfaces.WebhookNotificationType {
+			if processWebhookMessage(context.Background(), p.webhook, notificationBytes, p.systemMetrics) {
+				// Leave the message on the queue so that it's redelivered once its visibility timeout expires.
+				continue
+			}
+			p.markMessageDone(msg)
+			continue
+		}
+
 		if err = proto.Unmarshal(notificationBytes, &emailMessage); err != nil {
 			logger.Debugf(context.Background(), "failed to unmarshal to notification object from decoded string[%s] from message [%s] with err: %v", valueString, stringMsg, err)
 			p.systemMetrics.MessageDecodingError.Inc()
@@ -92,6 +103,10 @@ func (p *Processor) run() error {
 		if err = p.email.SendEmail(context.Background(), emailMessage); err != nil {
 			p.systemMetrics.MessageProcessorError.Inc()
 			logger.Errorf(context.Background(), "Error sending an email message for message [%s] with emailM with err: %v", emailMessage.String(), err)
+			if IsRetryableDeliveryError(err) {
+				// Leave the message on the queue so that it's redelivered once its visibility timeout expires.
+				continue
+			}
 		} else {
 			p.systemMetrics.MessageSuccess.Inc()
 		}
@@ -129,10 +144,12 @@ func (p *Processor) StopProcessing() error {
 	return err
 }
 
-func NewProcessor(sub pubsub.Subscriber, emailer interfaces.Emailer, scope promutils.Scope) interfaces.Processor {
+func NewProcessor(sub pubsub.Subscriber, emailer interfaces.Emailer, webhookSender interfaces.WebhookSender,
+	scope promutils.Scope) interfaces.Processor {
 	return &Processor{
 		sub:           sub,
 		email:         emailer,
+		webhook:       webhookSender,
 		systemMetrics: newProcessorSystemMetrics(scope.NewSubScope("processor")),
 	}
 }
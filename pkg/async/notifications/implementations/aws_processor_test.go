@@ -10,7 +10,10 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/async/notifications/mocks"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -138,3 +141,34 @@ func TestProcessor_StopProcessingError(t *testing.T) {
 	testSubscriber.GivenStopError = stopError
 	assert.Equal(t, stopError, testProcessor.StopProcessing())
 }
+
+func TestProcessor_StartProcessingWebhook(t *testing.T) {
+	initializeProcessor()
+	webhookMessage := interfaces.WebhookMessage{
+		Webhook: "webhook",
+		Body:    `{"name": "execution"}`,
+	}
+	message, err := proto.Marshal(MarshalWebhookMessage(webhookMessage))
+	assert.NoError(t, err)
+	testSubscriber.JSONMessages = append(testSubscriber.JSONMessages, map[string]interface{}{
+		"Type":    "Notification",
+		"Subject": interfaces.WebhookNotificationType,
+		"Message": aws.String(base64.StdEncoding.EncodeToString(message)),
+	})
+
+	var called bool
+	webhookSender := mocks.MockWebhookSender{}
+	webhookSender.SetSendWebhookFunc(func(ctx context.Context, message interfaces.WebhookMessage) error {
+		called = true
+		assert.Equal(t, webhookMessage, message)
+		return nil
+	})
+	emailer := mocks.MockEmailer{}
+	emailer.SetSendEmailFunc(func(ctx context.Context, email admin.EmailMessage) error {
+		t.Fatal("webhook messages shouldn't be sent as emails")
+		return nil
+	})
+	processor := NewProcessor(mockSub, &emailer, &webhookSender, promutils.NewTestScope())
+	assert.Nil(t, processor.(*Processor).run())
+	assert.True(t, called)
+}
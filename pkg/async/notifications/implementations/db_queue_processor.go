@@ -0,0 +1,185 @@
+package implementations
+
+import (
+	"context"
+
+	"github.com/benbjohnson/clock"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type dbQueueProcessorMetrics struct {
+	processorSystemMetrics
+	ClaimError        prometheus.Counter
+	MessageRetry      prometheus.Counter
+	MessageDeadLetter prometheus.Counter
+}
+
+// DBQueueProcessor delivers the notifications enqueued by a DBQueuePublisher.
+// Notifications which fail to deliver are retried once their visibility timeout passes, up to the configured number of
+// attempts, after which they are moved to the dead-letter state. When the delivery log is enabled it decides which
+// failed notifications are retried instead, and keeps the dead-lettered ones, which are removed from the queue.
+type DBQueueProcessor struct {
+	repo          repoInterfaces.NotificationQueueRepoInterface
+	email         interfaces.Emailer
+	webhook       interfaces.WebhookSender
+	config        runtimeInterfaces.NotificationsDBQueueConfig
+	deliveryLog   bool
+	clock         clock.Clock
+	stop          chan struct{}
+	systemMetrics dbQueueProcessorMetrics
+}
+
+func (p *DBQueueProcessor) StartProcessing() {
+	logger.Infof(context.Background(), "Starting database queue notifications processor with poll interval [%v]",
+		p.config.PollInterval.Duration)
+	ticker := p.clock.Ticker(p.config.PollInterval.Duration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			p.run(context.Background())
+		}
+	}
+}
+
+// Claims and processes batches of notifications until the queue has no more visible items.
+func (p *DBQueueProcessor) run(ctx context.Context) {
+	for {
+		items, err := p.repo.Claim(ctx, repoInterfaces.ClaimNotificationsInput{
+			Now:               p.clock.Now(),
+			VisibilityTimeout: p.config.VisibilityTimeout.Duration,
+			Limit:             p.config.BatchSize,
+			// The queue is shared with launch plan trigger firings, which are processed separately.
+			NotificationTypes: []string{proto.MessageName(&admin.EmailNotification{}), interfaces.WebhookNotificationType},
+		})
+		if err != nil {
+			p.systemMetrics.ClaimError.Inc()
+			logger.Errorf(ctx, "failed to claim notifications from the database queue with err: %v", err)
+			return
+		}
+		for _, item := range items {
+			p.process(ctx, item)
+		}
+		if len(items) < p.config.BatchSize {
+			return
+		}
+	}
+}
+
+func (p *DBQueueProcessor) process(ctx context.Context, item models.NotificationQueueItem) {
+	p.systemMetrics.MessageTotal.Inc()
+	var send func() error
+	var description string
+	if item.NotificationType == interfaces.WebhookNotificationType {
+		webhookMessage, err := UnmarshalWebhookMessage(item.Message)
+		if err != nil {
+			p.systemMetrics.MessageDecodingError.Inc()
+			logger.Debugf(ctx, "failed to unmarshal to webhook message [%d] with err: %v", item.ID, err)
+			p.deadLetter(ctx, item, err)
+			return
+		}
+		description = "a call to webhook [" + webhookMessage.Webhook + "]"
+		send = func() error {
+			return p.webhook.SendWebhook(ctx, webhookMessage)
+		}
+	} else {
+		var emailMessage admin.EmailMessage
+		if err := proto.Unmarshal(item.Message, &emailMessage); err != nil {
+			p.systemMetrics.MessageDecodingError.Inc()
+			logger.Debugf(ctx, "failed to unmarshal to notification object message [%d] with err: %v", item.ID, err)
+			p.deadLetter(ctx, item, err)
+			return
+		}
+		description = "an email message for message [" + emailMessage.String() + "]"
+		send = func() error {
+			return p.email.SendEmail(ctx, emailMessage)
+		}
+	}
+
+	if err := send(); err != nil {
+		p.systemMetrics.MessageProcessorError.Inc()
+		logger.Errorf(ctx, "Error sending %s on attempt [%d] with err: %v", description, item.Attempts, err)
+		if p.deliveryLog && !IsRetryableDeliveryError(err) {
+			// The dead-lettered notification is re-driven from the delivery log, which enqueues it again.
+			p.systemMetrics.MessageDeadLetter.Inc()
+			if err := p.repo.Delete(ctx, item.ID); err != nil {
+				p.systemMetrics.MessageDoneError.Inc()
+				logger.Errorf(ctx, "failed to remove dead-lettered notification [%d] from the queue with err: %v",
+					item.ID, err)
+			}
+			return
+		}
+		if !p.deliveryLog && (item.Attempts >= p.config.MaxAttempts || IsDeadLetteredDeliveryError(err)) {
+			p.deadLetter(ctx, item, err)
+			return
+		}
+		// The item becomes visible for another attempt once its visibility timeout passes.
+		p.systemMetrics.MessageRetry.Inc()
+		item.LastError = err.Error()
+		if err := p.repo.UpdateStatus(ctx, item); err != nil {
+			p.systemMetrics.MessageDoneError.Inc()
+			logger.Errorf(ctx, "failed to record the error for notification [%d] with err: %v", item.ID, err)
+		}
+		return
+	}
+
+	p.systemMetrics.MessageSuccess.Inc()
+	if err := p.repo.Delete(ctx, item.ID); err != nil {
+		p.systemMetrics.MessageDoneError.Inc()
+		logger.Errorf(ctx, "failed to remove delivered notification [%d] from the queue with err: %v", item.ID, err)
+	}
+}
+
+func (p *DBQueueProcessor) deadLetter(ctx context.Context, item models.NotificationQueueItem, cause error) {
+	p.systemMetrics.MessageDeadLetter.Inc()
+	item.Status = models.NotificationQueueItemStatusDeadLetter
+	item.LastError = cause.Error()
+	if err := p.repo.UpdateStatus(ctx, item); err != nil {
+		p.systemMetrics.MessageDoneError.Inc()
+		logger.Errorf(ctx, "failed to move notification [%d] to the dead-letter state with err: %v", item.ID, err)
+	}
+}
+
+func (p *DBQueueProcessor) StopProcessing() error {
+	close(p.stop)
+	return nil
+}
+
+func newDBQueueProcessor(repo repoInterfaces.NotificationQueueRepoInterface, emailer interfaces.Emailer,
+	webhookSender interfaces.WebhookSender, config runtimeInterfaces.NotificationsDBQueueConfig, deliveryLog bool,
+	scope promutils.Scope, clock clock.Clock) *DBQueueProcessor {
+	return &DBQueueProcessor{
+		repo:        repo,
+		email:       emailer,
+		webhook:     webhookSender,
+		config:      config,
+		deliveryLog: deliveryLog,
+		clock:       clock,
+		stop:        make(chan struct{}),
+		systemMetrics: dbQueueProcessorMetrics{
+			processorSystemMetrics: newProcessorSystemMetrics(scope),
+			ClaimError:             scope.MustNewCounter("claim_error", "count of errors claiming messages from the queue"),
+			MessageRetry:           scope.MustNewCounter("message_retry", "count of messages which will be retried"),
+			MessageDeadLetter:      scope.MustNewCounter("message_dead_letter", "count of messages moved to the dead-letter state"),
+		},
+	}
+}
+
+// NewDBQueueProcessor returns a processor of the database queue. deliveryLog is set when the emailer and the webhook
+// sender record their attempts in the delivery log.
+func NewDBQueueProcessor(repo repoInterfaces.NotificationQueueRepoInterface, emailer interfaces.Emailer,
+	webhookSender interfaces.WebhookSender, config runtimeInterfaces.NotificationsDBQueueConfig, deliveryLog bool,
+	scope promutils.Scope) interfaces.Processor {
+	return newDBQueueProcessor(repo, emailer, webhookSender, config, deliveryLog,
+		scope.NewSubScope("db_queue_processor"), clock.New())
+}
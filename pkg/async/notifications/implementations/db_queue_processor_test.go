@@ -0,0 +1,208 @@
+package implementations
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/mocks"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repoMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/config"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var dbQueueConfig = runtimeInterfaces.NotificationsDBQueueConfig{
+	Enabled:           true,
+	PollInterval:      config.Duration{Duration: time.Second},
+	BatchSize:         2,
+	VisibilityTimeout: config.Duration{Duration: time.Minute},
+	MaxAttempts:       3,
+}
+
+func TestDBQueuePublisher_Publish(t *testing.T) {
+	repo := &repoMocks.NotificationQueueRepoInterface{}
+	mockClock := clock.NewMock()
+	publisher := NewDBQueuePublisher(repo, promutils.NewTestScope())
+	publisher.(*DBQueuePublisher).clock = mockClock
+
+	message, err := proto.Marshal(&testEmail)
+	assert.NoError(t, err)
+	repo.OnCreate(context.Background(), models.NotificationQueueItem{
+		NotificationType: "email",
+		Message:          message,
+		Status:           models.NotificationQueueItemStatusPending,
+		VisibleAt:        mockClock.Now(),
+	}).Return(nil)
+	assert.NoError(t, publisher.Publish(context.Background(), "email", &testEmail))
+
+	repo.OnCreateMatch(mock.Anything, mock.Anything).Return(errors.New("foo"))
+	assert.EqualError(t, NewDBQueuePublisher(repo, promutils.NewTestScope()).Publish(
+		context.Background(), "email", &testEmail), "foo")
+}
+
+func TestDBQueueProcessor_Run(t *testing.T) {
+	message, err := proto.Marshal(&testEmail)
+	assert.NoError(t, err)
+	mockClock := clock.NewMock()
+
+	repo := &repoMocks.NotificationQueueRepoInterface{}
+	claimInput := repoInterfaces.ClaimNotificationsInput{
+		Now:               mockClock.Now(),
+		VisibilityTimeout: time.Minute,
+		Limit:             2,
+		NotificationTypes: []string{"flyteidl.admin.EmailNotification", interfaces.WebhookNotificationType},
+	}
+	// A full batch is followed by another claim, which returns the remaining item.
+	repo.OnClaim(context.Background(), claimInput).Return([]models.NotificationQueueItem{
+		{ID: 1, Message: message, Attempts: 1},
+		{ID: 2, Message: []byte("invalid"), Attempts: 1},
+	}, nil).Once()
+	repo.OnClaim(context.Background(), claimInput).Return([]models.NotificationQueueItem{
+		{ID: 3, Message: message, Attempts: 1},
+	}, nil).Once()
+	repo.OnDelete(context.Background(), uint(1)).Return(nil)
+	repo.OnUpdateStatusMatch(context.Background(), mock.MatchedBy(func(item models.NotificationQueueItem) bool {
+		return item.ID == 2 && item.Status == models.NotificationQueueItemStatusDeadLetter && len(item.LastError) > 0
+	})).Return(nil)
+	repo.OnUpdateStatusMatch(context.Background(), mock.MatchedBy(func(item models.NotificationQueueItem) bool {
+		return item.ID == 3 && item.Status == "" && item.LastError == "send failed"
+	})).Return(nil)
+
+	var sent int
+	emailer := mocks.MockEmailer{}
+	emailer.SetSendEmailFunc(func(ctx context.Context, email admin.EmailMessage) error {
+		sent++
+		assert.True(t, proto.Equal(&testEmail, &email))
+		if sent > 1 {
+			return errors.New("send failed")
+		}
+		return nil
+	})
+
+	processor := newDBQueueProcessor(repo, &emailer, nil, dbQueueConfig, false, promutils.NewTestScope(), mockClock)
+	processor.run(context.Background())
+	assert.Equal(t, 2, sent)
+	repo.AssertExpectations(t)
+}
+
+func TestDBQueueProcessor_DeadLetterAfterMaxAttempts(t *testing.T) {
+	message, err := proto.Marshal(&testEmail)
+	assert.NoError(t, err)
+
+	repo := &repoMocks.NotificationQueueRepoInterface{}
+	repo.OnClaimMatch(mock.Anything, mock.Anything).Return([]models.NotificationQueueItem{
+		{ID: 1, Message: message, Attempts: 3},
+	}, nil)
+	repo.OnUpdateStatus(context.Background(), models.NotificationQueueItem{
+		ID:        1,
+		Message:   message,
+		Attempts:  3,
+		Status:    models.NotificationQueueItemStatusDeadLetter,
+		LastError: "send failed",
+	}).Return(nil)
+
+	emailer := mocks.MockEmailer{}
+	emailer.SetSendEmailFunc(func(ctx context.Context, email admin.EmailMessage) error {
+		return errors.New("send failed")
+	})
+
+	processor := newDBQueueProcessor(repo, &emailer, nil, dbQueueConfig, false, promutils.NewTestScope(), clock.NewMock())
+	processor.run(context.Background())
+	repo.AssertExpectations(t)
+}
+
+func TestDBQueueProcessor_DeliveryLog(t *testing.T) {
+	message, err := proto.Marshal(&testEmail)
+	assert.NoError(t, err)
+
+	repo := &repoMocks.NotificationQueueRepoInterface{}
+	repo.OnClaimMatch(mock.Anything, mock.Anything).Return([]models.NotificationQueueItem{
+		{ID: 1, Message: message, Attempts: 3},
+		{ID: 2, Message: message, Attempts: 3},
+	}, nil).Once()
+	repo.OnClaimMatch(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	// The delivery log, rather than the queue's maximum attempts, decides that the first item is retried.
+	repo.OnUpdateStatus(context.Background(), models.NotificationQueueItem{
+		ID:        1,
+		Message:   message,
+		Attempts:  3,
+		LastError: "send failed",
+	}).Return(nil)
+	// The dead-lettered item is left to the delivery log.
+	repo.OnDelete(context.Background(), uint(2)).Return(nil)
+
+	var calls int
+	emailer := mocks.MockEmailer{}
+	emailer.SetSendEmailFunc(func(ctx context.Context, email admin.EmailMessage) error {
+		calls++
+		return &DeliveryError{error: errors.New("send failed"), Retryable: calls == 1}
+	})
+
+	processor := newDBQueueProcessor(repo, &emailer, nil, dbQueueConfig, true, promutils.NewTestScope(), clock.NewMock())
+	processor.run(context.Background())
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.MatchedBy(func(item models.NotificationQueueItem) bool {
+		return item.Status == models.NotificationQueueItemStatusDeadLetter
+	}))
+}
+
+func TestDBQueueProcessor_ClaimError(t *testing.T) {
+	repo := &repoMocks.NotificationQueueRepoInterface{}
+	repo.OnClaimMatch(mock.Anything, mock.Anything).Return(nil, errors.New("foo"))
+
+	processor := newDBQueueProcessor(repo, &mocks.MockEmailer{}, nil, dbQueueConfig, false, promutils.NewTestScope(),
+		clock.NewMock())
+	processor.run(context.Background())
+	repo.AssertNumberOfCalls(t, "Claim", 1)
+}
+
+func TestDBQueueProcessor_Webhook(t *testing.T) {
+	webhookMessage := interfaces.WebhookMessage{
+		Webhook: "webhook",
+		Body:    `{"name": "execution"}`,
+	}
+	message, err := proto.Marshal(MarshalWebhookMessage(webhookMessage))
+	assert.NoError(t, err)
+
+	repo := &repoMocks.NotificationQueueRepoInterface{}
+	repo.OnClaimMatch(mock.Anything, mock.Anything).Return([]models.NotificationQueueItem{
+		{ID: 1, NotificationType: interfaces.WebhookNotificationType, Message: message, Attempts: 1},
+		{ID: 2, NotificationType: interfaces.WebhookNotificationType, Message: message, Attempts: 1},
+	}, nil).Once()
+	repo.OnClaimMatch(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	repo.OnDelete(context.Background(), uint(1)).Return(nil)
+	repo.OnUpdateStatus(context.Background(), models.NotificationQueueItem{
+		ID:               2,
+		NotificationType: interfaces.WebhookNotificationType,
+		Message:          message,
+		Attempts:         1,
+		LastError:        "call failed",
+	}).Return(nil)
+
+	var calls int
+	webhookSender := mocks.MockWebhookSender{}
+	webhookSender.SetSendWebhookFunc(func(ctx context.Context, message interfaces.WebhookMessage) error {
+		calls++
+		assert.Equal(t, webhookMessage, message)
+		if calls > 1 {
+			return errors.New("call failed")
+		}
+		return nil
+	})
+
+	processor := newDBQueueProcessor(repo, &mocks.MockEmailer{}, &webhookSender, dbQueueConfig, false,
+		promutils.NewTestScope(), clock.NewMock())
+	processor.run(context.Background())
+	assert.Equal(t, 2, calls)
+	repo.AssertExpectations(t)
+}
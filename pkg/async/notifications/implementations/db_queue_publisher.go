@@ -0,0 +1,52 @@
+package implementations
+
+import (
+	"context"
+
+	"github.com/benbjohnson/clock"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+)
+
+// DBQueuePublisher enqueues notifications in the database for consumption by a DBQueueProcessor.
+type DBQueuePublisher struct {
+	repo          repoInterfaces.NotificationQueueRepoInterface
+	clock         clock.Clock
+	systemMetrics publisherSystemMetrics
+}
+
+func (p *DBQueuePublisher) Publish(ctx context.Context, notificationType string, msg proto.Message) error {
+	p.systemMetrics.PublishTotal.Inc()
+	logger.Debugf(ctx, "Enqueueing the following message [%s]", msg.String())
+	message, err := proto.Marshal(msg)
+	if err != nil {
+		p.systemMetrics.PublishError.Inc()
+		logger.Errorf(ctx, "Failed to marshal message with key [%s] and message [%s] and error: %v",
+			notificationType, msg.String(), err)
+		return err
+	}
+	err = p.repo.Create(ctx, models.NotificationQueueItem{
+		NotificationType: notificationType,
+		Message:          message,
+		Status:           models.NotificationQueueItemStatusPending,
+		VisibleAt:        p.clock.Now(),
+	})
+	if err != nil {
+		p.systemMetrics.PublishError.Inc()
+		logger.Errorf(ctx, "Failed to enqueue a message with key [%s] and message [%s] and error: %v",
+			notificationType, msg.String(), err)
+	}
+	return err
+}
+
+func NewDBQueuePublisher(repo repoInterfaces.NotificationQueueRepoInterface, scope promutils.Scope) interfaces.Publisher {
+	return &DBQueuePublisher{
+		repo:          repo,
+		clock:         clock.New(),
+		systemMetrics: newPublisherSystemMetrics(scope.NewSubScope("db_queue_publisher")),
+	}
+}
@@ -0,0 +1,363 @@
+package implementations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/contextutils"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const (
+	EmailChannel   = "email"
+	WebhookChannel = "webhook"
+)
+
+// DeliveryError is returned by the DeliveryLogEmailer, the DeliveryLogWebhookSender and the HTTPWebhookSender when
+// sending a notification fails. Retryable failures should be left on the processor's queue for redelivery, while the
+// remaining ones are dead-lettered.
+type DeliveryError struct {
+	error
+	Retryable bool
+}
+
+func (e *DeliveryError) Unwrap() error {
+	return e.error
+}
+
+func IsRetryableDeliveryError(err error) bool {
+	var deliveryErr *DeliveryError
+	return errors.As(err, &deliveryErr) && deliveryErr.Retryable
+}
+
+func IsDeadLetteredDeliveryError(err error) bool {
+	var deliveryErr *DeliveryError
+	return errors.As(err, &deliveryErr) && !deliveryErr.Retryable
+}
+
+// flyteidl's EmailMessage has no field for the delivery ID, so it's carried as a field unknown to the message. Emailers
+// ignore it, while the serialized message keeps it through the processors' queues.
+const emailDeliveryIDField protowire.Number = 1000
+
+// Returns the delivery ID carried in the email, or an empty string for emails published without one.
+func getEmailDeliveryID(email *admin.EmailMessage) string {
+	unknown := proto.MessageReflect(email).GetUnknown()
+	for len(unknown) > 0 {
+		number, wireType, n := protowire.ConsumeTag(unknown)
+		if n < 0 {
+			return ""
+		}
+		unknown = unknown[n:]
+		if number == emailDeliveryIDField && wireType == protowire.BytesType {
+			deliveryID, n := protowire.ConsumeString(unknown)
+			if n < 0 {
+				return ""
+			}
+			return deliveryID
+		}
+		n = protowire.ConsumeFieldValue(number, wireType, unknown)
+		if n < 0 {
+			return ""
+		}
+		unknown = unknown[n:]
+	}
+	return ""
+}
+
+// Returns a copy of the email which carries the delivery ID.
+func withEmailDeliveryID(email *admin.EmailMessage, deliveryID string) *admin.EmailMessage {
+	stamped := proto.Clone(email).(*admin.EmailMessage)
+	message := proto.MessageReflect(stamped)
+	unknown := protowire.AppendTag(message.GetUnknown(), emailDeliveryIDField, protowire.BytesType)
+	message.SetUnknown(protowire.AppendString(unknown, deliveryID))
+	return stamped
+}
+
+type deliveryLogMetrics struct {
+	Scope              promutils.Scope
+	RecordError        prometheus.Counter
+	DeliveryDeadLetter prometheus.Counter
+}
+
+func newDeliveryLogMetrics(scope promutils.Scope) deliveryLogMetrics {
+	return deliveryLogMetrics{
+		Scope:              scope,
+		RecordError:        scope.MustNewCounter("record_error", "count of errors recording notification deliveries"),
+		DeliveryDeadLetter: scope.MustNewCounter("dead_letter", "count of notification deliveries moved to the dead-letter state"),
+	}
+}
+
+// Returns a copy of a published notification which the processors deliver, carrying the delivery ID, along with its
+// channel and recipients, or false for other messages.
+func withDeliveryID(notificationType string, msg proto.Message, deliveryID string) (
+	proto.Message, string, string, bool, error) {
+	if emailMessage, ok := msg.(*admin.EmailMessage); ok {
+		return withEmailDeliveryID(emailMessage, deliveryID), EmailChannel,
+			strings.Join(emailMessage.RecipientsEmail, ","), true, nil
+	}
+	if notificationType != interfaces.WebhookNotificationType {
+		return nil, "", "", false, nil
+	}
+	message, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, "", "", true, err
+	}
+	webhookMessage, err := UnmarshalWebhookMessage(message)
+	if err != nil {
+		return nil, "", "", true, err
+	}
+	webhookMessage.DeliveryID = deliveryID
+	return MarshalWebhookMessage(webhookMessage), WebhookChannel, getWebhookRecipient(webhookMessage), true, nil
+}
+
+// Webhook deliveries record the URL called for launch plan webhooks and the name of configured ones.
+func getWebhookRecipient(message interfaces.WebhookMessage) string {
+	if len(message.URL) > 0 {
+		return message.URL
+	}
+	return message.Webhook
+}
+
+// DeliveryLogPublisher records a pending delivery for every email and webhook notification before publishing it with
+// the wrapped publisher. Each published notification carries the generated ID of its delivery. The execution is read
+// from the project, domain and execution ID set in the context.
+type DeliveryLogPublisher struct {
+	pub     interfaces.Publisher
+	repo    repoInterfaces.NotificationDeliveryRepoInterface
+	metrics deliveryLogMetrics
+}
+
+func (p *DeliveryLogPublisher) Publish(ctx context.Context, notificationType string, msg proto.Message) error {
+	deliveryID := uuid.New().String()
+	published, channel, recipients, ok, err := withDeliveryID(notificationType, msg, deliveryID)
+	if !ok {
+		return p.pub.Publish(ctx, notificationType, msg)
+	}
+	if err != nil {
+		return err
+	}
+	message, err := proto.Marshal(published)
+	if err != nil {
+		return err
+	}
+	delivery := models.NotificationDelivery{
+		ExecutionKey: models.ExecutionKey{
+			Project: contextutils.Value(ctx, contextutils.ProjectKey),
+			Domain:  contextutils.Value(ctx, contextutils.DomainKey),
+			Name:    contextutils.Value(ctx, contextutils.ExecIDKey),
+		},
+		Channel:    channel,
+		Recipients: recipients,
+		Message:    message,
+		DeliveryID: deliveryID,
+		Status:     models.NotificationDeliveryStatusPending,
+	}
+	if err := p.repo.Create(ctx, delivery); err != nil {
+		// The notification is still published, its delivery is recorded once it's processed.
+		p.metrics.RecordError.Inc()
+		logger.Errorf(ctx, "failed to record delivery of [%s] notification to [%s] with err: %v", channel, recipients,
+			err)
+	}
+
+	publishErr := p.pub.Publish(ctx, notificationType, published)
+	if publishErr != nil {
+		// Nothing will retry a notification which never made it onto the queue.
+		p.metrics.DeliveryDeadLetter.Inc()
+		p.recordPublishFailure(ctx, deliveryID, publishErr)
+	}
+	return publishErr
+}
+
+func (p *DeliveryLogPublisher) recordPublishFailure(ctx context.Context, deliveryID string, publishErr error) {
+	delivery, err := p.repo.GetByDeliveryID(ctx, deliveryID)
+	if err != nil {
+		p.metrics.RecordError.Inc()
+		logger.Errorf(ctx, "failed to find delivery [%s] with err: %v", deliveryID, err)
+		return
+	}
+	delivery.Status = models.NotificationDeliveryStatusDeadLetter
+	delivery.LastError = publishErr.Error()
+	if err := p.repo.Update(ctx, delivery); err != nil {
+		p.metrics.RecordError.Inc()
+		logger.Errorf(ctx, "failed to record publish failure for delivery [%d] with err: %v", delivery.ID, err)
+	}
+}
+
+func NewDeliveryLogPublisher(pub interfaces.Publisher, repo repoInterfaces.NotificationDeliveryRepoInterface,
+	scope promutils.Scope) interfaces.Publisher {
+	return &DeliveryLogPublisher{
+		pub:     pub,
+		repo:    repo,
+		metrics: newDeliveryLogMetrics(scope.NewSubScope("delivery_log_publisher")),
+	}
+}
+
+// deliveryLog records the attempts to deliver consumed notification messages. It decides which failed deliveries are
+// retried and which are dead-lettered, so that processors only follow its outcome.
+type deliveryLog struct {
+	repo        repoInterfaces.NotificationDeliveryRepoInterface
+	maxAttempts uint32
+	metrics     deliveryLogMetrics
+}
+
+// Returns the delivery with the given ID. Deliveries which weren't recorded when the message was published, for
+// instance because the delivery log was enabled since, are recorded without an execution.
+func (l *deliveryLog) getDelivery(ctx context.Context, deliveryID, channel, recipients string, message []byte) (
+	models.NotificationDelivery, error) {
+	delivery, err := l.repo.GetByDeliveryID(ctx, deliveryID)
+	if err == nil {
+		return delivery, nil
+	}
+	if adminErr, ok := err.(flyteAdminErrors.FlyteAdminError); !ok || adminErr.Code() != codes.NotFound {
+		return models.NotificationDelivery{}, err
+	}
+	delivery = models.NotificationDelivery{
+		Channel:    channel,
+		Recipients: recipients,
+		Message:    message,
+		DeliveryID: deliveryID,
+		Status:     models.NotificationDeliveryStatusPending,
+	}
+	if err := l.repo.Create(ctx, delivery); err != nil {
+		return models.NotificationDelivery{}, err
+	}
+	return l.repo.GetByDeliveryID(ctx, deliveryID)
+}
+
+// Sends a consumed message and records the attempt against its delivery, returning the send error as a DeliveryError.
+// Messages published without a delivery ID can't be matched to their delivery when they're redelivered, so they're
+// attempted once.
+func (l *deliveryLog) deliver(ctx context.Context, deliveryID string, redeliverable bool, channel, recipients string,
+	message []byte, send func() error) error {
+	delivery, err := l.getDelivery(ctx, deliveryID, channel, recipients, message)
+	if err != nil {
+		// Without a delivery the attempts can't be counted, so a failed attempt isn't redelivered.
+		l.metrics.RecordError.Inc()
+		logger.Errorf(ctx, "failed to get delivery [%s] of [%s] notification to [%s] with err: %v", deliveryID,
+			channel, recipients, err)
+		if sendErr := send(); sendErr != nil {
+			return &DeliveryError{error: sendErr}
+		}
+		return nil
+	}
+	switch delivery.Status {
+	case models.NotificationDeliveryStatusDelivered:
+		logger.Debugf(ctx, "skipping redelivered message of delivery [%d] which was already delivered", delivery.ID)
+		return nil
+	case models.NotificationDeliveryStatusDeadLetter:
+		return &DeliveryError{error: fmt.Errorf("delivery [%d] was dead-lettered", delivery.ID)}
+	}
+	return l.recordAttempt(ctx, delivery, send(), redeliverable)
+}
+
+// Records the outcome of an attempt to deliver and returns the send error as a DeliveryError. Failures are retryable
+// until the maximum number of attempts is reached, unless the sender already dead-lettered them.
+func (l *deliveryLog) recordAttempt(ctx context.Context, delivery models.NotificationDelivery, sendErr error,
+	redeliverable bool) error {
+	delivery.Attempts++
+	switch {
+	case sendErr == nil:
+		delivery.Status = models.NotificationDeliveryStatusDelivered
+		delivery.LastError = ""
+	case !redeliverable || delivery.Attempts >= l.maxAttempts || IsDeadLetteredDeliveryError(sendErr):
+		l.metrics.DeliveryDeadLetter.Inc()
+		delivery.Status = models.NotificationDeliveryStatusDeadLetter
+		delivery.LastError = sendErr.Error()
+		sendErr = &DeliveryError{error: sendErr}
+	default:
+		delivery.Status = models.NotificationDeliveryStatusFailed
+		delivery.LastError = sendErr.Error()
+		sendErr = &DeliveryError{error: sendErr, Retryable: true}
+	}
+	if err := l.repo.Update(ctx, delivery); err != nil {
+		l.metrics.RecordError.Inc()
+		logger.Errorf(ctx, "failed to record attempt [%d] of delivery [%d] with err: %v", delivery.Attempts,
+			delivery.ID, err)
+	}
+	return sendErr
+}
+
+// DeliveryLogEmailer records the outcome of every email sent with the wrapped emailer against the delivery of the
+// message.
+type DeliveryLogEmailer struct {
+	emailer interfaces.Emailer
+	log     deliveryLog
+}
+
+func (e *DeliveryLogEmailer) SendEmail(ctx context.Context, email admin.EmailMessage) error {
+	deliveryID := getEmailDeliveryID(&email)
+	redeliverable := len(deliveryID) > 0
+	if !redeliverable {
+		// The recorded message carries the new delivery ID, so that re-driving it updates the same delivery.
+		deliveryID = uuid.New().String()
+		email = *withEmailDeliveryID(&email, deliveryID)
+	}
+	message, err := proto.Marshal(&email)
+	if err != nil {
+		return &DeliveryError{error: err}
+	}
+	return e.log.deliver(ctx, deliveryID, redeliverable, EmailChannel, strings.Join(email.RecipientsEmail, ","),
+		message, func() error {
+			return e.emailer.SendEmail(ctx, email)
+		})
+}
+
+func NewDeliveryLogEmailer(emailer interfaces.Emailer, repo repoInterfaces.NotificationDeliveryRepoInterface,
+	config runtimeInterfaces.NotificationsDeliveryLogConfig, scope promutils.Scope) interfaces.Emailer {
+	return &DeliveryLogEmailer{
+		emailer: emailer,
+		log: deliveryLog{
+			repo:        repo,
+			maxAttempts: config.MaxAttempts,
+			metrics:     newDeliveryLogMetrics(scope.NewSubScope("delivery_log_emailer")),
+		},
+	}
+}
+
+// DeliveryLogWebhookSender records the outcome of every call made with the wrapped webhook sender against the delivery
+// of the message, which bounds the redeliveries of failed calls.
+type DeliveryLogWebhookSender struct {
+	sender interfaces.WebhookSender
+	log    deliveryLog
+}
+
+func (s *DeliveryLogWebhookSender) SendWebhook(ctx context.Context, webhookMessage interfaces.WebhookMessage) error {
+	redeliverable := len(webhookMessage.DeliveryID) > 0
+	if !redeliverable {
+		webhookMessage.DeliveryID = uuid.New().String()
+	}
+	message, err := proto.Marshal(MarshalWebhookMessage(webhookMessage))
+	if err != nil {
+		return &DeliveryError{error: err}
+	}
+	return s.log.deliver(ctx, webhookMessage.DeliveryID, redeliverable, WebhookChannel,
+		getWebhookRecipient(webhookMessage), message, func() error {
+			return s.sender.SendWebhook(ctx, webhookMessage)
+		})
+}
+
+func NewDeliveryLogWebhookSender(sender interfaces.WebhookSender, repo repoInterfaces.NotificationDeliveryRepoInterface,
+	config runtimeInterfaces.NotificationsDeliveryLogConfig, scope promutils.Scope) interfaces.WebhookSender {
+	return &DeliveryLogWebhookSender{
+		sender: sender,
+		log: deliveryLog{
+			repo:        repo,
+			maxAttempts: config.MaxAttempts,
+			metrics:     newDeliveryLogMetrics(scope.NewSubScope("delivery_log_webhook_sender")),
+		},
+	}
+}
@@ -0,0 +1,373 @@
+package implementations
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/mocks"
+	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	repoMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/contextutils"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+var deliveryLogConfig = runtimeInterfaces.NotificationsDeliveryLogConfig{
+	Enabled:     true,
+	MaxAttempts: 2,
+}
+
+const testDeliveryID = "delivery-id"
+
+func TestEmailDeliveryID(t *testing.T) {
+	assert.Empty(t, getEmailDeliveryID(&testEmail))
+	stamped := withEmailDeliveryID(&testEmail, testDeliveryID)
+	assert.Equal(t, testDeliveryID, getEmailDeliveryID(stamped))
+	assert.Empty(t, getEmailDeliveryID(&testEmail))
+
+	// The delivery ID survives serialization through the processors' queues, while the email is left as is.
+	message, err := proto.Marshal(stamped)
+	assert.NoError(t, err)
+	var email admin.EmailMessage
+	assert.NoError(t, proto.Unmarshal(message, &email))
+	assert.Equal(t, testDeliveryID, getEmailDeliveryID(&email))
+	assert.Equal(t, testEmail.RecipientsEmail, email.RecipientsEmail)
+	assert.Equal(t, testEmail.SubjectLine, email.SubjectLine)
+	assert.Equal(t, testEmail.Body, email.Body)
+}
+
+func TestDeliveryLogPublisher_Publish(t *testing.T) {
+	ctx := contextutils.WithExecutionID(contextutils.WithProjectDomain(context.Background(), "project", "domain"), "name")
+
+	var deliveryID string
+	repo := &repoMocks.NotificationDeliveryRepoInterface{}
+	repo.OnCreateMatch(ctx, mock.MatchedBy(func(delivery models.NotificationDelivery) bool {
+		deliveryID = delivery.DeliveryID
+		var email admin.EmailMessage
+		return assert.NoError(t, proto.Unmarshal(delivery.Message, &email)) &&
+			getEmailDeliveryID(&email) == delivery.DeliveryID &&
+			delivery.ExecutionKey == models.ExecutionKey{Project: "project", Domain: "domain", Name: "name"} &&
+			delivery.Channel == EmailChannel && delivery.Recipients == "a@example.com,b@example.com" &&
+			delivery.Status == models.NotificationDeliveryStatusPending
+	})).Return(nil)
+
+	var published proto.Message
+	publisher := mocks.MockPublisher{}
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		published = msg
+		return nil
+	})
+	assert.NoError(t, NewDeliveryLogPublisher(&publisher, repo, promutils.NewTestScope()).Publish(
+		ctx, "email", &testEmail))
+	repo.AssertExpectations(t)
+	// The published email carries the ID of the recorded delivery.
+	assert.NotEmpty(t, deliveryID)
+	assert.Equal(t, deliveryID, getEmailDeliveryID(published.(*admin.EmailMessage)))
+	assert.Empty(t, getEmailDeliveryID(&testEmail))
+
+	// Identical notifications get deliveries of their own.
+	firstDeliveryID := deliveryID
+	assert.NoError(t, NewDeliveryLogPublisher(&publisher, repo, promutils.NewTestScope()).Publish(
+		ctx, "email", &testEmail))
+	assert.NotEqual(t, firstDeliveryID, deliveryID)
+}
+
+func TestDeliveryLogPublisher_PublishError(t *testing.T) {
+	repo := &repoMocks.NotificationDeliveryRepoInterface{}
+	var deliveryID string
+	repo.OnCreateMatch(mock.Anything, mock.MatchedBy(func(delivery models.NotificationDelivery) bool {
+		deliveryID = delivery.DeliveryID
+		return true
+	})).Return(nil)
+	repo.OnGetByDeliveryIDMatch(mock.Anything, mock.MatchedBy(func(id string) bool {
+		return id == deliveryID
+	})).Return(models.NotificationDelivery{
+		ID:     1,
+		Status: models.NotificationDeliveryStatusPending,
+	}, nil)
+	repo.OnUpdateMatch(mock.Anything, models.NotificationDelivery{
+		ID:        1,
+		Status:    models.NotificationDeliveryStatusDeadLetter,
+		LastError: "foo",
+	}).Return(nil)
+
+	publisher := mocks.MockPublisher{}
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		return errors.New("foo")
+	})
+	assert.EqualError(t, NewDeliveryLogPublisher(&publisher, repo, promutils.NewTestScope()).Publish(
+		context.Background(), "email", &testEmail), "foo")
+	repo.AssertExpectations(t)
+}
+
+func TestDeliveryLogPublisher_NonEmailMessage(t *testing.T) {
+	repo := &repoMocks.NotificationDeliveryRepoInterface{}
+	var published bool
+	publisher := mocks.MockPublisher{}
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		published = true
+		return nil
+	})
+	assert.NoError(t, NewDeliveryLogPublisher(&publisher, repo, promutils.NewTestScope()).Publish(
+		context.Background(), "other", &admin.Notification{}))
+	assert.True(t, published)
+	repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestDeliveryLogEmailer_SendEmail(t *testing.T) {
+	repo := &repoMocks.NotificationDeliveryRepoInterface{}
+	repo.OnGetByDeliveryIDMatch(mock.Anything, testDeliveryID).Return(models.NotificationDelivery{
+		ID:     1,
+		Status: models.NotificationDeliveryStatusPending,
+	}, nil).Once()
+	repo.OnUpdateMatch(mock.Anything, models.NotificationDelivery{
+		ID:       1,
+		Status:   models.NotificationDeliveryStatusDelivered,
+		Attempts: 1,
+	}).Return(nil)
+
+	var calls int
+	emailer := mocks.MockEmailer{}
+	emailer.SetSendEmailFunc(func(ctx context.Context, email admin.EmailMessage) error {
+		calls++
+		return nil
+	})
+	deliveryLogEmailer := NewDeliveryLogEmailer(&emailer, repo, deliveryLogConfig, promutils.NewTestScope())
+	email := withEmailDeliveryID(&testEmail, testDeliveryID)
+	assert.NoError(t, deliveryLogEmailer.SendEmail(context.Background(), *email))
+
+	// A redelivered message which was already delivered isn't sent again.
+	repo.OnGetByDeliveryIDMatch(mock.Anything, testDeliveryID).Return(models.NotificationDelivery{
+		ID:       1,
+		Status:   models.NotificationDeliveryStatusDelivered,
+		Attempts: 1,
+	}, nil).Once()
+	assert.NoError(t, deliveryLogEmailer.SendEmail(context.Background(), *email))
+	assert.Equal(t, 1, calls)
+	repo.AssertExpectations(t)
+}
+
+func TestDeliveryLogEmailer_SendEmailWithoutDelivery(t *testing.T) {
+	message, err := proto.Marshal(withEmailDeliveryID(&testEmail, testDeliveryID))
+	assert.NoError(t, err)
+	repo := &repoMocks.NotificationDeliveryRepoInterface{}
+	repo.OnGetByDeliveryIDMatch(mock.Anything, testDeliveryID).Return(models.NotificationDelivery{},
+		adminErrors.NewFlyteAdminError(codes.NotFound, "not found")).Once()
+	repo.OnCreateMatch(mock.Anything, models.NotificationDelivery{
+		Channel:    EmailChannel,
+		Recipients: "a@example.com,b@example.com",
+		Message:    message,
+		DeliveryID: testDeliveryID,
+		Status:     models.NotificationDeliveryStatusPending,
+	}).Return(nil)
+	repo.OnGetByDeliveryIDMatch(mock.Anything, testDeliveryID).Return(models.NotificationDelivery{
+		ID:     3,
+		Status: models.NotificationDeliveryStatusPending,
+	}, nil).Once()
+	repo.OnUpdateMatch(mock.Anything, mock.MatchedBy(func(delivery models.NotificationDelivery) bool {
+		return delivery.ID == 3 && delivery.Status == models.NotificationDeliveryStatusDelivered
+	})).Return(nil)
+
+	emailer := mocks.MockEmailer{}
+	assert.NoError(t, NewDeliveryLogEmailer(&emailer, repo, deliveryLogConfig, promutils.NewTestScope()).SendEmail(
+		context.Background(), *withEmailDeliveryID(&testEmail, testDeliveryID)))
+	repo.AssertExpectations(t)
+}
+
+func TestDeliveryLogEmailer_SendEmailWithoutDeliveryID(t *testing.T) {
+	var deliveryID string
+	repo := &repoMocks.NotificationDeliveryRepoInterface{}
+	repo.OnGetByDeliveryIDMatch(mock.Anything, mock.Anything).Return(models.NotificationDelivery{},
+		adminErrors.NewFlyteAdminError(codes.NotFound, "not found")).Once()
+	repo.OnCreateMatch(mock.Anything, mock.MatchedBy(func(delivery models.NotificationDelivery) bool {
+		deliveryID = delivery.DeliveryID
+		var email admin.EmailMessage
+		return assert.NoError(t, proto.Unmarshal(delivery.Message, &email)) &&
+			len(deliveryID) > 0 && getEmailDeliveryID(&email) == deliveryID
+	})).Return(nil)
+	repo.OnGetByDeliveryIDMatch(mock.Anything, mock.Anything).Return(models.NotificationDelivery{
+		ID:     3,
+		Status: models.NotificationDeliveryStatusPending,
+	}, nil).Once()
+	// Emails published without a delivery ID can't be matched to their delivery when redelivered, so they're attempted
+	// once.
+	repo.OnUpdateMatch(mock.Anything, models.NotificationDelivery{
+		ID:        3,
+		Status:    models.NotificationDeliveryStatusDeadLetter,
+		Attempts:  1,
+		LastError: "send failed",
+	}).Return(nil)
+
+	emailer := mocks.MockEmailer{}
+	emailer.SetSendEmailFunc(func(ctx context.Context, email admin.EmailMessage) error {
+		return errors.New("send failed")
+	})
+	err := NewDeliveryLogEmailer(&emailer, repo, deliveryLogConfig, promutils.NewTestScope()).SendEmail(
+		context.Background(), testEmail)
+	assert.True(t, IsDeadLetteredDeliveryError(err))
+	repo.AssertExpectations(t)
+}
+
+func TestDeliveryLogEmailer_SendEmailFailure(t *testing.T) {
+	repo := &repoMocks.NotificationDeliveryRepoInterface{}
+	repo.OnGetByDeliveryIDMatch(mock.Anything, testDeliveryID).Return(models.NotificationDelivery{
+		ID:     1,
+		Status: models.NotificationDeliveryStatusPending,
+	}, nil).Once()
+	repo.OnUpdateMatch(mock.Anything, models.NotificationDelivery{
+		ID:        1,
+		Status:    models.NotificationDeliveryStatusFailed,
+		Attempts:  1,
+		LastError: "send failed",
+	}).Return(nil)
+
+	var calls int
+	emailer := mocks.MockEmailer{}
+	emailer.SetSendEmailFunc(func(ctx context.Context, email admin.EmailMessage) error {
+		calls++
+		return errors.New("send failed")
+	})
+	deliveryLogEmailer := NewDeliveryLogEmailer(&emailer, repo, deliveryLogConfig, promutils.NewTestScope())
+	email := withEmailDeliveryID(&testEmail, testDeliveryID)
+	err := deliveryLogEmailer.SendEmail(context.Background(), *email)
+	assert.EqualError(t, err, "send failed")
+	assert.True(t, IsRetryableDeliveryError(err))
+	assert.False(t, IsDeadLetteredDeliveryError(err))
+
+	// The final attempt moves the delivery to the dead-letter state.
+	repo.OnGetByDeliveryIDMatch(mock.Anything, testDeliveryID).Return(models.NotificationDelivery{
+		ID:        1,
+		Status:    models.NotificationDeliveryStatusFailed,
+		Attempts:  1,
+		LastError: "send failed",
+	}, nil).Once()
+	repo.OnUpdateMatch(mock.Anything, models.NotificationDelivery{
+		ID:        1,
+		Status:    models.NotificationDeliveryStatusDeadLetter,
+		Attempts:  2,
+		LastError: "send failed",
+	}).Return(nil)
+	err = deliveryLogEmailer.SendEmail(context.Background(), *email)
+	assert.EqualError(t, err, "send failed")
+	assert.False(t, IsRetryableDeliveryError(err))
+	assert.True(t, IsDeadLetteredDeliveryError(err))
+
+	// Dead-lettered deliveries aren't attempted again until they're re-driven.
+	repo.OnGetByDeliveryIDMatch(mock.Anything, testDeliveryID).Return(models.NotificationDelivery{
+		ID:       1,
+		Status:   models.NotificationDeliveryStatusDeadLetter,
+		Attempts: 2,
+	}, nil).Once()
+	err = deliveryLogEmailer.SendEmail(context.Background(), *email)
+	assert.True(t, IsDeadLetteredDeliveryError(err))
+	assert.Equal(t, 2, calls)
+	repo.AssertExpectations(t)
+}
+
+var testWebhookMessage = interfaces.WebhookMessage{
+	Webhook:    "launch-plan/project/domain/name",
+	URL:        "https://hooks.example.com/flyte",
+	Body:       testWebhookBody,
+	DeliveryID: testDeliveryID,
+}
+
+func TestDeliveryLogPublisher_PublishWebhook(t *testing.T) {
+	webhookMessage := testWebhookMessage
+	webhookMessage.DeliveryID = ""
+	var deliveryID string
+	repo := &repoMocks.NotificationDeliveryRepoInterface{}
+	repo.OnCreateMatch(mock.Anything, mock.MatchedBy(func(delivery models.NotificationDelivery) bool {
+		deliveryID = delivery.DeliveryID
+		recorded, err := UnmarshalWebhookMessage(delivery.Message)
+		return assert.NoError(t, err) && recorded.DeliveryID == delivery.DeliveryID &&
+			delivery.Channel == WebhookChannel && delivery.Recipients == "https://hooks.example.com/flyte" &&
+			delivery.Status == models.NotificationDeliveryStatusPending
+	})).Return(nil)
+
+	var published interfaces.WebhookMessage
+	publisher := mocks.MockPublisher{}
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		message, err := proto.Marshal(msg)
+		assert.NoError(t, err)
+		published, err = UnmarshalWebhookMessage(message)
+		return err
+	})
+	assert.NoError(t, NewDeliveryLogPublisher(&publisher, repo, promutils.NewTestScope()).Publish(
+		context.Background(), interfaces.WebhookNotificationType, MarshalWebhookMessage(webhookMessage)))
+	repo.AssertExpectations(t)
+	assert.NotEmpty(t, deliveryID)
+	webhookMessage.DeliveryID = deliveryID
+	assert.Equal(t, webhookMessage, published)
+}
+
+func TestDeliveryLogWebhookSender_SendWebhookFailure(t *testing.T) {
+	repo := &repoMocks.NotificationDeliveryRepoInterface{}
+	repo.OnGetByDeliveryIDMatch(mock.Anything, testDeliveryID).Return(models.NotificationDelivery{
+		ID:     1,
+		Status: models.NotificationDeliveryStatusPending,
+	}, nil).Once()
+	repo.OnUpdateMatch(mock.Anything, models.NotificationDelivery{
+		ID:        1,
+		Status:    models.NotificationDeliveryStatusFailed,
+		Attempts:  1,
+		LastError: "unavailable",
+	}).Return(nil)
+
+	sender := mocks.MockWebhookSender{}
+	sender.SetSendWebhookFunc(func(ctx context.Context, message interfaces.WebhookMessage) error {
+		assert.Equal(t, testWebhookMessage, message)
+		return &DeliveryError{error: errors.New("unavailable"), Retryable: true}
+	})
+	deliveryLogSender := NewDeliveryLogWebhookSender(&sender, repo, deliveryLogConfig, promutils.NewTestScope())
+	err := deliveryLogSender.SendWebhook(context.Background(), testWebhookMessage)
+	assert.True(t, IsRetryableDeliveryError(err))
+
+	// Retryable failures are dead-lettered once the maximum number of attempts is reached.
+	repo.OnGetByDeliveryIDMatch(mock.Anything, testDeliveryID).Return(models.NotificationDelivery{
+		ID:        1,
+		Status:    models.NotificationDeliveryStatusFailed,
+		Attempts:  1,
+		LastError: "unavailable",
+	}, nil).Once()
+	repo.OnUpdateMatch(mock.Anything, models.NotificationDelivery{
+		ID:        1,
+		Status:    models.NotificationDeliveryStatusDeadLetter,
+		Attempts:  2,
+		LastError: "unavailable",
+	}).Return(nil)
+	err = deliveryLogSender.SendWebhook(context.Background(), testWebhookMessage)
+	assert.True(t, IsDeadLetteredDeliveryError(err))
+	repo.AssertExpectations(t)
+}
+
+func TestDeliveryLogWebhookSender_SendWebhookDeadLettered(t *testing.T) {
+	repo := &repoMocks.NotificationDeliveryRepoInterface{}
+	repo.OnGetByDeliveryIDMatch(mock.Anything, testDeliveryID).Return(models.NotificationDelivery{
+		ID:     1,
+		Status: models.NotificationDeliveryStatusPending,
+	}, nil)
+	repo.OnUpdateMatch(mock.Anything, models.NotificationDelivery{
+		ID:        1,
+		Status:    models.NotificationDeliveryStatusDeadLetter,
+		Attempts:  1,
+		LastError: "bad request",
+	}).Return(nil)
+
+	// Failures the sender doesn't retry are dead-lettered right away.
+	sender := mocks.MockWebhookSender{}
+	sender.SetSendWebhookFunc(func(ctx context.Context, message interfaces.WebhookMessage) error {
+		return &DeliveryError{error: errors.New("bad request")}
+	})
+	err := NewDeliveryLogWebhookSender(&sender, repo, deliveryLogConfig, promutils.NewTestScope()).SendWebhook(
+		context.Background(), testWebhookMessage)
+	assert.True(t, IsDeadLetteredDeliveryError(err))
+	repo.AssertExpectations(t)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/NYTimes/gizmo/pubsub"
+	gizmoGCP "github.com/NYTimes/gizmo/pubsub/gcp"
 	"github.com/flyteorg/flyteadmin/pkg/async"
 	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
@@ -13,17 +14,23 @@ import (
 	"github.com/golang/protobuf/proto"
 )
 
+// The message attribute gizmo sets to the key a message was published with.
+const gcpKeyAttribute = "key"
+
 // TODO: Add a counter that encompasses the publisher stats grouped by project and domain.
 type GcpProcessor struct {
 	sub           pubsub.Subscriber
 	email         interfaces.Emailer
+	webhook       interfaces.WebhookSender
 	systemMetrics processorSystemMetrics
 }
 
-func NewGcpProcessor(sub pubsub.Subscriber, emailer interfaces.Emailer, scope promutils.Scope) interfaces.Processor {
+func NewGcpProcessor(sub pubsub.Subscriber, emailer interfaces.Emailer, webhookSender interfaces.WebhookSender,
+	scope promutils.Scope) interfaces.Processor {
 	return &GcpProcessor{
 		sub:           sub,
 		email:         emailer,
+		webhook:       webhookSender,
 		systemMetrics: newProcessorSystemMetrics(scope.NewSubScope("gcp_processor")),
 	}
 }
@@ -43,6 +50,16 @@ func (p *GcpProcessor) run() error {
 	for msg := range p.sub.Start() {
 		p.systemMetrics.MessageTotal.Inc()
 
+		// The key the notification was published with is set as a message attribute.
+		if gcpMsg, ok := msg.(*gizmoGCP.SubMessage); ok && gcpMsg.Attributes[gcpKeyAttribute] == interfaces.WebhookNotificationType {
+			if processWebhookMessage(context.Background(), p.webhook, msg.Message(), p.systemMetrics) {
+				// Leave the message unacknowledged so that it's redelivered once its ack deadline expires.
+				continue
+			}
+			p.markMessageDone(msg)
+			continue
+		}
+
 		if err := proto.Unmarshal(msg.Message(), &emailMessage); err != nil {
 			logger.Debugf(context.Background(), "failed to unmarshal to notification object message [%s] with err: %v", string(msg.Message()), err)
 			p.systemMetrics.MessageDecodingError.Inc()
@@ -53,6 +70,10 @@ func (p *GcpProcessor) run() error {
 		if err := p.email.SendEmail(context.Background(), emailMessage); err != nil {
 			p.systemMetrics.MessageProcessorError.Inc()
 			logger.Errorf(context.Background(), "Error sending an email message for message [%s] with emailM with err: %v", emailMessage.String(), err)
+			if IsRetryableDeliveryError(err) {
+				// Leave the message unacknowledged so that it's redelivered once its ack deadline expires.
+				continue
+			}
 		} else {
 			p.systemMetrics.MessageSuccess.Inc()
 		}
@@ -31,7 +31,7 @@ func TestGcpProcessor_StartProcessing(t *testing.T) {
 	initializeGcpSubscriber()
 	testGcpSubscriber.ProtoMessages = append(testGcpSubscriber.ProtoMessages, testSubscriberProtoMessages...)
 
-	testGcpProcessor := NewGcpProcessor(&testGcpSubscriber, &mockGcpEmailer, promutils.NewTestScope())
+	testGcpProcessor := NewGcpProcessor(&testGcpSubscriber, &mockGcpEmailer, nil, promutils.NewTestScope())
 
 	sendEmailValidationFunc := func(ctx context.Context, email admin.EmailMessage) error {
 		assert.Equal(t, email.Body, testEmail.Body)
@@ -53,7 +53,7 @@ func TestGcpProcessor_StartProcessing(t *testing.T) {
 func TestGcpProcessor_StartProcessingNoMessages(t *testing.T) {
 	initializeGcpSubscriber()
 
-	testGcpProcessor := NewGcpProcessor(&testGcpSubscriber, &mockGcpEmailer, promutils.NewTestScope())
+	testGcpProcessor := NewGcpProcessor(&testGcpSubscriber, &mockGcpEmailer, nil, promutils.NewTestScope())
 
 	// Expect no errors are returned.
 	assert.Nil(t, testGcpProcessor.(*GcpProcessor).run())
@@ -73,7 +73,7 @@ func TestGcpProcessor_StartProcessingError(t *testing.T) {
 	// Err() is checked before Run() returning.
 	testGcpSubscriber.GivenErrError = ret
 
-	testGcpProcessor := NewGcpProcessor(&testGcpSubscriber, &mockGcpEmailer, promutils.NewTestScope())
+	testGcpProcessor := NewGcpProcessor(&testGcpSubscriber, &mockGcpEmailer, nil, promutils.NewTestScope())
 	assert.Equal(t, ret, testGcpProcessor.(*GcpProcessor).run())
 }
 
@@ -86,7 +86,7 @@ func TestGcpProcessor_StartProcessingEmailError(t *testing.T) {
 	mockGcpEmailer.SetSendEmailFunc(sendEmailErrorFunc)
 	testGcpSubscriber.ProtoMessages = append(testGcpSubscriber.ProtoMessages, testSubscriberProtoMessages...)
 
-	testGcpProcessor := NewGcpProcessor(&testGcpSubscriber, &mockGcpEmailer, promutils.NewTestScope())
+	testGcpProcessor := NewGcpProcessor(&testGcpSubscriber, &mockGcpEmailer, nil, promutils.NewTestScope())
 
 	// Even if there is an error in sending an email StartProcessing will return no errors.
 	assert.Nil(t, testGcpProcessor.(*GcpProcessor).run())
@@ -100,7 +100,7 @@ func TestGcpProcessor_StartProcessingEmailError(t *testing.T) {
 
 func TestGcpProcessor_StopProcessing(t *testing.T) {
 	initializeGcpSubscriber()
-	testGcpProcessor := NewGcpProcessor(&testGcpSubscriber, &mockGcpEmailer, promutils.NewTestScope())
+	testGcpProcessor := NewGcpProcessor(&testGcpSubscriber, &mockGcpEmailer, nil, promutils.NewTestScope())
 	assert.Nil(t, testGcpProcessor.StopProcessing())
 }
 
@@ -108,6 +108,6 @@ func TestGcpProcessor_StopProcessingError(t *testing.T) {
 	initializeGcpSubscriber()
 	stopError := errors.New("stop() returns an error")
 	testGcpSubscriber.GivenStopError = stopError
-	testGcpProcessor := NewGcpProcessor(&testGcpSubscriber, &mockGcpEmailer, promutils.NewTestScope())
+	testGcpProcessor := NewGcpProcessor(&testGcpSubscriber, &mockGcpEmailer, nil, promutils.NewTestScope())
 	assert.Equal(t, stopError, testGcpProcessor.StopProcessing())
 }
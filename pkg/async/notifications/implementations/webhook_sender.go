@@ -0,0 +1,300 @@
+package implementations
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// Header carrying the HMAC-SHA256 of the request body, formatted as sha256=<hex digest>.
+	WebhookSignatureHeader = "X-Flyte-Signature-256"
+	webhookNameHeader      = "X-Flyte-Webhook"
+
+	webhookMessageName       = "webhook"
+	webhookMessageURL        = "url"
+	webhookMessageBody       = "body"
+	webhookMessageDeliveryID = "delivery_id"
+
+	defaultWebhookTimeout = 10 * time.Second
+)
+
+// flyteidl has no webhook message so webhook calls are published as a protobuf Struct.
+func MarshalWebhookMessage(message interfaces.WebhookMessage) proto.Message {
+	webhookStruct := &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			webhookMessageName: {Kind: &structpb.Value_StringValue{StringValue: message.Webhook}},
+			webhookMessageBody: {Kind: &structpb.Value_StringValue{StringValue: message.Body}},
+		},
+	}
+	if len(message.URL) > 0 {
+		webhookStruct.Fields[webhookMessageURL] = &structpb.Value{
+			Kind: &structpb.Value_StringValue{StringValue: message.URL},
+		}
+	}
+	if len(message.DeliveryID) > 0 {
+		webhookStruct.Fields[webhookMessageDeliveryID] = &structpb.Value{
+			Kind: &structpb.Value_StringValue{StringValue: message.DeliveryID},
+		}
+	}
+	return webhookStruct
+}
+
+func UnmarshalWebhookMessage(message []byte) (interfaces.WebhookMessage, error) {
+	var webhookStruct structpb.Struct
+	if err := proto.Unmarshal(message, &webhookStruct); err != nil {
+		return interfaces.WebhookMessage{}, err
+	}
+	name := webhookStruct.Fields[webhookMessageName].GetStringValue()
+	if len(name) == 0 {
+		return interfaces.WebhookMessage{}, fmt.Errorf("webhook message is missing the webhook name")
+	}
+	return interfaces.WebhookMessage{
+		Webhook:    name,
+		URL:        webhookStruct.Fields[webhookMessageURL].GetStringValue(),
+		Body:       webhookStruct.Fields[webhookMessageBody].GetStringValue(),
+		DeliveryID: webhookStruct.Fields[webhookMessageDeliveryID].GetStringValue(),
+	}, nil
+}
+
+// Returns whether the path of the URL falls under the path of the allowed prefix. A prefix path which doesn't end in a
+// slash matches whole path segments only, and dot segments are resolved first so they can't escape the prefix.
+func hasAllowedPath(urlPath, prefixPath string) bool {
+	if len(prefixPath) == 0 || prefixPath == "/" {
+		return true
+	}
+	cleaned := path.Clean("/" + urlPath)
+	if strings.HasSuffix(urlPath, "/") && cleaned != "/" {
+		cleaned += "/"
+	}
+	if strings.HasSuffix(prefixPath, "/") {
+		return strings.HasPrefix(cleaned, prefixPath)
+	}
+	return cleaned == prefixPath || strings.HasPrefix(cleaned, prefixPath+"/")
+}
+
+// IsAllowedLaunchPlanWebhookURL returns whether launch plans may declare webhooks calling the URL. The scheme and host,
+// including any port, must equal those of an allowed prefix and the path must fall under its path.
+func IsAllowedLaunchPlanWebhookURL(config runtimeInterfaces.LaunchPlanWebhooksConfig, webhookURL string) bool {
+	parsed, err := url.Parse(webhookURL)
+	if err != nil || len(parsed.Host) == 0 || parsed.User != nil {
+		return false
+	}
+	for _, prefix := range config.AllowedURLPrefixes {
+		allowed, err := url.Parse(prefix)
+		if err != nil || len(allowed.Host) == 0 {
+			continue
+		}
+		if strings.EqualFold(parsed.Scheme, allowed.Scheme) && strings.EqualFold(parsed.Host, allowed.Host) &&
+			hasAllowedPath(parsed.Path, allowed.Path) {
+			return true
+		}
+	}
+	return false
+}
+
+func readWebhookSecret(secret runtimeInterfaces.WebhookSecret) (string, error) {
+	if secret.EnvVar != "" {
+		return os.Getenv(secret.EnvVar), nil
+	}
+	value, err := ioutil.ReadFile(secret.FilePath)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(value)), nil
+}
+
+func isRetryableWebhookStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
+type webhookMetrics struct {
+	Scope       promutils.Scope
+	SendSuccess prometheus.Counter
+	SendError   prometheus.Counter
+	SendTotal   prometheus.Counter
+}
+
+func newWebhookMetrics(scope promutils.Scope) webhookMetrics {
+	return webhookMetrics{
+		Scope:       scope,
+		SendSuccess: scope.MustNewCounter("send_success", "Number of successful webhook calls"),
+		SendError:   scope.MustNewCounter("send_error", "Number of failed webhook calls"),
+		SendTotal:   scope.MustNewCounter("send_total", "Total number of webhook calls attempted"),
+	}
+}
+
+// HTTPWebhookSender POSTs webhook messages to the endpoints of the configured webhooks, or to those declared by launch
+// plans. Secrets are read when each call is made so that rotated secrets are picked up without a restart.
+type HTTPWebhookSender struct {
+	webhooks           map[string]runtimeInterfaces.Webhook
+	launchPlanWebhooks runtimeInterfaces.LaunchPlanWebhooksConfig
+	client             *http.Client
+	systemMetrics      webhookMetrics
+}
+
+func (s *HTTPWebhookSender) newRequest(ctx context.Context, webhook runtimeInterfaces.Webhook, body []byte) (
+	*http.Request, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(webhookNameHeader, webhook.Name)
+	for header, value := range webhook.Headers {
+		request.Header.Set(header, value)
+	}
+	for header, secret := range webhook.SecretHeaders {
+		value, err := readWebhookSecret(secret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read secret for header [%s] with err: %v", header, err)
+		}
+		request.Header.Set(header, value)
+	}
+	if webhook.SigningSecret.EnvVar != "" || webhook.SigningSecret.FilePath != "" {
+		key, err := readWebhookSecret(webhook.SigningSecret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read signing secret with err: %v", err)
+		}
+		mac := hmac.New(sha256.New, []byte(key))
+		mac.Write(body)
+		request.Header.Set(WebhookSignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+	return request, nil
+}
+
+// Returns whether a failed call should be retried along with the error.
+func (s *HTTPWebhookSender) call(ctx context.Context, webhook runtimeInterfaces.Webhook, body []byte) (bool, error) {
+	timeout := webhook.Timeout.Duration
+	if timeout == 0 {
+		timeout = defaultWebhookTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	request, err := s.newRequest(ctx, webhook, body)
+	if err != nil {
+		return false, err
+	}
+	response, err := s.client.Do(request)
+	if err != nil {
+		return true, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode >= http.StatusOK && response.StatusCode < http.StatusMultipleChoices {
+		return false, nil
+	}
+	responseBody, _ := ioutil.ReadAll(response.Body)
+	return isRetryableWebhookStatus(response.StatusCode), fmt.Errorf(
+		"webhook [%s] responded with status [%d] and body [%s]", webhook.Name, response.StatusCode, responseBody)
+}
+
+func (s *HTTPWebhookSender) getWebhook(message interfaces.WebhookMessage) (runtimeInterfaces.Webhook, error) {
+	if len(message.URL) == 0 {
+		webhook, ok := s.webhooks[message.Webhook]
+		if !ok {
+			return runtimeInterfaces.Webhook{}, fmt.Errorf("no webhook named [%s] is configured", message.Webhook)
+		}
+		return webhook, nil
+	}
+	// The allowed prefixes are checked again in case they changed since the launch plan was created.
+	if !IsAllowedLaunchPlanWebhookURL(s.launchPlanWebhooks, message.URL) {
+		return runtimeInterfaces.Webhook{}, fmt.Errorf("launch plan webhook [%s] calls URL [%s] which isn't allowed",
+			message.Webhook, message.URL)
+	}
+	return runtimeInterfaces.Webhook{
+		Name:          message.Webhook,
+		URL:           message.URL,
+		SigningSecret: s.launchPlanWebhooks.SigningSecret,
+		Timeout:       s.launchPlanWebhooks.Timeout,
+	}, nil
+}
+
+// SendWebhook makes a single call per delivery. Failures are returned as a DeliveryError so the processor leaves
+// retryable ones on its queue to be redelivered with the queue's backoff, rather than holding up the processor.
+func (s *HTTPWebhookSender) SendWebhook(ctx context.Context, message interfaces.WebhookMessage) error {
+	s.systemMetrics.SendTotal.Inc()
+	webhook, err := s.getWebhook(message)
+	if err != nil {
+		s.systemMetrics.SendError.Inc()
+		return &DeliveryError{error: err}
+	}
+	retryable, err := s.call(ctx, webhook, []byte(message.Body))
+	if err != nil {
+		s.systemMetrics.SendError.Inc()
+		logger.Warningf(ctx, "Failed to call webhook [%s] with err: %v", webhook.Name, err)
+		return &DeliveryError{error: err, Retryable: retryable}
+	}
+	s.systemMetrics.SendSuccess.Inc()
+	return nil
+}
+
+func NewHTTPWebhookSender(webhooks []runtimeInterfaces.Webhook,
+	launchPlanWebhooks runtimeInterfaces.LaunchPlanWebhooksConfig, scope promutils.Scope) interfaces.WebhookSender {
+	webhooksByName := make(map[string]runtimeInterfaces.Webhook, len(webhooks))
+	for _, webhook := range webhooks {
+		webhooksByName[webhook.Name] = webhook
+	}
+	return &HTTPWebhookSender{
+		webhooks:           webhooksByName,
+		launchPlanWebhooks: launchPlanWebhooks,
+		client:             &http.Client{},
+		systemMetrics:      newWebhookMetrics(scope.NewSubScope("webhook")),
+	}
+}
+
+// SingleAttemptWebhookSender dead-letters the failed calls of the wrapped webhook sender, including retryable ones.
+// Cloud queues don't count how often a message was redelivered, so unless the delivery log bounds the attempts, failed
+// webhook calls aren't redelivered, the same as emails.
+type SingleAttemptWebhookSender struct {
+	sender interfaces.WebhookSender
+}
+
+func (s *SingleAttemptWebhookSender) SendWebhook(ctx context.Context, message interfaces.WebhookMessage) error {
+	if err := s.sender.SendWebhook(ctx, message); err != nil {
+		return &DeliveryError{error: err}
+	}
+	return nil
+}
+
+func NewSingleAttemptWebhookSender(sender interfaces.WebhookSender) interfaces.WebhookSender {
+	return &SingleAttemptWebhookSender{
+		sender: sender,
+	}
+}
+
+// Decodes and sends a webhook message consumed by a processor, recording the outcome in the processor metrics.
+// Returns whether the message should be left on the queue so that the failed call is redelivered.
+func processWebhookMessage(ctx context.Context, sender interfaces.WebhookSender, message []byte,
+	systemMetrics processorSystemMetrics) bool {
+	webhookMessage, err := UnmarshalWebhookMessage(message)
+	if err != nil {
+		systemMetrics.MessageDecodingError.Inc()
+		logger.Errorf(ctx, "failed to unmarshal webhook message [%s] with err: %v", string(message), err)
+		return false
+	}
+	if err := sender.SendWebhook(ctx, webhookMessage); err != nil {
+		systemMetrics.MessageProcessorError.Inc()
+		logger.Errorf(ctx, "Error calling webhook [%s] with err: %v", webhookMessage.Webhook, err)
+		return IsRetryableDeliveryError(err)
+	}
+	systemMetrics.MessageSuccess.Inc()
+	return false
+}
@@ -0,0 +1,210 @@
+package implementations
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/mocks"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+const testWebhookBody = `{"name": "execution"}`
+
+func TestWebhookMessage_MarshalUnmarshal(t *testing.T) {
+	message := interfaces.WebhookMessage{
+		Webhook: "webhook",
+		Body:    testWebhookBody,
+	}
+	serialized, err := proto.Marshal(MarshalWebhookMessage(message))
+	assert.NoError(t, err)
+	unmarshalled, err := UnmarshalWebhookMessage(serialized)
+	assert.NoError(t, err)
+	assert.Equal(t, message, unmarshalled)
+
+	message.URL = "https://hooks.example.com/flyte"
+	serialized, err = proto.Marshal(MarshalWebhookMessage(message))
+	assert.NoError(t, err)
+	unmarshalled, err = UnmarshalWebhookMessage(serialized)
+	assert.NoError(t, err)
+	assert.Equal(t, message, unmarshalled)
+
+	_, err = UnmarshalWebhookMessage([]byte{})
+	assert.EqualError(t, err, "webhook message is missing the webhook name")
+}
+
+func TestHTTPWebhookSender_SendWebhook(t *testing.T) {
+	secretFile := filepath.Join(t.TempDir(), "signing-key")
+	assert.NoError(t, ioutil.WriteFile(secretFile, []byte("signing-key\n"), 0600))
+	assert.NoError(t, os.Setenv("TEST_WEBHOOK_AUTHORIZATION", "Bearer token"))
+	defer os.Unsetenv("TEST_WEBHOOK_AUTHORIZATION")
+
+	mac := hmac.New(sha256.New, []byte("signing-key"))
+	mac.Write([]byte(testWebhookBody))
+	expectedSignature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		calls++
+		body, err := ioutil.ReadAll(request.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, http.MethodPost, request.Method)
+		assert.Equal(t, testWebhookBody, string(body))
+		assert.Equal(t, "application/json", request.Header.Get("Content-Type"))
+		assert.Equal(t, "static", request.Header.Get("X-Static"))
+		assert.Equal(t, "Bearer token", request.Header.Get("Authorization"))
+		assert.Equal(t, expectedSignature, request.Header.Get(WebhookSignatureHeader))
+		if calls == 1 {
+			writer.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		writer.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sender := NewHTTPWebhookSender([]runtimeInterfaces.Webhook{
+		{
+			Name: "webhook",
+			URL:  server.URL,
+			Headers: map[string]string{
+				"X-Static": "static",
+			},
+			SecretHeaders: map[string]runtimeInterfaces.WebhookSecret{
+				"Authorization": {EnvVar: "TEST_WEBHOOK_AUTHORIZATION"},
+			},
+			SigningSecret: runtimeInterfaces.WebhookSecret{FilePath: secretFile},
+		},
+	}, runtimeInterfaces.LaunchPlanWebhooksConfig{}, promutils.NewTestScope())
+
+	message := interfaces.WebhookMessage{
+		Webhook: "webhook",
+		Body:    testWebhookBody,
+	}
+	assert.True(t, IsRetryableDeliveryError(sender.SendWebhook(context.Background(), message)))
+	assert.Equal(t, 1, calls)
+	assert.NoError(t, sender.SendWebhook(context.Background(), message))
+	assert.Equal(t, 2, calls)
+}
+
+func TestHTTPWebhookSender_SendWebhookFailure(t *testing.T) {
+	var calls int
+	statusCode := http.StatusBadRequest
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		calls++
+		writer.WriteHeader(statusCode)
+	}))
+	defer server.Close()
+
+	sender := NewHTTPWebhookSender([]runtimeInterfaces.Webhook{
+		{
+			Name: "webhook",
+			URL:  server.URL,
+		},
+	}, runtimeInterfaces.LaunchPlanWebhooksConfig{}, promutils.NewTestScope())
+	message := interfaces.WebhookMessage{
+		Webhook: "webhook",
+		Body:    testWebhookBody,
+	}
+
+	// Client errors aren't redelivered.
+	err := sender.SendWebhook(context.Background(), message)
+	assert.EqualError(t, err, "webhook [webhook] responded with status [400] and body []")
+	assert.True(t, IsDeadLetteredDeliveryError(err))
+	assert.Equal(t, 1, calls)
+
+	// Server errors are left for the queue to redeliver rather than retried in place.
+	calls = 0
+	statusCode = http.StatusInternalServerError
+	err = sender.SendWebhook(context.Background(), message)
+	assert.True(t, IsRetryableDeliveryError(err))
+	assert.Equal(t, 1, calls)
+
+	err = sender.SendWebhook(context.Background(), interfaces.WebhookMessage{Webhook: "unknown"})
+	assert.EqualError(t, err, "no webhook named [unknown] is configured")
+	assert.True(t, IsDeadLetteredDeliveryError(err))
+}
+
+func TestHTTPWebhookSender_SendLaunchPlanWebhook(t *testing.T) {
+	secretFile := filepath.Join(t.TempDir(), "signing-key")
+	assert.NoError(t, ioutil.WriteFile(secretFile, []byte("signing-key"), 0600))
+	mac := hmac.New(sha256.New, []byte("signing-key"))
+	mac.Write([]byte(testWebhookBody))
+	expectedSignature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		calls++
+		assert.Equal(t, "/hooks/flyte", request.URL.Path)
+		assert.Equal(t, "launch-plan/project/domain/name", request.Header.Get(webhookNameHeader))
+		assert.Equal(t, expectedSignature, request.Header.Get(WebhookSignatureHeader))
+		writer.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sender := NewHTTPWebhookSender(nil, runtimeInterfaces.LaunchPlanWebhooksConfig{
+		AllowedURLPrefixes: []string{server.URL + "/hooks/"},
+		SigningSecret:      runtimeInterfaces.WebhookSecret{FilePath: secretFile},
+	}, promutils.NewTestScope())
+	message := interfaces.WebhookMessage{
+		Webhook: "launch-plan/project/domain/name",
+		URL:     server.URL + "/hooks/flyte",
+		Body:    testWebhookBody,
+	}
+	assert.NoError(t, sender.SendWebhook(context.Background(), message))
+	assert.Equal(t, 1, calls)
+
+	// URLs which are no longer allowed aren't called.
+	message.URL = server.URL + "/internal"
+	err := sender.SendWebhook(context.Background(), message)
+	assert.True(t, IsDeadLetteredDeliveryError(err))
+	assert.Equal(t, 1, calls)
+}
+
+func TestIsAllowedLaunchPlanWebhookURL(t *testing.T) {
+	config := runtimeInterfaces.LaunchPlanWebhooksConfig{
+		AllowedURLPrefixes: []string{"https://hooks.example.com/flyte/", "https://alerts.example.com/api"},
+	}
+	for webhookURL, allowed := range map[string]bool{
+		"https://hooks.example.com/flyte/build":            true,
+		"https://HOOKS.example.com/flyte/build?a=b":        true,
+		"https://alerts.example.com/api":                   true,
+		"https://alerts.example.com/api/v1":                true,
+		"https://alerts.example.com/apiv1":                 false,
+		"https://hooks.example.com.evil.com/flyte/build":   false,
+		"https://hooks.example.com:8443/flyte/build":       false,
+		"https://hooks.example.com@evil.com/flyte/build":   false,
+		"http://hooks.example.com/flyte/build":             false,
+		"https://hooks.example.com/flyte/../admin":         false,
+		"https://hooks.example.com/other":                  false,
+		"hooks.example.com/flyte/build":                    false,
+		"https://evil.com/?next=https://hooks.example.com": false,
+	} {
+		assert.Equal(t, allowed, IsAllowedLaunchPlanWebhookURL(config, webhookURL), webhookURL)
+	}
+}
+
+func TestSingleAttemptWebhookSender(t *testing.T) {
+	sender := mocks.MockWebhookSender{}
+	sender.SetSendWebhookFunc(func(ctx context.Context, message interfaces.WebhookMessage) error {
+		return &DeliveryError{error: errors.New("unavailable"), Retryable: true}
+	})
+	err := NewSingleAttemptWebhookSender(&sender).SendWebhook(context.Background(), interfaces.WebhookMessage{})
+	assert.EqualError(t, err, "unavailable")
+	assert.True(t, IsDeadLetteredDeliveryError(err))
+
+	sender.SetSendWebhookFunc(nil)
+	assert.NoError(t, NewSingleAttemptWebhookSender(&sender).SendWebhook(context.Background(),
+		interfaces.WebhookMessage{}))
+}
@@ -0,0 +1,25 @@
+package interfaces
+
+import (
+	"context"
+)
+
+// The key webhook calls are published with. Processors use it to tell webhook calls apart from emails.
+const WebhookNotificationType = "flyteadmin.WebhookNotification"
+
+// WebhookMessage is a rendered call to a configured webhook or to a webhook declared by a launch plan.
+type WebhookMessage struct {
+	// Name of the configured webhook to call.
+	Webhook string
+	// URL of the webhook declared by a launch plan. Unset for configured webhooks.
+	URL  string
+	Body string
+	// Identifies the delivery of the call in the delivery log. Unset when the delivery log isn't enabled.
+	DeliveryID string
+}
+
+// The implementation of WebhookSender needs to be passed to the implementation of Processor
+// in order for webhooks to be called.
+type WebhookSender interface {
+	SendWebhook(ctx context.Context, message WebhookMessage) error
+}
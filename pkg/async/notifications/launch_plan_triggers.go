@@ -0,0 +1,165 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/config"
+)
+
+const (
+	// Launch plan annotation listing the comma separated recipients notified when a task of an execution of the launch
+	// plan fails on its final retry attempt.
+	NotifyOnTaskRetriesExhaustedAnnotation = "flyte.org/notify-on-task-retries-exhausted"
+	// Launch plan annotation listing the comma separated recipients notified when a node of an execution of the launch
+	// plan fails.
+	NotifyOnNodeFailureAnnotation = "flyte.org/notify-on-node-failure"
+	// Launch plan annotation restricting node failure notifications to the comma separated node IDs. Defaults to all
+	// nodes.
+	NotifyOnNodeFailureNodesAnnotation = "flyte.org/notify-on-node-failure-nodes"
+	// Launch plan annotation listing the comma separated recipients notified when an execution of the launch plan is
+	// still running after the threshold.
+	NotifyOnLongRunningAnnotation = "flyte.org/notify-on-long-running"
+	// Launch plan annotation setting the long running threshold as a duration, e.g. 2h. Required for long running
+	// notifications.
+	NotifyOnLongRunningThresholdAnnotation = "flyte.org/notify-on-long-running-threshold"
+)
+
+var launchPlanNotificationAnnotations = []string{
+	NotifyOnTaskRetriesExhaustedAnnotation,
+	NotifyOnNodeFailureAnnotation,
+	NotifyOnNodeFailureNodesAnnotation,
+	NotifyOnLongRunningAnnotation,
+	NotifyOnLongRunningThresholdAnnotation,
+}
+
+// WithoutLaunchPlanNotificationAnnotations returns the annotations without those declaring notification triggers.
+// Execution annotations are copied into the FlyteWorkflow CRD and onto every pod of the execution, which shouldn't
+// carry the recipient addresses. The given annotations are left unchanged.
+func WithoutLaunchPlanNotificationAnnotations(annotations map[string]string) map[string]string {
+	var filtered map[string]string
+	for _, key := range launchPlanNotificationAnnotations {
+		if _, ok := annotations[key]; ok {
+			filtered = make(map[string]string, len(annotations))
+			break
+		}
+	}
+	if filtered == nil {
+		return annotations
+	}
+	for key, value := range annotations {
+		filtered[key] = value
+	}
+	for _, key := range launchPlanNotificationAnnotations {
+		delete(filtered, key)
+	}
+	return filtered
+}
+
+func splitAnnotationList(value string) []string {
+	var values []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); len(item) > 0 {
+			values = append(values, item)
+		}
+	}
+	return values
+}
+
+// ParseLaunchPlanNotificationTriggers returns the notification triggers declared by the annotations of the identified
+// launch plan. The triggers apply to the executions of the launch plan only.
+func ParseLaunchPlanNotificationTriggers(launchPlanID core.Identifier, annotations map[string]string) (
+	[]runtimeInterfaces.NotificationTrigger, error) {
+	requires := map[string]string{
+		NotifyOnNodeFailureNodesAnnotation:     NotifyOnNodeFailureAnnotation,
+		NotifyOnLongRunningThresholdAnnotation: NotifyOnLongRunningAnnotation,
+	}
+	for key, required := range requires {
+		if _, ok := annotations[key]; ok {
+			if _, ok := annotations[required]; !ok {
+				return nil, fmt.Errorf("annotation [%s] requires the [%s] annotation", key, required)
+			}
+		}
+	}
+
+	var triggers []runtimeInterfaces.NotificationTrigger
+	for _, annotation := range []struct {
+		key         string
+		triggerType runtimeInterfaces.NotificationTriggerType
+	}{
+		{NotifyOnTaskRetriesExhaustedAnnotation, runtimeInterfaces.NotificationTriggerTypeTaskRetriesExhausted},
+		{NotifyOnNodeFailureAnnotation, runtimeInterfaces.NotificationTriggerTypeNodeFailure},
+		{NotifyOnLongRunningAnnotation, runtimeInterfaces.NotificationTriggerTypeLongRunning},
+	} {
+		value, ok := annotations[annotation.key]
+		if !ok {
+			continue
+		}
+		recipients := splitAnnotationList(value)
+		if len(recipients) == 0 {
+			return nil, fmt.Errorf("annotation [%s] requires at least one recipient", annotation.key)
+		}
+		trigger := runtimeInterfaces.NotificationTrigger{
+			Type:            annotation.triggerType,
+			Project:         launchPlanID.Project,
+			Domain:          launchPlanID.Domain,
+			LaunchPlan:      launchPlanID.Name,
+			RecipientsEmail: recipients,
+		}
+		switch annotation.triggerType {
+		case runtimeInterfaces.NotificationTriggerTypeNodeFailure:
+			trigger.NodeIDs = splitAnnotationList(annotations[NotifyOnNodeFailureNodesAnnotation])
+		case runtimeInterfaces.NotificationTriggerTypeLongRunning:
+			threshold, err := time.ParseDuration(strings.TrimSpace(annotations[NotifyOnLongRunningThresholdAnnotation]))
+			if err != nil || threshold <= 0 {
+				return nil, fmt.Errorf("annotation [%s] requires a positive duration in the [%s] annotation",
+					annotation.key, NotifyOnLongRunningThresholdAnnotation)
+			}
+			trigger.RunningThreshold = config.Duration{Duration: threshold}
+		}
+		triggers = append(triggers, trigger)
+	}
+	return triggers, nil
+}
+
+// Returns the identifier and annotations of the launch plan of the execution, or a nil identifier when the execution
+// has no launch plan.
+func getLaunchPlanAnnotations(ctx context.Context, db repositories.RepositoryInterface, execution *admin.Execution) (
+	*core.Identifier, map[string]string, error) {
+	launchPlanID := execution.GetSpec().GetLaunchPlan()
+	if launchPlanID == nil {
+		return nil, nil, nil
+	}
+	launchPlanModel, err := db.LaunchPlanRepo().Get(ctx, repoInterfaces.Identifier{
+		Project: launchPlanID.Project,
+		Domain:  launchPlanID.Domain,
+		Name:    launchPlanID.Name,
+		Version: launchPlanID.Version,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	launchPlan, err := transformers.FromLaunchPlanModel(launchPlanModel)
+	if err != nil {
+		return nil, nil, err
+	}
+	return launchPlanID, launchPlan.GetSpec().GetAnnotations().GetValues(), nil
+}
+
+// GetLaunchPlanNotificationTriggers returns the notification triggers declared by the launch plan of the execution.
+func GetLaunchPlanNotificationTriggers(ctx context.Context, db repositories.RepositoryInterface,
+	execution *admin.Execution) ([]runtimeInterfaces.NotificationTrigger, error) {
+	launchPlanID, annotations, err := getLaunchPlanAnnotations(ctx, db, execution)
+	if err != nil || launchPlanID == nil {
+		return nil, err
+	}
+	return ParseLaunchPlanNotificationTriggers(*launchPlanID, annotations)
+}
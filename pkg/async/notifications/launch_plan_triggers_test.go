@@ -0,0 +1,100 @@
+package notifications
+
+import (
+	"testing"
+	"time"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/config"
+	"github.com/stretchr/testify/assert"
+)
+
+var launchPlanIDForTriggers = core.Identifier{
+	Project: executionProjectValue,
+	Domain:  executionDomainValue,
+	Name:    launchPlanNameValue,
+	Version: "v1",
+}
+
+func TestParseLaunchPlanNotificationTriggers(t *testing.T) {
+	triggers, err := ParseLaunchPlanNotificationTriggers(launchPlanIDForTriggers, map[string]string{
+		NotifyOnTaskRetriesExhaustedAnnotation: "oncall@example.com",
+		NotifyOnNodeFailureAnnotation:          "oncall@example.com, team@example.com",
+		NotifyOnNodeFailureNodesAnnotation:     "n0,n1",
+		NotifyOnLongRunningAnnotation:          "oncall@example.com",
+		NotifyOnLongRunningThresholdAnnotation: "2h",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []runtimeInterfaces.NotificationTrigger{
+		{
+			Type:            runtimeInterfaces.NotificationTriggerTypeTaskRetriesExhausted,
+			Project:         executionProjectValue,
+			Domain:          executionDomainValue,
+			LaunchPlan:      launchPlanNameValue,
+			RecipientsEmail: recipients,
+		},
+		{
+			Type:            runtimeInterfaces.NotificationTriggerTypeNodeFailure,
+			Project:         executionProjectValue,
+			Domain:          executionDomainValue,
+			LaunchPlan:      launchPlanNameValue,
+			NodeIDs:         []string{"n0", "n1"},
+			RecipientsEmail: []string{"oncall@example.com", "team@example.com"},
+		},
+		{
+			Type:             runtimeInterfaces.NotificationTriggerTypeLongRunning,
+			Project:          executionProjectValue,
+			Domain:           executionDomainValue,
+			LaunchPlan:       launchPlanNameValue,
+			RunningThreshold: config.Duration{Duration: 2 * time.Hour},
+			RecipientsEmail:  recipients,
+		},
+	}, triggers)
+
+	triggers, err = ParseLaunchPlanNotificationTriggers(launchPlanIDForTriggers, map[string]string{})
+	assert.NoError(t, err)
+	assert.Empty(t, triggers)
+}
+
+func TestParseLaunchPlanNotificationTriggers_Invalid(t *testing.T) {
+	for name, annotations := range map[string]map[string]string{
+		"no recipients": {
+			NotifyOnNodeFailureAnnotation: " , ",
+		},
+		"nodes without node failure": {
+			NotifyOnNodeFailureNodesAnnotation: "n0",
+		},
+		"threshold without long running": {
+			NotifyOnLongRunningThresholdAnnotation: "1h",
+		},
+		"missing threshold": {
+			NotifyOnLongRunningAnnotation: "oncall@example.com",
+		},
+		"invalid threshold": {
+			NotifyOnLongRunningAnnotation:          "oncall@example.com",
+			NotifyOnLongRunningThresholdAnnotation: "-1h",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseLaunchPlanNotificationTriggers(launchPlanIDForTriggers, annotations)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestWithoutLaunchPlanNotificationAnnotations(t *testing.T) {
+	annotations := map[string]string{
+		"team":                                 "data",
+		NotifyOnNodeFailureAnnotation:          "oncall@example.com",
+		NotifyOnLongRunningAnnotation:          "oncall@example.com",
+		NotifyOnLongRunningThresholdAnnotation: "2h",
+	}
+	assert.Equal(t, map[string]string{"team": "data"}, WithoutLaunchPlanNotificationAnnotations(annotations))
+	// The given annotations are left unchanged.
+	assert.Len(t, annotations, 4)
+
+	annotations = map[string]string{"team": "data"}
+	assert.Equal(t, annotations, WithoutLaunchPlanNotificationAnnotations(annotations))
+	assert.Nil(t, WithoutLaunchPlanNotificationAnnotations(nil))
+}
@@ -0,0 +1,79 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/implementations"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+const (
+	// Launch plan annotation setting the URL POSTed to when an execution of the launch plan changes phase. The URL must
+	// start with one of the prefixes allowed in the launch plan webhooks config.
+	WebhookURLAnnotation = "flyte.org/webhook-url"
+	// Launch plan annotation restricting the webhook to the comma separated workflow execution phases, e.g.
+	// SUCCEEDED,FAILED. Defaults to every phase change.
+	WebhookPhasesAnnotation = "flyte.org/webhook-phases"
+	// Launch plan annotation setting the optionally templatized JSON body of the webhook. Defaults to the body used by
+	// configured webhooks.
+	WebhookBodyAnnotation = "flyte.org/webhook-body"
+)
+
+// ParseLaunchPlanWebhooks returns the webhook declared by the annotations of the identified launch plan, if any. The
+// webhook applies to the executions of the launch plan only.
+func ParseLaunchPlanWebhooks(launchPlanID core.Identifier, annotations map[string]string,
+	config runtimeInterfaces.LaunchPlanWebhooksConfig) ([]runtimeInterfaces.Webhook, error) {
+	webhookURL, ok := annotations[WebhookURLAnnotation]
+	if !ok {
+		for _, key := range []string{WebhookPhasesAnnotation, WebhookBodyAnnotation} {
+			if _, ok := annotations[key]; ok {
+				return nil, fmt.Errorf("annotation [%s] requires the [%s] annotation", key, WebhookURLAnnotation)
+			}
+		}
+		return nil, nil
+	}
+	webhookURL = strings.TrimSpace(webhookURL)
+	parsedURL, err := url.Parse(webhookURL)
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || len(parsedURL.Host) == 0 {
+		return nil, fmt.Errorf("annotation [%s] requires an http or https URL", WebhookURLAnnotation)
+	}
+	if !implementations.IsAllowedLaunchPlanWebhookURL(config, webhookURL) {
+		return nil, fmt.Errorf("annotation [%s] sets URL [%s] which doesn't start with an allowed prefix",
+			WebhookURLAnnotation, webhookURL)
+	}
+	var phases []string
+	for _, phase := range splitAnnotationList(annotations[WebhookPhasesAnnotation]) {
+		if _, ok := core.WorkflowExecution_Phase_value[strings.ToUpper(phase)]; !ok {
+			return nil, fmt.Errorf("annotation [%s] lists unknown workflow execution phase [%s]",
+				WebhookPhasesAnnotation, phase)
+		}
+		phases = append(phases, phase)
+	}
+	return []runtimeInterfaces.Webhook{
+		{
+			Name:       fmt.Sprintf("launch-plan/%s/%s/%s", launchPlanID.Project, launchPlanID.Domain, launchPlanID.Name),
+			URL:        webhookURL,
+			Project:    launchPlanID.Project,
+			Domain:     launchPlanID.Domain,
+			LaunchPlan: launchPlanID.Name,
+			Phases:     phases,
+			Body:       annotations[WebhookBodyAnnotation],
+		},
+	}, nil
+}
+
+// GetLaunchPlanWebhooks returns the webhooks declared by the launch plan of the execution.
+func GetLaunchPlanWebhooks(ctx context.Context, db repositories.RepositoryInterface,
+	config runtimeInterfaces.LaunchPlanWebhooksConfig, execution *admin.Execution) ([]runtimeInterfaces.Webhook, error) {
+	launchPlanID, annotations, err := getLaunchPlanAnnotations(ctx, db, execution)
+	if err != nil || launchPlanID == nil {
+		return nil, err
+	}
+	return ParseLaunchPlanWebhooks(*launchPlanID, annotations, config)
+}
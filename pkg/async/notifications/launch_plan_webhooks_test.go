@@ -0,0 +1,68 @@
+package notifications
+
+import (
+	"testing"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/stretchr/testify/assert"
+)
+
+var launchPlanWebhooksConfig = runtimeInterfaces.LaunchPlanWebhooksConfig{
+	AllowedURLPrefixes: []string{"https://hooks.example.com/"},
+}
+
+func TestParseLaunchPlanWebhooks(t *testing.T) {
+	webhooks, err := ParseLaunchPlanWebhooks(launchPlanIDForTriggers, map[string]string{
+		WebhookURLAnnotation:    " https://hooks.example.com/flyte ",
+		WebhookPhasesAnnotation: "succeeded, FAILED",
+		WebhookBodyAnnotation:   `{"name": "{{ name }}"}`,
+	}, launchPlanWebhooksConfig)
+	assert.NoError(t, err)
+	assert.Equal(t, []runtimeInterfaces.Webhook{
+		{
+			Name:       "launch-plan/" + executionProjectValue + "/" + executionDomainValue + "/" + launchPlanNameValue,
+			URL:        "https://hooks.example.com/flyte",
+			Project:    executionProjectValue,
+			Domain:     executionDomainValue,
+			LaunchPlan: launchPlanNameValue,
+			Phases:     []string{"succeeded", "FAILED"},
+			Body:       `{"name": "{{ name }}"}`,
+		},
+	}, webhooks)
+
+	webhooks, err = ParseLaunchPlanWebhooks(launchPlanIDForTriggers, map[string]string{}, launchPlanWebhooksConfig)
+	assert.NoError(t, err)
+	assert.Empty(t, webhooks)
+}
+
+func TestParseLaunchPlanWebhooks_Invalid(t *testing.T) {
+	for name, annotations := range map[string]map[string]string{
+		"phases without url": {
+			WebhookPhasesAnnotation: "FAILED",
+		},
+		"body without url": {
+			WebhookBodyAnnotation: "{}",
+		},
+		"not http": {
+			WebhookURLAnnotation: "file:///etc/passwd",
+		},
+		"not allowed": {
+			WebhookURLAnnotation: "https://internal.example.com/flyte",
+		},
+		"unknown phase": {
+			WebhookURLAnnotation:    "https://hooks.example.com/flyte",
+			WebhookPhasesAnnotation: "DONE",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseLaunchPlanWebhooks(launchPlanIDForTriggers, annotations, launchPlanWebhooksConfig)
+			assert.Error(t, err)
+		})
+	}
+
+	// Launch plans can't declare webhooks unless the operator allows some URLs.
+	_, err := ParseLaunchPlanWebhooks(launchPlanIDForTriggers, map[string]string{
+		WebhookURLAnnotation: "https://hooks.example.com/flyte",
+	}, runtimeInterfaces.LaunchPlanWebhooksConfig{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,317 @@
+package notifications
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/implementations"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/contextutils"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+)
+
+const longRunningCheckerPageSize = 100
+
+// Records that all the longRunning triggers of the launch plan of an execution were handled.
+const launchPlanTriggersKey = "launch-plan"
+
+var nonTerminalExecutionPhases = []string{
+	core.WorkflowExecution_UNDEFINED.String(),
+	core.WorkflowExecution_QUEUED.String(),
+	core.WorkflowExecution_RUNNING.String(),
+	core.WorkflowExecution_SUCCEEDING.String(),
+	core.WorkflowExecution_FAILING.String(),
+}
+
+type longRunningCheckerMetrics struct {
+	Scope              promutils.Scope
+	CheckTotal         prometheus.Counter
+	CheckError         prometheus.Counter
+	NotificationsTotal prometheus.Counter
+	PublishError       prometheus.Counter
+}
+
+// LongRunningChecker periodically looks for non-terminal executions which crossed the threshold of a configured
+// longRunning notification trigger and publishes a notification for each of them.
+// Every trigger handled for an execution is recorded, so each execution is notified once per trigger, however late the
+// check runs.
+type LongRunningChecker struct {
+	db        repositories.RepositoryInterface
+	config    runtimeInterfaces.ApplicationConfiguration
+	publisher interfaces.Publisher
+	clock     clock.Clock
+	stop      chan struct{}
+	metrics   longRunningCheckerMetrics
+}
+
+func (c *LongRunningChecker) StartProcessing() {
+	interval := c.config.GetNotificationsConfig().LongRunningChecker.Interval.Duration
+	logger.Infof(context.Background(), "Starting long running execution checker with interval [%v]", interval)
+	ticker := c.clock.Ticker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C:
+			if err := c.check(context.Background()); err != nil {
+				c.metrics.CheckError.Inc()
+				logger.Errorf(context.Background(), "failed to check for long running executions with err: %v", err)
+			}
+		}
+	}
+}
+
+func (c *LongRunningChecker) StopProcessing() error {
+	close(c.stop)
+	return nil
+}
+
+func (c *LongRunningChecker) check(ctx context.Context) error {
+	c.metrics.CheckTotal.Inc()
+	notificationsConfig := c.config.GetNotificationsConfig()
+	now := c.clock.Now()
+	for _, trigger := range notificationsConfig.Triggers {
+		if trigger.Type != runtimeInterfaces.NotificationTriggerTypeLongRunning || len(trigger.RecipientsEmail) == 0 {
+			continue
+		}
+		if err := c.checkTrigger(ctx, *notificationsConfig, trigger, now); err != nil {
+			return err
+		}
+	}
+	return c.checkLaunchPlanTriggers(ctx, *notificationsConfig, now)
+}
+
+// Returns the key recording that a trigger was handled for an execution. Triggers are identified by their content, so
+// that a changed trigger is evaluated anew.
+func getTriggerKey(source string, trigger runtimeInterfaces.NotificationTrigger) (string, error) {
+	serializedTrigger, err := json.Marshal(trigger)
+	if err != nil {
+		return "", err
+	}
+	digest := sha256.Sum256(serializedTrigger)
+	return fmt.Sprintf("%s/%s", source, hex.EncodeToString(digest[:])), nil
+}
+
+// Calls handle for every non-terminal execution matching the input, page by page. Executions which can't be read are
+// recorded as handled, so that they aren't listed again.
+func (c *LongRunningChecker) forEachUnhandledExecution(ctx context.Context,
+	input repoInterfaces.ListUnhandledExecutionsInput, handle func(models.ExecutionKey, *admin.Execution)) error {
+	input.Phases = nonTerminalExecutionPhases
+	input.Limit = longRunningCheckerPageSize
+	for {
+		executionModels, err := c.db.LongRunningNotificationRepo().ListUnhandledExecutions(ctx, input)
+		if err != nil {
+			return err
+		}
+		for _, executionModel := range executionModels {
+			execution, err := transformers.FromExecutionModel(executionModel)
+			if err != nil {
+				logger.Warnf(ctx, "failed to transform execution [%+v] with err: %v", executionModel.ExecutionKey, err)
+				c.markHandled(ctx, executionModel.ExecutionKey, input.TriggerKey)
+				continue
+			}
+			handle(executionModel.ExecutionKey, execution)
+		}
+		if len(executionModels) < longRunningCheckerPageSize {
+			return nil
+		}
+		last := executionModels[len(executionModels)-1]
+		if last.ExecutionCreatedAt == nil {
+			return fmt.Errorf("execution [%+v] has no creation time", last.ExecutionKey)
+		}
+		input.After = &repoInterfaces.ExecutionCursor{CreatedAt: *last.ExecutionCreatedAt, ID: last.ID}
+	}
+}
+
+// Records that the trigger was handled for the execution, and returns whether it wasn't already.
+func (c *LongRunningChecker) record(ctx context.Context, executionKey models.ExecutionKey, triggerKey string) (
+	bool, error) {
+	err := c.db.LongRunningNotificationRepo().Create(ctx, models.LongRunningNotification{
+		ExecutionKey: executionKey,
+		TriggerKey:   triggerKey,
+	})
+	if err == nil {
+		return true, nil
+	}
+	if flyteAdminError, ok := err.(errors.FlyteAdminError); ok && flyteAdminError.Code() == codes.AlreadyExists {
+		return false, nil
+	}
+	logger.Warnf(ctx, "failed to record trigger [%s] of execution [%+v] with err: %v", triggerKey, executionKey, err)
+	return false, err
+}
+
+// Records that the trigger was handled for the execution without publishing a notification. When that fails, the
+// execution is listed again by the next check.
+func (c *LongRunningChecker) markHandled(ctx context.Context, executionKey models.ExecutionKey, triggerKey string) {
+	_, _ = c.record(ctx, executionKey, triggerKey)
+}
+
+// Publishes the notification of the trigger unless it was published already, and returns whether the trigger is
+// handled. The trigger is recorded before the notification is published, so that concurrent checkers don't both
+// publish it, and the record is removed again when publishing fails, so that the next check retries it.
+func (c *LongRunningChecker) notify(ctx context.Context, config runtimeInterfaces.NotificationsConfig,
+	trigger runtimeInterfaces.NotificationTrigger, triggerKey string, executionKey models.ExecutionKey,
+	execution *admin.Execution, now time.Time) bool {
+	recorded, err := c.record(ctx, executionKey, triggerKey)
+	if err != nil {
+		return false
+	}
+	if !recorded {
+		return true
+	}
+	if err := c.publish(ctx, config, trigger, execution, now); err != nil {
+		record := models.LongRunningNotification{ExecutionKey: executionKey, TriggerKey: triggerKey}
+		if err := c.db.LongRunningNotificationRepo().Delete(ctx, record); err != nil {
+			logger.Errorf(ctx, "failed to remove the record of trigger [%s] of execution [%+v] with err: %v",
+				triggerKey, executionKey, err)
+		}
+		return false
+	}
+	return true
+}
+
+func (c *LongRunningChecker) checkTrigger(ctx context.Context, config runtimeInterfaces.NotificationsConfig,
+	trigger runtimeInterfaces.NotificationTrigger, now time.Time) error {
+	triggerKey, err := getTriggerKey("config", trigger)
+	if err != nil {
+		return err
+	}
+	return c.forEachUnhandledExecution(ctx, repoInterfaces.ListUnhandledExecutionsInput{
+		TriggerKey:    triggerKey,
+		CreatedBefore: now.Add(-trigger.RunningThreshold.Duration),
+		Project:       trigger.Project,
+		Domain:        trigger.Domain,
+	}, func(executionKey models.ExecutionKey, execution *admin.Execution) {
+		// Executions the trigger doesn't apply to are recorded too, so that they aren't listed again.
+		if !MatchesTrigger(trigger, execution) {
+			c.markHandled(ctx, executionKey, triggerKey)
+			return
+		}
+		c.notify(ctx, config, trigger, triggerKey, executionKey, execution, now)
+	})
+}
+
+// The longRunning triggers declared by launch plans aren't known up front, so the non-terminal executions are matched
+// against the triggers of their launch plans. Once all of these are handled, which is right away for launch plans
+// without any, the execution is recorded with launchPlanTriggersKey so that it isn't listed again.
+func (c *LongRunningChecker) checkLaunchPlanTriggers(ctx context.Context, config runtimeInterfaces.NotificationsConfig,
+	now time.Time) error {
+	if !config.LaunchPlanTriggersEnabled || config.LongRunningChecker.MaxLaunchPlanThreshold.Duration <= 0 {
+		return nil
+	}
+	// Executions of the same launch plan version share its triggers.
+	launchPlanTriggers := make(map[string][]runtimeInterfaces.NotificationTrigger)
+	return c.forEachUnhandledExecution(ctx, repoInterfaces.ListUnhandledExecutionsInput{
+		TriggerKey:    launchPlanTriggersKey,
+		CreatedBefore: now,
+	}, func(executionKey models.ExecutionKey, execution *admin.Execution) {
+		createdAt := execution.GetClosure().GetCreatedAt()
+		if createdAt == nil {
+			c.markHandled(ctx, executionKey, launchPlanTriggersKey)
+			return
+		}
+		launchPlanKey := execution.GetSpec().GetLaunchPlan().String()
+		triggers, ok := launchPlanTriggers[launchPlanKey]
+		if !ok {
+			var err error
+			triggers, err = GetLaunchPlanNotificationTriggers(ctx, c.db, execution)
+			if err != nil {
+				// The execution is checked again once the triggers can be read.
+				logger.Warnf(ctx, "failed to get the notification triggers of launch plan [%s] with err: %v",
+					launchPlanKey, err)
+				return
+			}
+			launchPlanTriggers[launchPlanKey] = triggers
+		}
+		created := time.Unix(createdAt.Seconds, int64(createdAt.Nanos))
+		handled := true
+		for _, trigger := range GetMatchingTriggers(
+			triggers, runtimeInterfaces.NotificationTriggerTypeLongRunning, execution, "") {
+			if created.After(now.Add(-trigger.RunningThreshold.Duration)) {
+				handled = false
+				continue
+			}
+			triggerKey, err := getTriggerKey("launch-plan", trigger)
+			if err != nil {
+				logger.Warnf(ctx, "failed to identify a notification trigger of launch plan [%s] with err: %v",
+					launchPlanKey, err)
+				continue
+			}
+			if !c.notify(ctx, config, trigger, triggerKey, executionKey, execution, now) {
+				handled = false
+			}
+		}
+		if handled {
+			c.markHandled(ctx, executionKey, launchPlanTriggersKey)
+		}
+	})
+}
+
+func (c *LongRunningChecker) publish(ctx context.Context, config runtimeInterfaces.NotificationsConfig,
+	trigger runtimeInterfaces.NotificationTrigger, execution *admin.Execution, now time.Time) error {
+	ctx = contextutils.WithExecutionID(ctx, execution.GetId().GetName())
+	ctx = contextutils.WithProjectDomain(ctx, execution.GetId().GetProject(), execution.GetId().GetDomain())
+	var runningDuration time.Duration
+	if createdAt := execution.GetClosure().GetCreatedAt(); createdAt != nil {
+		runningDuration = now.Sub(time.Unix(createdAt.Seconds, int64(createdAt.Nanos)))
+	}
+	email := ToEmailMessageFromTriggerEvent(config, trigger, TriggerEvent{
+		Execution:       execution,
+		RunningDuration: runningDuration,
+	})
+	c.metrics.NotificationsTotal.Inc()
+	if err := c.publisher.Publish(ctx, proto.MessageName(&admin.EmailNotification{}), email); err != nil {
+		c.metrics.PublishError.Inc()
+		logger.Infof(ctx, "error publishing long running notification for execution [%+v] with err: [%v]",
+			execution.Id, err)
+		return err
+	}
+	return nil
+}
+
+func newLongRunningChecker(db repositories.RepositoryInterface, config runtimeInterfaces.ApplicationConfiguration,
+	publisher interfaces.Publisher, scope promutils.Scope, clock clock.Clock) *LongRunningChecker {
+	return &LongRunningChecker{
+		db:        db,
+		config:    config,
+		publisher: publisher,
+		clock:     clock,
+		stop:      make(chan struct{}),
+		metrics: longRunningCheckerMetrics{
+			Scope:              scope,
+			CheckTotal:         scope.MustNewCounter("check_total", "overall count of long running execution checks"),
+			CheckError:         scope.MustNewCounter("check_error", "count of failed long running execution checks"),
+			NotificationsTotal: scope.MustNewCounter("notifications_total", "count of long running execution notifications"),
+			PublishError:       scope.MustNewCounter("publish_error", "count of long running notification publish errors"),
+		},
+	}
+}
+
+// NewLongRunningChecker returns a checker for longRunning notification triggers, or a no-op processor when the
+// checker is disabled in the notifications config.
+func NewLongRunningChecker(db repositories.RepositoryInterface, config runtimeInterfaces.ApplicationConfiguration,
+	publisher interfaces.Publisher, scope promutils.Scope) interfaces.Processor {
+	if !config.GetNotificationsConfig().LongRunningChecker.Enabled {
+		logger.Infof(context.Background(), "Long running execution checker is disabled")
+		return implementations.NewNoopProcess()
+	}
+	return newLongRunningChecker(db, config, publisher, scope.NewSubScope("long_running_checker"), clock.New())
+}
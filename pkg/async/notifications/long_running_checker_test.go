@@ -0,0 +1,274 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/mocks"
+	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/config"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+func getExecutionModelForCheckerTest(t *testing.T, id uint, name, launchPlanName string,
+	createdAt time.Time) models.Execution {
+	spec, err := proto.Marshal(&admin.ExecutionSpec{
+		LaunchPlan: &core.Identifier{
+			Project: executionProjectValue,
+			Domain:  executionDomainValue,
+			Name:    launchPlanName,
+		},
+	})
+	assert.NoError(t, err)
+	createdAtProto, _ := ptypes.TimestampProto(createdAt)
+	closure, err := proto.Marshal(&admin.ExecutionClosure{
+		Phase:     core.WorkflowExecution_RUNNING,
+		CreatedAt: createdAtProto,
+		WorkflowId: &core.Identifier{
+			Name: workflowNameValue,
+		},
+	})
+	assert.NoError(t, err)
+	return models.Execution{
+		BaseModel: models.BaseModel{ID: id},
+		ExecutionKey: models.ExecutionKey{
+			Project: executionProjectValue,
+			Domain:  executionDomainValue,
+			Name:    name,
+		},
+		Phase:              core.WorkflowExecution_RUNNING.String(),
+		Spec:               spec,
+		Closure:            closure,
+		ExecutionCreatedAt: &createdAt,
+	}
+}
+
+func getLongRunningNotificationForTest(name, triggerKey string) models.LongRunningNotification {
+	return models.LongRunningNotification{
+		ExecutionKey: models.ExecutionKey{
+			Project: executionProjectValue,
+			Domain:  executionDomainValue,
+			Name:    name,
+		},
+		TriggerKey: triggerKey,
+	}
+}
+
+func TestLongRunningChecker_Check(t *testing.T) {
+	mockClock := clock.NewMock()
+	mockClock.Set(time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC))
+	now := mockClock.Now()
+
+	trigger := runtimeInterfaces.NotificationTrigger{
+		Type:             runtimeInterfaces.NotificationTriggerTypeLongRunning,
+		Project:          executionProjectValue,
+		LaunchPlan:       launchPlanNameValue,
+		RunningThreshold: config.Duration{Duration: time.Hour},
+		RecipientsEmail:  recipients,
+	}
+	configProvider := &runtimeMocks.MockApplicationProvider{}
+	configProvider.SetNotificationsConfig(runtimeInterfaces.NotificationsConfig{
+		Triggers: []runtimeInterfaces.NotificationTrigger{
+			trigger,
+			{
+				Type:             runtimeInterfaces.NotificationTriggerTypeLongRunning,
+				RunningThreshold: config.Duration{Duration: time.Hour},
+			},
+			{
+				Type:            runtimeInterfaces.NotificationTriggerTypeNodeFailure,
+				RecipientsEmail: recipients,
+			},
+		},
+	})
+	triggerKey, err := getTriggerKey("config", trigger)
+	assert.NoError(t, err)
+
+	repository := repositoryMocks.NewMockRepository()
+	notificationRepo := repository.LongRunningNotificationRepo().(*repositoryMocks.LongRunningNotificationRepoInterface)
+	notificationRepo.OnListUnhandledExecutions(context.Background(), repoInterfaces.ListUnhandledExecutionsInput{
+		TriggerKey:    triggerKey,
+		Phases:        nonTerminalExecutionPhases,
+		CreatedBefore: now.Add(-time.Hour),
+		Project:       executionProjectValue,
+		Limit:         longRunningCheckerPageSize,
+	}).Return([]models.Execution{
+		getExecutionModelForCheckerTest(t, 1, "notified", launchPlanNameValue, now.Add(-time.Hour-time.Second)),
+		// Executions of other launch plans are recorded without a notification.
+		getExecutionModelForCheckerTest(t, 2, "other", "other", now.Add(-time.Hour-time.Second)),
+		// Executions notified concurrently aren't notified again.
+		getExecutionModelForCheckerTest(t, 3, "concurrent", launchPlanNameValue, now.Add(-time.Hour-time.Second)),
+		// Executions whose notification can't be published are retried by the next check.
+		getExecutionModelForCheckerTest(t, 4, "failed", launchPlanNameValue, now.Add(-time.Hour-time.Second)),
+	}, nil).Once()
+	notificationRepo.OnCreate(context.Background(), getLongRunningNotificationForTest("notified", triggerKey)).
+		Return(nil).Once()
+	notificationRepo.OnCreate(context.Background(), getLongRunningNotificationForTest("other", triggerKey)).
+		Return(nil).Once()
+	notificationRepo.OnCreate(context.Background(), getLongRunningNotificationForTest("concurrent", triggerKey)).
+		Return(adminErrors.NewFlyteAdminError(codes.AlreadyExists, "already exists")).Once()
+	notificationRepo.OnCreate(context.Background(), getLongRunningNotificationForTest("failed", triggerKey)).
+		Return(nil).Once()
+	notificationRepo.OnDelete(context.Background(), getLongRunningNotificationForTest("failed", triggerKey)).
+		Return(nil).Once()
+
+	var published []*admin.EmailMessage
+	publisher := mocks.MockPublisher{}
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		assert.Equal(t, "flyteidl.admin.EmailNotification", key)
+		if len(published) > 0 {
+			return errors.New("publish failed")
+		}
+		published = append(published, msg.(*admin.EmailMessage))
+		return nil
+	})
+
+	checker := newLongRunningChecker(repository, configProvider, &publisher, promutils.NewTestScope(), mockClock)
+	assert.NoError(t, checker.check(context.Background()))
+	notificationRepo.AssertExpectations(t)
+	assert.Len(t, published, 1)
+	assert.Equal(t, recipients, published[0].RecipientsEmail)
+	assert.Equal(t, "Execution notified is still running after 1h0m1s", published[0].SubjectLine)
+}
+
+func TestLongRunningChecker_Pages(t *testing.T) {
+	mockClock := clock.NewMock()
+	now := mockClock.Now()
+	trigger := runtimeInterfaces.NotificationTrigger{
+		Type:             runtimeInterfaces.NotificationTriggerTypeLongRunning,
+		LaunchPlan:       "other",
+		RunningThreshold: config.Duration{Duration: time.Hour},
+		RecipientsEmail:  recipients,
+	}
+	configProvider := &runtimeMocks.MockApplicationProvider{}
+	configProvider.SetNotificationsConfig(runtimeInterfaces.NotificationsConfig{
+		Triggers: []runtimeInterfaces.NotificationTrigger{trigger},
+	})
+
+	repository := repositoryMocks.NewMockRepository()
+	notificationRepo := repository.LongRunningNotificationRepo().(*repositoryMocks.LongRunningNotificationRepoInterface)
+	firstPage := make([]models.Execution, 0, longRunningCheckerPageSize)
+	for i := 0; i < longRunningCheckerPageSize; i++ {
+		firstPage = append(firstPage, getExecutionModelForCheckerTest(t, uint(i+1), fmt.Sprintf("e%d", i),
+			launchPlanNameValue, now.Add(-2*time.Hour)))
+	}
+	lastCreatedAt := now.Add(-2 * time.Hour)
+	notificationRepo.OnListUnhandledExecutionsMatch(context.Background(), mock.MatchedBy(
+		func(input repoInterfaces.ListUnhandledExecutionsInput) bool {
+			return input.After == nil
+		})).Return(firstPage, nil).Once()
+	// The next page starts after the last execution of the first one.
+	notificationRepo.OnListUnhandledExecutionsMatch(context.Background(), mock.MatchedBy(
+		func(input repoInterfaces.ListUnhandledExecutionsInput) bool {
+			return input.After != nil && input.After.ID == longRunningCheckerPageSize &&
+				input.After.CreatedAt.Equal(lastCreatedAt)
+		})).Return([]models.Execution{}, nil).Once()
+	notificationRepo.OnCreateMatch(mock.Anything, mock.Anything).Return(nil)
+
+	checker := newLongRunningChecker(repository, configProvider, &mocks.MockPublisher{}, promutils.NewTestScope(),
+		mockClock)
+	assert.NoError(t, checker.check(context.Background()))
+	notificationRepo.AssertExpectations(t)
+	notificationRepo.AssertNumberOfCalls(t, "Create", longRunningCheckerPageSize)
+}
+
+func TestNewLongRunningChecker_Disabled(t *testing.T) {
+	configProvider := &runtimeMocks.MockApplicationProvider{}
+	checker := NewLongRunningChecker(repositoryMocks.NewMockRepository(), configProvider, &mocks.MockPublisher{},
+		promutils.NewTestScope())
+	_, ok := checker.(*LongRunningChecker)
+	assert.False(t, ok)
+}
+
+func TestLongRunningChecker_CheckLaunchPlanTriggers(t *testing.T) {
+	mockClock := clock.NewMock()
+	mockClock.Set(time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC))
+	now := mockClock.Now()
+
+	configProvider := &runtimeMocks.MockApplicationProvider{}
+	configProvider.SetNotificationsConfig(runtimeInterfaces.NotificationsConfig{
+		LaunchPlanTriggersEnabled: true,
+		LongRunningChecker: runtimeInterfaces.LongRunningCheckerConfig{
+			MaxLaunchPlanThreshold: config.Duration{Duration: 24 * time.Hour},
+		},
+	})
+
+	repository := repositoryMocks.NewMockRepository()
+	notificationRepo := repository.LongRunningNotificationRepo().(*repositoryMocks.LongRunningNotificationRepoInterface)
+	notificationRepo.OnListUnhandledExecutions(context.Background(), repoInterfaces.ListUnhandledExecutionsInput{
+		TriggerKey:    launchPlanTriggersKey,
+		Phases:        nonTerminalExecutionPhases,
+		CreatedBefore: now,
+		Limit:         longRunningCheckerPageSize,
+	}).Return([]models.Execution{
+		getExecutionModelForCheckerTest(t, 1, "notified", launchPlanNameValue, now.Add(-2*time.Hour-time.Second)),
+		// The execution is listed again until it crosses the threshold.
+		getExecutionModelForCheckerTest(t, 2, "pending", launchPlanNameValue, now.Add(-time.Hour)),
+		// Executions of launch plans without triggers are recorded right away.
+		getExecutionModelForCheckerTest(t, 3, "other", "other", now.Add(-2*time.Hour-time.Second)),
+	}, nil).Once()
+	var launchPlanGets int
+	var launchPlanTrigger runtimeInterfaces.NotificationTrigger
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetGetCallback(
+		func(input repoInterfaces.Identifier) (models.LaunchPlan, error) {
+			launchPlanGets++
+			launchPlanSpec := &admin.LaunchPlanSpec{}
+			if input.Name == launchPlanNameValue {
+				launchPlanSpec.Annotations = &admin.Annotations{
+					Values: map[string]string{
+						NotifyOnLongRunningAnnotation:          "oncall@example.com",
+						NotifyOnLongRunningThresholdAnnotation: "2h",
+					},
+				}
+				triggers, err := ParseLaunchPlanNotificationTriggers(core.Identifier{
+					Project: input.Project, Domain: input.Domain, Name: input.Name, Version: input.Version,
+				}, launchPlanSpec.Annotations.Values)
+				assert.NoError(t, err)
+				launchPlanTrigger = triggers[0]
+			}
+			spec, err := proto.Marshal(launchPlanSpec)
+			assert.NoError(t, err)
+			return models.LaunchPlan{Spec: spec}, nil
+		})
+	notificationRepo.OnCreateMatch(context.Background(), mock.MatchedBy(func(input models.LongRunningNotification) bool {
+		triggerKey, err := getTriggerKey("launch-plan", launchPlanTrigger)
+		assert.NoError(t, err)
+		return input.Name == "notified" && input.TriggerKey == triggerKey
+	})).Return(nil).Once()
+	notificationRepo.OnCreate(context.Background(), getLongRunningNotificationForTest("notified", launchPlanTriggersKey)).
+		Return(nil).Once()
+	notificationRepo.OnCreate(context.Background(), getLongRunningNotificationForTest("other", launchPlanTriggersKey)).
+		Return(nil).Once()
+
+	var published []*admin.EmailMessage
+	publisher := mocks.MockPublisher{}
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		published = append(published, msg.(*admin.EmailMessage))
+		return nil
+	})
+
+	checker := newLongRunningChecker(repository, configProvider, &publisher, promutils.NewTestScope(), mockClock)
+	assert.NoError(t, checker.check(context.Background()))
+	notificationRepo.AssertExpectations(t)
+	notificationRepo.AssertNumberOfCalls(t, "Create", 3)
+	// The triggers of each launch plan are read once per check.
+	assert.Equal(t, 2, launchPlanGets)
+	assert.Len(t, published, 1)
+	assert.Equal(t, recipients, published[0].RecipientsEmail)
+	assert.Equal(t, "Execution notified is still running after 2h0m1s", published[0].SubjectLine)
+}
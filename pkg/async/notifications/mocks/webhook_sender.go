@@ -0,0 +1,24 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+)
+
+type SendWebhookFunc func(ctx context.Context, message interfaces.WebhookMessage) error
+
+type MockWebhookSender struct {
+	sendWebhookFunc SendWebhookFunc
+}
+
+func (m *MockWebhookSender) SetSendWebhookFunc(sendWebhook SendWebhookFunc) {
+	m.sendWebhookFunc = sendWebhook
+}
+
+func (m *MockWebhookSender) SendWebhook(ctx context.Context, message interfaces.WebhookMessage) error {
+	if m.sendWebhookFunc != nil {
+		return m.sendWebhookFunc(ctx, message)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package notifications
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+)
+
+const nodeID = "node_id"
+const taskProject = "task.project"
+const taskDomain = "task.domain"
+const taskName = "task.name"
+const taskVersion = "task.version"
+const retryAttempt = "retry_attempt"
+const runningDuration = "running_duration"
+
+const defaultTaskRetriesExhaustedSubject = "Task {{ task.name }} in execution {{ name }} exhausted its retries"
+const defaultTaskRetriesExhaustedBody = "Task {{ task.name }} in execution {{ project }}/{{ domain }}/{{ name }} " +
+	"failed on retry attempt {{ retry_attempt }} and will not be retried.{{ error }}"
+const defaultNodeFailureSubject = "Node {{ node_id }} in execution {{ name }} failed"
+const defaultNodeFailureBody = "Node {{ node_id }} in execution {{ project }}/{{ domain }}/{{ name }} failed.{{ error }}"
+const defaultLongRunningSubject = "Execution {{ name }} is still running after {{ running_duration }}"
+const defaultLongRunningBody = "Execution {{ project }}/{{ domain }}/{{ name }} of launch plan {{ launch_plan.name }} " +
+	"is still in phase {{ phase }} after {{ running_duration }}."
+
+type triggerTemplate struct {
+	subject string
+	body    string
+}
+
+var defaultTriggerTemplates = map[runtimeInterfaces.NotificationTriggerType]triggerTemplate{
+	runtimeInterfaces.NotificationTriggerTypeTaskRetriesExhausted: {
+		subject: defaultTaskRetriesExhaustedSubject,
+		body:    defaultTaskRetriesExhaustedBody,
+	},
+	runtimeInterfaces.NotificationTriggerTypeNodeFailure: {
+		subject: defaultNodeFailureSubject,
+		body:    defaultNodeFailureBody,
+	},
+	runtimeInterfaces.NotificationTriggerTypeLongRunning: {
+		subject: defaultLongRunningSubject,
+		body:    defaultLongRunningBody,
+	},
+}
+
+// TriggerEvent describes the execution state which fired a notification trigger.
+type TriggerEvent struct {
+	Execution *admin.Execution
+	// Set for nodeFailure and taskRetriesExhausted triggers.
+	NodeID string
+	// Set for taskRetriesExhausted triggers.
+	TaskID       *core.Identifier
+	RetryAttempt uint32
+	Error        *core.ExecutionError
+	// Set for longRunning triggers.
+	RunningDuration time.Duration
+}
+
+func matchesTriggerValue(expected, actual string) bool {
+	return len(expected) == 0 || expected == actual
+}
+
+// MatchesTrigger returns whether the trigger applies to the execution. Empty trigger fields match all values.
+func MatchesTrigger(trigger runtimeInterfaces.NotificationTrigger, execution *admin.Execution) bool {
+	return matchesTriggerValue(trigger.Project, execution.GetId().GetProject()) &&
+		matchesTriggerValue(trigger.Domain, execution.GetId().GetDomain()) &&
+		matchesTriggerValue(trigger.Workflow, execution.GetClosure().GetWorkflowId().GetName()) &&
+		matchesTriggerValue(trigger.LaunchPlan, execution.GetSpec().GetLaunchPlan().GetName())
+}
+
+// GetMatchingTriggers returns the configured triggers of the given type which apply to the execution and, for
+// nodeFailure triggers, to the failed node.
+func GetMatchingTriggers(triggers []runtimeInterfaces.NotificationTrigger,
+	triggerType runtimeInterfaces.NotificationTriggerType, execution *admin.Execution,
+	nodeID string) []runtimeInterfaces.NotificationTrigger {
+	var matching []runtimeInterfaces.NotificationTrigger
+	for _, trigger := range triggers {
+		if trigger.Type != triggerType || len(trigger.RecipientsEmail) == 0 || !MatchesTrigger(trigger, execution) {
+			continue
+		}
+		if triggerType == runtimeInterfaces.NotificationTriggerTypeNodeFailure && len(trigger.NodeIDs) > 0 {
+			var matchNode bool
+			for _, id := range trigger.NodeIDs {
+				if id == nodeID {
+					matchNode = true
+					break
+				}
+			}
+			if !matchNode {
+				continue
+			}
+		}
+		matching = append(matching, trigger)
+	}
+	return matching
+}
+
+func getTriggerTemplateValues(triggerEvent TriggerEvent) map[string]string {
+	return map[string]string{
+		nodeID:          triggerEvent.NodeID,
+		taskProject:     triggerEvent.TaskID.GetProject(),
+		taskDomain:      triggerEvent.TaskID.GetDomain(),
+		taskName:        triggerEvent.TaskID.GetName(),
+		taskVersion:     triggerEvent.TaskID.GetVersion(),
+		retryAttempt:    strconv.FormatUint(uint64(triggerEvent.RetryAttempt), 10),
+		runningDuration: triggerEvent.RunningDuration.Truncate(time.Second).String(),
+	}
+}
+
+func substituteTriggerParameters(message string, triggerEvent TriggerEvent) string {
+	for template, value := range getTriggerTemplateValues(triggerEvent) {
+		message = strings.Replace(message, fmt.Sprintf(substitutionParam, template), value, replaceAllInstances)
+		message = strings.Replace(message, fmt.Sprintf(substitutionParamNoSpaces, template), value, replaceAllInstances)
+	}
+	// The remaining execution-level parameters are resolved against the current state of the execution.
+	request := admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			ExecutionId: triggerEvent.Execution.Id,
+			Phase:       triggerEvent.Execution.GetClosure().GetPhase(),
+		},
+	}
+	if triggerEvent.Error != nil {
+		request.Event.OutputResult = &event.WorkflowExecutionEvent_Error{
+			Error: triggerEvent.Error,
+		}
+	}
+	return substituteEmailParameters(message, request, triggerEvent.Execution)
+}
+
+// Converts a fired notification trigger to an admin.EmailMessage proto, substituting parameters in the trigger
+// subject and body or in the defaults for the trigger type when those are unset.
+func ToEmailMessageFromTriggerEvent(
+	config runtimeInterfaces.NotificationsConfig,
+	trigger runtimeInterfaces.NotificationTrigger,
+	triggerEvent TriggerEvent) *admin.EmailMessage {
+	subject := trigger.Subject
+	if len(subject) == 0 {
+		subject = defaultTriggerTemplates[trigger.Type].subject
+	}
+	body := trigger.Body
+	if len(body) == 0 {
+		body = defaultTriggerTemplates[trigger.Type].body
+	}
+	return &admin.EmailMessage{
+		SubjectLine:     substituteTriggerParameters(subject, triggerEvent),
+		SenderEmail:     config.NotificationsEmailerConfig.Sender,
+		RecipientsEmail: trigger.RecipientsEmail,
+		Body:            substituteTriggerParameters(body, triggerEvent),
+	}
+}
@@ -0,0 +1,114 @@
+package notifications
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+var recipients = []string{"oncall@example.com"}
+
+func TestGetMatchingTriggers(t *testing.T) {
+	triggers := []runtimeInterfaces.NotificationTrigger{
+		{
+			Type:            runtimeInterfaces.NotificationTriggerTypeNodeFailure,
+			RecipientsEmail: recipients,
+		},
+		{
+			Type:            runtimeInterfaces.NotificationTriggerTypeNodeFailure,
+			Project:         executionProjectValue,
+			Domain:          executionDomainValue,
+			LaunchPlan:      launchPlanNameValue,
+			NodeIDs:         []string{"n1"},
+			RecipientsEmail: recipients,
+		},
+		{
+			Type:            runtimeInterfaces.NotificationTriggerTypeNodeFailure,
+			Workflow:        "other",
+			RecipientsEmail: recipients,
+		},
+		{
+			Type:    runtimeInterfaces.NotificationTriggerTypeNodeFailure,
+			Project: executionProjectValue,
+		},
+		{
+			Type:            runtimeInterfaces.NotificationTriggerTypeTaskRetriesExhausted,
+			RecipientsEmail: recipients,
+		},
+	}
+	t.Run("matching node", func(t *testing.T) {
+		matching := GetMatchingTriggers(
+			triggers, runtimeInterfaces.NotificationTriggerTypeNodeFailure, workflowExecution, "n1")
+		assert.Equal(t, triggers[0:2], matching)
+	})
+	t.Run("other node", func(t *testing.T) {
+		matching := GetMatchingTriggers(
+			triggers, runtimeInterfaces.NotificationTriggerTypeNodeFailure, workflowExecution, "n2")
+		assert.Equal(t, triggers[0:1], matching)
+	})
+	t.Run("task retries exhausted", func(t *testing.T) {
+		matching := GetMatchingTriggers(
+			triggers, runtimeInterfaces.NotificationTriggerTypeTaskRetriesExhausted, workflowExecution, "n2")
+		assert.Equal(t, triggers[4:], matching)
+	})
+	t.Run("long running", func(t *testing.T) {
+		matching := GetMatchingTriggers(
+			triggers, runtimeInterfaces.NotificationTriggerTypeLongRunning, workflowExecution, "")
+		assert.Empty(t, matching)
+	})
+}
+
+func TestToEmailMessageFromTriggerEvent(t *testing.T) {
+	notificationsConfig := runtimeInterfaces.NotificationsConfig{
+		NotificationsEmailerConfig: runtimeInterfaces.NotificationsEmailerConfig{
+			Sender: "no-reply@example.com",
+		},
+	}
+	t.Run("default templates", func(t *testing.T) {
+		emailMessage := ToEmailMessageFromTriggerEvent(notificationsConfig, runtimeInterfaces.NotificationTrigger{
+			Type:            runtimeInterfaces.NotificationTriggerTypeTaskRetriesExhausted,
+			RecipientsEmail: recipients,
+		}, TriggerEvent{
+			Execution: workflowExecution,
+			NodeID:    "n1",
+			TaskID: &core.Identifier{
+				ResourceType: core.ResourceType_TASK,
+				Name:         "my_task",
+			},
+			RetryAttempt: 2,
+			Error: &core.ExecutionError{
+				Message: "uh-oh",
+			},
+		})
+		assert.True(t, proto.Equal(emailMessage, &admin.EmailMessage{
+			RecipientsEmail: recipients,
+			SenderEmail:     "no-reply@example.com",
+			SubjectLine:     "Task my_task in execution e124 exhausted its retries",
+			Body: "Task my_task in execution proj/prod/e124 failed on retry attempt 2 and will not be retried." +
+				" The execution failed with error: [uh-oh].",
+		}), fmt.Sprintf("%+v", emailMessage))
+	})
+	t.Run("custom templates", func(t *testing.T) {
+		emailMessage := ToEmailMessageFromTriggerEvent(notificationsConfig, runtimeInterfaces.NotificationTrigger{
+			Type:            runtimeInterfaces.NotificationTriggerTypeLongRunning,
+			RecipientsEmail: recipients,
+			Subject:         "{{name}} is slow",
+			Body:            "{{ launch_plan.name }} has been {{ phase }} for {{ running_duration }}",
+		}, TriggerEvent{
+			Execution:       workflowExecution,
+			RunningDuration: 90*time.Minute + 3*time.Millisecond,
+		})
+		assert.True(t, proto.Equal(emailMessage, &admin.EmailMessage{
+			RecipientsEmail: recipients,
+			SenderEmail:     "no-reply@example.com",
+			SubjectLine:     "e124 is slow",
+			Body:            "lp_name has been succeeded for 1h30m0s",
+		}), fmt.Sprintf("%+v", emailMessage))
+	})
+}
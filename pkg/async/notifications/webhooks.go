@@ -0,0 +1,77 @@
+package notifications
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+const defaultWebhookBody = `{"project": "{{ project }}", "domain": "{{ domain }}", "name": "{{ name }}", ` +
+	`"phase": "{{ phase }}", "workflow": "{{ workflow.name }}", "launch_plan": "{{ launch_plan.name }}", ` +
+	`"error": "{{ error }}"}`
+
+func matchesWebhookPhase(webhook runtimeInterfaces.Webhook, phase core.WorkflowExecution_Phase) bool {
+	if len(webhook.Phases) == 0 {
+		return true
+	}
+	for _, webhookPhase := range webhook.Phases {
+		if strings.EqualFold(webhookPhase, phase.String()) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetMatchingWebhooks returns the configured webhooks which apply to the execution in its new phase.
+func GetMatchingWebhooks(webhooks []runtimeInterfaces.Webhook, execution *admin.Execution,
+	phase core.WorkflowExecution_Phase) []runtimeInterfaces.Webhook {
+	var matching []runtimeInterfaces.Webhook
+	for _, webhook := range webhooks {
+		if !matchesTriggerValue(webhook.Project, execution.GetId().GetProject()) ||
+			!matchesTriggerValue(webhook.Domain, execution.GetId().GetDomain()) ||
+			!matchesTriggerValue(webhook.Workflow, execution.GetClosure().GetWorkflowId().GetName()) ||
+			!matchesTriggerValue(webhook.LaunchPlan, execution.GetSpec().GetLaunchPlan().GetName()) ||
+			!matchesWebhookPhase(webhook, phase) {
+			continue
+		}
+		matching = append(matching, webhook)
+	}
+	return matching
+}
+
+// Escapes a value substituted into a JSON string.
+func escapeJSONString(value string) string {
+	escaped, _ := json.Marshal(value)
+	return string(escaped[1 : len(escaped)-1])
+}
+
+func substituteWebhookParameters(body string, request admin.WorkflowExecutionEventRequest,
+	execution *admin.Execution) string {
+	for template, function := range getTemplateValueFuncs {
+		value := escapeJSONString(function(request, execution))
+		body = strings.Replace(body, fmt.Sprintf(substitutionParam, template), value, replaceAllInstances)
+		body = strings.Replace(body, fmt.Sprintf(substitutionParamNoSpaces, template), value, replaceAllInstances)
+	}
+	return body
+}
+
+// Converts an execution event to a call of the given webhook, substituting parameters in the webhook body or in the
+// default body when that is unset.
+func ToWebhookMessageFromWorkflowExecutionEvent(
+	webhook runtimeInterfaces.Webhook,
+	request admin.WorkflowExecutionEventRequest,
+	execution *admin.Execution) interfaces.WebhookMessage {
+	body := webhook.Body
+	if len(body) == 0 {
+		body = defaultWebhookBody
+	}
+	return interfaces.WebhookMessage{
+		Webhook: webhook.Name,
+		Body:    substituteWebhookParameters(body, request, execution),
+	}
+}
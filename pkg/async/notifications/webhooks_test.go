@@ -0,0 +1,77 @@
+package notifications
+
+import (
+	"testing"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/stretchr/testify/assert"
+)
+
+var webhookExecution = &admin.Execution{
+	Id: &core.WorkflowExecutionIdentifier{
+		Project: "proj",
+		Domain:  "dev",
+		Name:    "e124",
+	},
+	Spec: &admin.ExecutionSpec{
+		LaunchPlan: &core.Identifier{
+			Project: "proj",
+			Domain:  "dev",
+			Name:    "lp_name",
+			Version: "lp_version",
+		},
+	},
+	Closure: &admin.ExecutionClosure{
+		WorkflowId: &core.Identifier{
+			Project: "proj",
+			Domain:  "dev",
+			Name:    "wf_name",
+			Version: "wf_version",
+		},
+	},
+}
+
+func TestGetMatchingWebhooks(t *testing.T) {
+	webhooks := []runtimeInterfaces.Webhook{
+		{Name: "all"},
+		{Name: "failed", Phases: []string{"failed"}},
+		{Name: "succeeded", Phases: []string{"SUCCEEDED"}},
+		{Name: "launch-plan", Project: "proj", LaunchPlan: "lp_name"},
+		{Name: "other-workflow", Workflow: "other"},
+	}
+	var names []string
+	for _, webhook := range GetMatchingWebhooks(webhooks, webhookExecution, core.WorkflowExecution_FAILED) {
+		names = append(names, webhook.Name)
+	}
+	assert.Equal(t, []string{"all", "failed", "launch-plan"}, names)
+}
+
+func TestToWebhookMessageFromWorkflowExecutionEvent(t *testing.T) {
+	request := admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase: core.WorkflowExecution_FAILED,
+			OutputResult: &event.WorkflowExecutionEvent_Error{
+				Error: &core.ExecutionError{
+					Message: "line\n\"quoted\"",
+				},
+			},
+		},
+	}
+	message := ToWebhookMessageFromWorkflowExecutionEvent(runtimeInterfaces.Webhook{
+		Name: "webhook",
+		Body: `{"execution": "{{ project }}/{{domain}}/{{ name }}", "error": "{{ error }}"}`,
+	}, request, webhookExecution)
+	assert.Equal(t, "webhook", message.Webhook)
+	assert.Equal(t, `{"execution": "proj/dev/e124", "error": " The execution failed with error: `+
+		`[line\n\"quoted\"]."}`, message.Body)
+
+	message = ToWebhookMessageFromWorkflowExecutionEvent(runtimeInterfaces.Webhook{
+		Name: "webhook",
+	}, request, webhookExecution)
+	assert.Equal(t, `{"project": "proj", "domain": "dev", "name": "e124", "phase": "failed", `+
+		`"workflow": "wf_name", "launch_plan": "lp_name", "error": " The execution failed with error: `+
+		`[line\n\"quoted\"]."}`, message.Body)
+}
@@ -84,7 +84,11 @@ func NewWorkflowScheduler(db repositories.RepositoryInterface, cfg WorkflowSched
 	case common.Local:
 		logger.Infof(context.Background(),
 			"Using default flyte scheduler implementation")
-		eventScheduler = flytescheduler.New(db)
+		var scheduleUpdatesConfig runtimeInterfaces.ScheduleUpdatesConfig
+		if flyteSchedulerConfig := cfg.SchedulerConfig.EventSchedulerConfig.GetFlyteSchedulerConfig(); flyteSchedulerConfig != nil {
+			scheduleUpdatesConfig = flyteSchedulerConfig.GetScheduleUpdates()
+		}
+		eventScheduler = flytescheduler.New(db, scheduleUpdatesConfig)
 	default:
 		logger.Infof(context.Background(),
 			"Using default noop event scheduler implementation for cloud provider type [%s]",
@@ -18,6 +18,12 @@ type AddScheduleInput struct {
 	Payload *string
 	// Optional: The application-wide prefix to be applied for schedule names.
 	ScheduleNamePrefix string
+	// Optional: Which of the schedule times missed while the scheduler was down are fired. Only supported by the
+	// native scheduler.
+	CatchupPolicy string
+	// Optional: Comma separated names of the calendars whose days the schedule doesn't fire on. Only supported by the
+	// native scheduler.
+	ExclusionCalendars string
 }
 
 type RemoveScheduleInput struct {
@@ -7,16 +7,17 @@ import (
 type Entity = string
 
 const (
-	Execution           = "e"
-	LaunchPlan          = "l"
-	NodeExecution       = "ne"
-	NodeExecutionEvent  = "nee"
-	Task                = "t"
-	TaskExecution       = "te"
-	Workflow            = "w"
-	NamedEntity         = "nen"
-	NamedEntityMetadata = "nem"
-	Project             = "p"
+	Execution            = "e"
+	LaunchPlan           = "l"
+	NodeExecution        = "ne"
+	NodeExecutionEvent   = "nee"
+	Task                 = "t"
+	TaskExecution        = "te"
+	Workflow             = "w"
+	NamedEntity          = "nen"
+	NamedEntityMetadata  = "nem"
+	Project              = "p"
+	NotificationDelivery = "nd"
 )
 
 // ResourceTypeToEntity maps a resource type to an entity suitable for use with Database filters
@@ -2,6 +2,7 @@ package common
 
 import (
 	"math/rand"
+	"sort"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 )
@@ -52,6 +53,18 @@ func IsExecutionTerminal(phase core.WorkflowExecution_Phase) bool {
 	return terminalExecutionPhases[phase]
 }
 
+// GetTerminalExecutionPhases returns the phases in which workflow executions no longer run, in enum order.
+func GetTerminalExecutionPhases() []core.WorkflowExecution_Phase {
+	phases := make([]core.WorkflowExecution_Phase, 0, len(terminalExecutionPhases))
+	for phase := range terminalExecutionPhases {
+		phases = append(phases, phase)
+	}
+	sort.Slice(phases, func(i, j int) bool {
+		return phases[i] < phases[j]
+	})
+	return phases
+}
+
 func IsNodeExecutionTerminal(phase core.NodeExecution_Phase) bool {
 	return terminalNodeExecutionPhases[phase]
 }
@@ -0,0 +1,153 @@
+package common
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+const (
+	// Launch plan annotation naming the upstream launch plan whose executions trigger the launch plan, either as
+	// project/domain/name or as a name in the project and domain of the launch plan.
+	TriggerOnLaunchPlanAnnotation = "flyte.org/trigger-on-launch-plan"
+	// Launch plan annotation listing the comma separated terminal phases of the upstream executions which trigger the
+	// launch plan. Defaults to SUCCEEDED.
+	TriggerOnPhasesAnnotation = "flyte.org/trigger-on-phases"
+	// Launch plan annotation binding outputs of the upstream execution to inputs of the triggered execution, as comma
+	// separated input=output pairs.
+	TriggerInputsAnnotation = "flyte.org/trigger-inputs"
+	// Execution annotation recording how many triggers fired in a row to create the execution.
+	TriggerDepthAnnotation = "flyte.org/trigger-depth"
+)
+
+// Declares that executions of a launch plan are created whenever an execution of the upstream launch plan reaches one
+// of the trigger phases.
+type LaunchPlanTrigger struct {
+	// The upstream launch plan. The trigger applies to executions of all its versions.
+	Upstream core.Identifier
+	Phases   []core.WorkflowExecution_Phase
+	// Maps inputs of the triggered launch plan to the upstream execution outputs they are bound to.
+	Inputs map[string]string
+}
+
+// Returns whether an upstream execution reaching the phase fires the trigger.
+func (t LaunchPlanTrigger) FiresOn(phase core.WorkflowExecution_Phase) bool {
+	for _, triggerPhase := range t.Phases {
+		if triggerPhase == phase {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the trigger phases in their annotation form.
+func (t LaunchPlanTrigger) PhasesString() string {
+	phases := make([]string, len(t.Phases))
+	for i, phase := range t.Phases {
+		phases[i] = phase.String()
+	}
+	return strings.Join(phases, ",")
+}
+
+// Returns the input bindings in their annotation form, sorted by input name.
+func (t LaunchPlanTrigger) InputsString() string {
+	bindings := make([]string, 0, len(t.Inputs))
+	for input, output := range t.Inputs {
+		bindings = append(bindings, fmt.Sprintf("%s=%s", input, output))
+	}
+	sort.Strings(bindings)
+	return strings.Join(bindings, ",")
+}
+
+// Parses the trigger declared by the annotations of the identified launch plan. Returns nil when the launch plan
+// doesn't declare one.
+func ParseLaunchPlanTrigger(launchPlanID core.Identifier, annotations map[string]string) (*LaunchPlanTrigger, error) {
+	upstream, ok := annotations[TriggerOnLaunchPlanAnnotation]
+	if !ok {
+		for _, key := range []string{TriggerOnPhasesAnnotation, TriggerInputsAnnotation} {
+			if _, ok := annotations[key]; ok {
+				return nil, fmt.Errorf("annotation [%s] requires the [%s] annotation", key, TriggerOnLaunchPlanAnnotation)
+			}
+		}
+		return nil, nil
+	}
+	trigger := &LaunchPlanTrigger{
+		Upstream: core.Identifier{
+			ResourceType: core.ResourceType_LAUNCH_PLAN,
+			Project:      launchPlanID.Project,
+			Domain:       launchPlanID.Domain,
+		},
+		Inputs: map[string]string{},
+	}
+	parts := strings.Split(strings.TrimSpace(upstream), "/")
+	switch len(parts) {
+	case 1:
+		trigger.Upstream.Name = parts[0]
+	case 3:
+		trigger.Upstream.Project, trigger.Upstream.Domain, trigger.Upstream.Name = parts[0], parts[1], parts[2]
+	default:
+		return nil, fmt.Errorf("invalid upstream launch plan [%s], expected project/domain/name or name", upstream)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("invalid upstream launch plan [%s], expected project/domain/name or name", upstream)
+		}
+	}
+
+	phases := annotations[TriggerOnPhasesAnnotation]
+	if strings.TrimSpace(phases) == "" {
+		phases = core.WorkflowExecution_SUCCEEDED.String()
+	}
+	for _, phaseName := range strings.Split(phases, ",") {
+		phaseName = strings.ToUpper(strings.TrimSpace(phaseName))
+		phaseValue, ok := core.WorkflowExecution_Phase_value[phaseName]
+		if !ok || !IsExecutionTerminal(core.WorkflowExecution_Phase(phaseValue)) {
+			return nil, fmt.Errorf("invalid trigger phase [%s], expected one of SUCCEEDED, FAILED, ABORTED, TIMED_OUT",
+				phaseName)
+		}
+		phase := core.WorkflowExecution_Phase(phaseValue)
+		if !trigger.FiresOn(phase) {
+			trigger.Phases = append(trigger.Phases, phase)
+		}
+	}
+
+	if inputs := strings.TrimSpace(annotations[TriggerInputsAnnotation]); inputs != "" {
+		for _, binding := range strings.Split(inputs, ",") {
+			pair := strings.Split(binding, "=")
+			if len(pair) != 2 || strings.TrimSpace(pair[0]) == "" || strings.TrimSpace(pair[1]) == "" {
+				return nil, fmt.Errorf("invalid trigger input binding [%s], expected input=output", binding)
+			}
+			input := strings.TrimSpace(pair[0])
+			if _, ok := trigger.Inputs[input]; ok {
+				return nil, fmt.Errorf("trigger input [%s] is bound more than once", input)
+			}
+			trigger.Inputs[input] = strings.TrimSpace(pair[1])
+		}
+	}
+	return trigger, nil
+}
+
+// Binds the outputs of an upstream execution to the inputs of the triggered execution.
+func GetTriggerInputs(trigger LaunchPlanTrigger, outputs *core.LiteralMap) (*core.LiteralMap, error) {
+	inputs := &core.LiteralMap{Literals: map[string]*core.Literal{}}
+	for input, output := range trigger.Inputs {
+		literal, ok := outputs.GetLiterals()[output]
+		if !ok {
+			return nil, fmt.Errorf("upstream execution has no output [%s] to bind to input [%s]", output, input)
+		}
+		inputs.Literals[input] = literal
+	}
+	return inputs, nil
+}
+
+// Returns the trigger depth recorded in the annotations of an execution, zero for executions which weren't triggered.
+func GetTriggerDepth(annotations map[string]string) int {
+	depth, err := strconv.Atoi(annotations[TriggerDepthAnnotation])
+	if err != nil || depth < 0 {
+		return 0
+	}
+	return depth
+}
@@ -0,0 +1,91 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyteidl/clients/go/coreutils"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+)
+
+var triggeredLaunchPlanID = core.Identifier{
+	ResourceType: core.ResourceType_LAUNCH_PLAN,
+	Project:      "project",
+	Domain:       "domain",
+	Name:         "downstream",
+	Version:      "v1",
+}
+
+func TestParseLaunchPlanTrigger(t *testing.T) {
+	trigger, err := ParseLaunchPlanTrigger(triggeredLaunchPlanID, map[string]string{
+		TriggerOnLaunchPlanAnnotation: "upstream",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &LaunchPlanTrigger{
+		Upstream: core.Identifier{
+			ResourceType: core.ResourceType_LAUNCH_PLAN,
+			Project:      "project",
+			Domain:       "domain",
+			Name:         "upstream",
+		},
+		Phases: []core.WorkflowExecution_Phase{core.WorkflowExecution_SUCCEEDED},
+		Inputs: map[string]string{},
+	}, trigger)
+
+	trigger, err = ParseLaunchPlanTrigger(triggeredLaunchPlanID, map[string]string{
+		TriggerOnLaunchPlanAnnotation: "other/production/upstream",
+		TriggerOnPhasesAnnotation:     "failed, TIMED_OUT",
+		TriggerInputsAnnotation:       "report = summary,count=rows",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "other", trigger.Upstream.Project)
+	assert.Equal(t, "production", trigger.Upstream.Domain)
+	assert.Equal(t, "upstream", trigger.Upstream.Name)
+	assert.True(t, trigger.FiresOn(core.WorkflowExecution_TIMED_OUT))
+	assert.False(t, trigger.FiresOn(core.WorkflowExecution_SUCCEEDED))
+	assert.Equal(t, "FAILED,TIMED_OUT", trigger.PhasesString())
+	assert.Equal(t, "count=rows,report=summary", trigger.InputsString())
+
+	trigger, err = ParseLaunchPlanTrigger(triggeredLaunchPlanID, map[string]string{"team": "data"})
+	assert.NoError(t, err)
+	assert.Nil(t, trigger)
+}
+
+func TestParseLaunchPlanTrigger_Invalid(t *testing.T) {
+	for _, annotations := range []map[string]string{
+		{TriggerOnLaunchPlanAnnotation: "domain/upstream"},
+		{TriggerOnLaunchPlanAnnotation: "project//upstream"},
+		{TriggerOnLaunchPlanAnnotation: "upstream", TriggerOnPhasesAnnotation: "RUNNING"},
+		{TriggerOnLaunchPlanAnnotation: "upstream", TriggerOnPhasesAnnotation: "DONE"},
+		{TriggerOnLaunchPlanAnnotation: "upstream", TriggerInputsAnnotation: "report"},
+		{TriggerOnLaunchPlanAnnotation: "upstream", TriggerInputsAnnotation: "report=summary,report=rows"},
+		{TriggerInputsAnnotation: "report=summary"},
+	} {
+		_, err := ParseLaunchPlanTrigger(triggeredLaunchPlanID, annotations)
+		assert.Error(t, err, annotations)
+	}
+}
+
+func TestGetTriggerInputs(t *testing.T) {
+	trigger := LaunchPlanTrigger{Inputs: map[string]string{"report": "summary"}}
+	outputs := &core.LiteralMap{
+		Literals: map[string]*core.Literal{
+			"summary": coreutils.MustMakeLiteral("all good"),
+			"rows":    coreutils.MustMakeLiteral(10),
+		},
+	}
+	inputs, err := GetTriggerInputs(trigger, outputs)
+	assert.NoError(t, err)
+	assert.Equal(t, &core.LiteralMap{
+		Literals: map[string]*core.Literal{"report": coreutils.MustMakeLiteral("all good")},
+	}, inputs)
+
+	_, err = GetTriggerInputs(LaunchPlanTrigger{Inputs: map[string]string{"report": "missing"}}, outputs)
+	assert.Error(t, err)
+}
+
+func TestGetTriggerDepth(t *testing.T) {
+	assert.Equal(t, 0, GetTriggerDepth(nil))
+	assert.Equal(t, 0, GetTriggerDepth(map[string]string{TriggerDepthAnnotation: "deep"}))
+	assert.Equal(t, 3, GetTriggerDepth(map[string]string{TriggerDepthAnnotation: "3"}))
+}
@@ -0,0 +1,14 @@
+package executioncluster
+
+import "time"
+
+// ClusterHealth is the state of an execution target as of its latest health probe.
+type ClusterHealth struct {
+	ID      string `json:"id"`
+	Healthy bool   `json:"healthy"`
+	// Error of the latest probe if it failed.
+	Error string `json:"error,omitempty"`
+	// Number of consecutive failed probes up to the latest one.
+	ConsecutiveFailures int       `json:"consecutiveFailures"`
+	LastProbedAt        time.Time `json:"lastProbedAt"`
+}
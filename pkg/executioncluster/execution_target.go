@@ -17,6 +17,21 @@ type ExecutionTargetSpec struct {
 	Domain      string
 	Workflow    string
 	LaunchPlan  string
+	// Targets which mustn't be selected, e.g. because creating the execution on them already failed.
+	ExcludedTargetIDs []string
+	// Optional target selected regardless of the label as long as it's enabled, healthy, not draining and not excluded,
+	// e.g. the cluster of the execution being recovered.
+	PreferredTargetID string
+}
+
+// IsExcluded returns whether the spec excludes the target from selection.
+func (s ExecutionTargetSpec) IsExcluded(id string) bool {
+	for _, excluded := range s.ExcludedTargetIDs {
+		if excluded == id {
+			return true
+		}
+	}
+	return false
 }
 
 // Client object of the target execution cluster
@@ -26,7 +41,9 @@ type ExecutionTarget struct {
 	Client        client.Client
 	DynamicClient dynamic.Interface
 	Enabled       bool
-	Config        restclient.Config
+	// Draining targets are assigned no new executions, but remain valid targets of the running ones.
+	Draining bool
+	Config   restclient.Config
 }
 
 func (e ExecutionTarget) Compare(to random.Comparable) bool {
@@ -37,6 +37,7 @@ func (c *clusterExecutionTargetProvider) GetExecutionTarget(initializationErrorC
 		DynamicClient: dynamicClient,
 		ID:            k8sCluster.Name,
 		Enabled:       k8sCluster.Enabled,
+		Draining:      k8sCluster.Draining,
 		Config:        *kubeConf,
 	}, nil
 }
@@ -0,0 +1,147 @@
+package impl
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster/interfaces"
+	runtime "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const clusterLabel = "cluster"
+
+type clusterHealthMetrics struct {
+	Scope         promutils.Scope
+	Healthy       *prometheus.GaugeVec
+	ProbeFailures *prometheus.CounterVec
+}
+
+// ClusterHealthChecker periodically probes the execution targets and tracks which of them are healthy. Targets are
+// healthy until they fail enough consecutive probes.
+type ClusterHealthChecker struct {
+	probe   interfaces.ClusterHealthProbe
+	config  runtime.ClusterHealthCheckConfig
+	metrics clusterHealthMetrics
+	lock    sync.RWMutex
+	health  map[string]executioncluster.ClusterHealth
+	// Called after a probe round which changed whether any of the targets is healthy.
+	onChange func()
+}
+
+// IsHealthy returns whether the target is healthy. Targets which weren't probed yet are healthy.
+func (c *ClusterHealthChecker) IsHealthy(id string) bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	health, ok := c.health[id]
+	return !ok || health.Healthy
+}
+
+// GetClusterHealth returns the health of the probed targets ordered by their ID.
+func (c *ClusterHealthChecker) GetClusterHealth() []executioncluster.ClusterHealth {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	clusterHealth := make([]executioncluster.ClusterHealth, 0, len(c.health))
+	for _, health := range c.health {
+		clusterHealth = append(clusterHealth, health)
+	}
+	sort.Slice(clusterHealth, func(i, j int) bool {
+		return clusterHealth[i].ID < clusterHealth[j].ID
+	})
+	return clusterHealth
+}
+
+// Records the result of a probe of the target and returns whether that changed whether it's healthy.
+func (c *ClusterHealthChecker) record(ctx context.Context, id string, probeErr error, probedAt time.Time) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	health, ok := c.health[id]
+	if !ok {
+		health = executioncluster.ClusterHealth{ID: id, Healthy: true}
+	}
+	wasHealthy := health.Healthy
+	health.LastProbedAt = probedAt
+	if probeErr != nil {
+		c.metrics.ProbeFailures.WithLabelValues(id).Inc()
+		health.Error = probeErr.Error()
+		health.ConsecutiveFailures++
+		if health.ConsecutiveFailures >= c.config.FailureThreshold {
+			health.Healthy = false
+		}
+	} else {
+		health.Error = ""
+		health.ConsecutiveFailures = 0
+		health.Healthy = true
+	}
+	c.health[id] = health
+	if health.Healthy {
+		c.metrics.Healthy.WithLabelValues(id).Set(1)
+	} else {
+		c.metrics.Healthy.WithLabelValues(id).Set(0)
+	}
+	if wasHealthy != health.Healthy {
+		if health.Healthy {
+			logger.Infof(ctx, "Execution cluster [%s] recovered", id)
+		} else {
+			logger.Warnf(ctx, "Execution cluster [%s] is unhealthy after %d failed probes: %s", id,
+				health.ConsecutiveFailures, health.Error)
+		}
+		return true
+	}
+	return false
+}
+
+// ProbeTargets probes the targets concurrently and records the results.
+func (c *ClusterHealthChecker) ProbeTargets(ctx context.Context, targets []executioncluster.ExecutionTarget) {
+	changed := make([]bool, len(targets))
+	var wg sync.WaitGroup
+	for idx, target := range targets {
+		wg.Add(1)
+		go func(idx int, target executioncluster.ExecutionTarget) {
+			defer wg.Done()
+			probeErr := c.probe.Probe(ctx, target)
+			changed[idx] = c.record(ctx, target.ID, probeErr, time.Now())
+		}(idx, target)
+	}
+	wg.Wait()
+	for _, targetChanged := range changed {
+		if targetChanged {
+			if c.onChange != nil {
+				c.onChange()
+			}
+			return
+		}
+	}
+}
+
+// Run probes the targets returned by getTargets every interval until the context is done.
+func (c *ClusterHealthChecker) Run(ctx context.Context, getTargets func() []executioncluster.ExecutionTarget) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		c.ProbeTargets(ctx, getTargets())
+	}, c.config.Interval.Duration)
+}
+
+// NewClusterHealthChecker returns a checker which probes the targets with the probe. onChange is called whenever a
+// target becomes unhealthy or recovers.
+func NewClusterHealthChecker(probe interfaces.ClusterHealthProbe, config runtime.ClusterHealthCheckConfig,
+	onChange func(), scope promutils.Scope) *ClusterHealthChecker {
+	return &ClusterHealthChecker{
+		probe:    probe,
+		config:   config,
+		health:   make(map[string]executioncluster.ClusterHealth),
+		onChange: onChange,
+		metrics: clusterHealthMetrics{
+			Scope: scope,
+			Healthy: scope.MustNewGaugeVec("cluster_healthy",
+				"whether the execution cluster passed its latest health probes", clusterLabel),
+			ProbeFailures: scope.MustNewCounterVec("cluster_probe_failures",
+				"count of failed health probes of the execution cluster", clusterLabel),
+		},
+	}
+}
@@ -0,0 +1,59 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
+	runtime "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockClusterHealthProbe struct {
+	unhealthy map[string]bool
+}
+
+func (p *mockClusterHealthProbe) Probe(ctx context.Context, target executioncluster.ExecutionTarget) error {
+	if p.unhealthy[target.ID] {
+		return errors.New("unreachable")
+	}
+	return nil
+}
+
+func TestClusterHealthChecker(t *testing.T) {
+	probe := &mockClusterHealthProbe{unhealthy: map[string]bool{"b": true}}
+	changes := 0
+	checker := NewClusterHealthChecker(probe, runtime.ClusterHealthCheckConfig{FailureThreshold: 2}, func() {
+		changes++
+	}, promutils.NewTestScope())
+	targets := []executioncluster.ExecutionTarget{{ID: "b"}, {ID: "a"}}
+	assert.True(t, checker.IsHealthy("b"))
+
+	checker.ProbeTargets(context.Background(), targets)
+	assert.True(t, checker.IsHealthy("b"))
+	assert.Equal(t, 0, changes)
+
+	checker.ProbeTargets(context.Background(), targets)
+	assert.False(t, checker.IsHealthy("b"))
+	assert.True(t, checker.IsHealthy("a"))
+	assert.Equal(t, 1, changes)
+	health := checker.GetClusterHealth()
+	assert.Len(t, health, 2)
+	assert.Equal(t, "a", health[0].ID)
+	assert.True(t, health[0].Healthy)
+	assert.Equal(t, "b", health[1].ID)
+	assert.False(t, health[1].Healthy)
+	assert.Equal(t, "unreachable", health[1].Error)
+	assert.Equal(t, 2, health[1].ConsecutiveFailures)
+
+	// A single successful probe is enough to recover.
+	probe.unhealthy = nil
+	checker.ProbeTargets(context.Background(), targets)
+	assert.True(t, checker.IsHealthy("b"))
+	assert.Equal(t, 2, changes)
+	health = checker.GetClusterHealth()
+	assert.Empty(t, health[1].Error)
+	assert.Zero(t, health[1].ConsecutiveFailures)
+}
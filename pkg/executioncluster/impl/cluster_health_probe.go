@@ -0,0 +1,91 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster/interfaces"
+	runtime "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const flyteWorkflowResource = "flyteworkflows"
+
+type newDiscoveryClientFunc func(config *rest.Config) (discovery.DiscoveryInterface, error)
+
+// Probes the API server of a target, whether it serves the FlyteWorkflow CRD and, optionally, whether propeller renews
+// its lease.
+type k8sClusterHealthProbe struct {
+	config             runtime.ClusterHealthCheckConfig
+	newDiscoveryClient newDiscoveryClientFunc
+}
+
+func (p k8sClusterHealthProbe) Probe(ctx context.Context, target executioncluster.ExecutionTarget) error {
+	restConfig := target.Config
+	restConfig.Timeout = p.config.Timeout.Duration
+	discoveryClient, err := p.newDiscoveryClient(&restConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create a discovery client: %v", err)
+	}
+	if _, err = discoveryClient.ServerVersion(); err != nil {
+		return fmt.Errorf("api server is unreachable: %v", err)
+	}
+	resources, err := discoveryClient.ServerResourcesForGroupVersion(v1alpha1.SchemeGroupVersion.String())
+	if err != nil {
+		return fmt.Errorf("failed to discover the %s resources: %v", v1alpha1.SchemeGroupVersion, err)
+	}
+	found := false
+	for _, resource := range resources.APIResources {
+		if resource.Name == flyteWorkflowResource {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("the %s CRD isn't installed", v1alpha1.SchemeGroupVersion.WithResource(flyteWorkflowResource))
+	}
+	if len(p.config.PropellerLease) > 0 {
+		return p.probePropellerLease(ctx, target)
+	}
+	return nil
+}
+
+func (p k8sClusterHealthProbe) probePropellerLease(ctx context.Context, target executioncluster.ExecutionTarget) error {
+	var key client.ObjectKey
+	if parts := strings.SplitN(p.config.PropellerLease, "/", 2); len(parts) == 2 {
+		key = client.ObjectKey{Namespace: parts[0], Name: parts[1]}
+	} else {
+		key = client.ObjectKey{Name: p.config.PropellerLease}
+	}
+	if p.config.Timeout.Duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.config.Timeout.Duration)
+		defer cancel()
+	}
+	lease := &coordinationv1.Lease{}
+	if err := target.Client.Get(ctx, key, lease); err != nil {
+		return fmt.Errorf("failed to get the propeller lease [%s]: %v", p.config.PropellerLease, err)
+	}
+	if lease.Spec.RenewTime == nil || time.Since(lease.Spec.RenewTime.Time) > p.config.PropellerLeaseMaxAge.Duration {
+		return fmt.Errorf("the propeller lease [%s] wasn't renewed in the last %v", p.config.PropellerLease,
+			p.config.PropellerLeaseMaxAge.Duration)
+	}
+	return nil
+}
+
+// NewK8sClusterHealthProbe returns a probe of the API server of the execution targets.
+func NewK8sClusterHealthProbe(config runtime.ClusterHealthCheckConfig) interfaces.ClusterHealthProbe {
+	return k8sClusterHealthProbe{
+		config: config,
+		newDiscoveryClient: func(config *rest.Config) (discovery.DiscoveryInterface, error) {
+			return discovery.NewDiscoveryClientForConfig(config)
+		},
+	}
+}
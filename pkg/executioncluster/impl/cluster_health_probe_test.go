@@ -0,0 +1,76 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
+	runtime "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+	"github.com/flyteorg/flytestdlib/config"
+	"github.com/stretchr/testify/assert"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
+	fakeDiscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func getTestHealthProbe(resources ...metav1.APIResource) k8sClusterHealthProbe {
+	return k8sClusterHealthProbe{
+		config: runtime.ClusterHealthCheckConfig{
+			Timeout:              config.Duration{Duration: time.Second},
+			PropellerLeaseMaxAge: config.Duration{Duration: time.Minute},
+		},
+		newDiscoveryClient: func(config *rest.Config) (discovery.DiscoveryInterface, error) {
+			return &fakeDiscovery.FakeDiscovery{
+				Fake: &k8stesting.Fake{
+					Resources: []*metav1.APIResourceList{
+						{
+							GroupVersion: v1alpha1.SchemeGroupVersion.String(),
+							APIResources: resources,
+						},
+					},
+				},
+			}, nil
+		},
+	}
+}
+
+func TestK8sClusterHealthProbe(t *testing.T) {
+	probe := getTestHealthProbe(metav1.APIResource{Name: flyteWorkflowResource})
+	assert.NoError(t, probe.Probe(context.Background(), executioncluster.ExecutionTarget{ID: "cluster"}))
+}
+
+func TestK8sClusterHealthProbe_MissingCRD(t *testing.T) {
+	probe := getTestHealthProbe(metav1.APIResource{Name: "pods"})
+	assert.EqualError(t, probe.Probe(context.Background(), executioncluster.ExecutionTarget{ID: "cluster"}),
+		"the flyte.lyft.com/v1alpha1, Resource=flyteworkflows CRD isn't installed")
+}
+
+func TestK8sClusterHealthProbe_PropellerLease(t *testing.T) {
+	probe := getTestHealthProbe(metav1.APIResource{Name: flyteWorkflowResource})
+	probe.config.PropellerLease = "flyte/propeller-leader"
+	lease := func(renewedAt time.Time) *coordinationv1.Lease {
+		return &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "flyte", Name: "propeller-leader"},
+			Spec:       coordinationv1.LeaseSpec{RenewTime: &metav1.MicroTime{Time: renewedAt}},
+		}
+	}
+
+	target := executioncluster.ExecutionTarget{
+		ID:     "cluster",
+		Client: fake.NewClientBuilder().WithObjects(lease(time.Now())).Build(),
+	}
+	assert.NoError(t, probe.Probe(context.Background(), target))
+
+	target.Client = fake.NewClientBuilder().WithObjects(lease(time.Now().Add(-time.Hour))).Build()
+	assert.EqualError(t, probe.Probe(context.Background(), target),
+		"the propeller lease [flyte/propeller-leader] wasn't renewed in the last 1m0s")
+
+	target.Client = fake.NewClientBuilder().Build()
+	assert.Error(t, probe.Probe(context.Background(), target))
+}
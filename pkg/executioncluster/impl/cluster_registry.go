@@ -0,0 +1,90 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	runtime "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+)
+
+// Provides the clusters from the ClusterConfigs and LabelClusterMap config.
+type configClusterRegistry struct {
+	config runtime.ClusterConfiguration
+}
+
+func (r configClusterRegistry) GetClusters(ctx context.Context) (
+	[]runtime.ClusterConfig, map[string][]runtime.ClusterEntity, error) {
+	return r.config.GetClusterConfigs(), r.config.GetLabelClusterMap(), nil
+}
+
+func (r configClusterRegistry) IsDynamic() bool {
+	return false
+}
+
+// Provides the clusters registered through the admin API.
+type dbClusterRegistry struct {
+	repo   repositoryInterfaces.ExecutionClusterRepoInterface
+	config runtime.ClusterRegistryConfig
+}
+
+func (r dbClusterRegistry) GetClusters(ctx context.Context) (
+	[]runtime.ClusterConfig, map[string][]runtime.ClusterEntity, error) {
+	clusters, err := r.repo.List(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	labels, err := r.repo.ListLabels(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	clusterConfigs := make([]runtime.ClusterConfig, 0, len(clusters))
+	for _, cluster := range clusters {
+		clusterConfig := runtime.ClusterConfig{
+			Name:     cluster.Name,
+			Endpoint: cluster.Endpoint,
+			Auth: runtime.Auth{
+				Type:      cluster.AuthType,
+				TokenPath: cluster.TokenPath,
+				CertPath:  cluster.CertPath,
+			},
+			Enabled:  cluster.Enabled,
+			Draining: cluster.Draining,
+			Capacity: cluster.Capacity,
+		}
+		// Clusters registered before the registry config changed may not be allowed anymore.
+		if err := executioncluster.ValidateRegisteredCluster(r.config, clusterConfig); err != nil {
+			logger.Errorf(ctx, "Skipping execution cluster [%s]: %v", cluster.Name, err)
+			continue
+		}
+		clusterConfigs = append(clusterConfigs, clusterConfig)
+	}
+	labelClusterMap := make(map[string][]runtime.ClusterEntity)
+	for _, label := range labels {
+		labelClusterMap[label.Label] = append(labelClusterMap[label.Label], runtime.ClusterEntity{
+			ID:     label.Cluster,
+			Weight: label.Weight,
+		})
+	}
+	return clusterConfigs, labelClusterMap, nil
+}
+
+func (r dbClusterRegistry) IsDynamic() bool {
+	return true
+}
+
+// NewClusterRegistry returns the registry of the clusters configured as their source.
+func NewClusterRegistry(config runtime.ClusterConfiguration, db repositories.RepositoryInterface) interfaces.ClusterRegistry {
+	if config.GetRegistryConfig().Source == runtime.ClusterRegistrySourceDatabase {
+		return dbClusterRegistry{
+			repo:   db.ExecutionClusterRepo(),
+			config: config.GetRegistryConfig(),
+		}
+	}
+	return configClusterRegistry{
+		config: config,
+	}
+}
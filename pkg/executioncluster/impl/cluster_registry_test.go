@@ -0,0 +1,53 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDBClusterRegistry_GetClusters(t *testing.T) {
+	db := repositoryMocks.NewMockRepository()
+	repo := db.ExecutionClusterRepo().(*repositoryMocks.MockExecutionClusterRepo)
+	repo.SetListCallback(func(ctx context.Context) ([]models.ExecutionCluster, error) {
+		return []models.ExecutionCluster{
+			{Name: "a", Endpoint: "a_endpoint", AuthType: "file_path", TokenPath: "/secrets/token",
+				CertPath: "/secrets/cert", Enabled: true, Capacity: 10},
+			{Name: "b", Endpoint: "b_endpoint", Enabled: true, Draining: true},
+			// Clusters whose credentials aren't in the credentials directory are skipped.
+			{Name: "c", Endpoint: "c_endpoint", AuthType: "file_path",
+				TokenPath: "/var/run/secrets/kubernetes.io/serviceaccount/token", Enabled: true},
+		}, nil
+	})
+	repo.SetListLabelsCallback(func(ctx context.Context) ([]models.ExecutionClusterLabel, error) {
+		return []models.ExecutionClusterLabel{
+			{Label: "gpu", Cluster: "a", Weight: 0.25},
+			{Label: "gpu", Cluster: "b", Weight: 0.75},
+		}, nil
+	})
+	registry := dbClusterRegistry{
+		repo:   repo,
+		config: runtimeInterfaces.ClusterRegistryConfig{CredentialsDirectory: "/secrets"},
+	}
+	assert.True(t, registry.IsDynamic())
+
+	clusterConfigs, labelClusterMap, err := registry.GetClusters(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []runtimeInterfaces.ClusterConfig{
+		{
+			Name:     "a",
+			Endpoint: "a_endpoint",
+			Auth:     runtimeInterfaces.Auth{Type: "file_path", TokenPath: "/secrets/token", CertPath: "/secrets/cert"},
+			Enabled:  true,
+			Capacity: 10,
+		},
+		{Name: "b", Endpoint: "b_endpoint", Enabled: true, Draining: true},
+	}, clusterConfigs)
+	assert.Equal(t, map[string][]runtimeInterfaces.ClusterEntity{
+		"gpu": {{ID: "a", Weight: 0.25}, {ID: "b", Weight: 0.75}},
+	}, labelClusterMap)
+}
@@ -1,25 +1,48 @@
 package impl
 
 import (
+	"fmt"
+
 	executioncluster_interface "github.com/flyteorg/flyteadmin/pkg/executioncluster/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories"
 	"github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
+type newClusterSelectorFunc func(scope promutils.Scope, initializationErrorCounter prometheus.Counter,
+	config interfaces.Configuration, executionTargetProvider executioncluster_interface.ExecutionTargetProvider,
+	db repositories.RepositoryInterface) (executioncluster_interface.ClusterInterface, error)
+
+// Cluster selectors by the name they are configured with.
+var clusterSelectors = map[string]newClusterSelectorFunc{
+	interfaces.ClusterSelectorRandom:      NewRandomClusterSelector,
+	interfaces.ClusterSelectorLeastLoaded: NewLeastLoadedClusterSelector,
+}
+
 func GetExecutionCluster(scope promutils.Scope, kubeConfig, master string, config interfaces.Configuration, db repositories.RepositoryInterface) executioncluster_interface.ClusterInterface {
 	initializationErrorCounter := scope.MustNewCounter(
 		"flyteclient_initialization_error",
 		"count of errors encountered initializing a flyte client from kube config")
-	switch len(config.ClusterConfiguration().GetClusterConfigs()) {
-	case 0:
+	// Clusters registered in the database are always selected from, even before any of them is registered.
+	switch {
+	case len(config.ClusterConfiguration().GetClusterConfigs()) == 0 &&
+		config.ClusterConfiguration().GetRegistryConfig().Source != interfaces.ClusterRegistrySourceDatabase:
 		cluster, err := NewInCluster(initializationErrorCounter, kubeConfig, master)
 		if err != nil {
 			panic(err)
 		}
 		return cluster
 	default:
-		cluster, err := NewRandomClusterSelector(initializationErrorCounter, config, &clusterExecutionTargetProvider{}, db)
+		selectorName := config.ClusterConfiguration().GetSelectorConfig().Name
+		if len(selectorName) == 0 {
+			selectorName = interfaces.ClusterSelectorRandom
+		}
+		newClusterSelector, ok := clusterSelectors[selectorName]
+		if !ok {
+			panic(fmt.Errorf("unknown cluster selector [%s]", selectorName))
+		}
+		cluster, err := newClusterSelector(scope, initializationErrorCounter, config, &clusterExecutionTargetProvider{}, db)
 		if err != nil {
 			panic(err)
 		}
@@ -31,6 +31,10 @@ func (i InCluster) GetAllValidTargets() []executioncluster.ExecutionTarget {
 	}
 }
 
+func (i InCluster) GetClusterHealth() []executioncluster.ClusterHealth {
+	return nil
+}
+
 func NewInCluster(initializationErrorCounter prometheus.Counter, kubeConfig, master string) (interfaces.ClusterInterface, error) {
 	clientConfig, err := flytek8s.GetRestClientConfig(kubeConfig, master, nil)
 	if err != nil {
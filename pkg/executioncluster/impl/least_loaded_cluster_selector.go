@@ -0,0 +1,168 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	runtime "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Implementation of a load aware cluster selector
+// Selects the cluster of the domain's label with the fewest running executions relative to its capacity.
+type LeastLoadedClusterSelector struct {
+	*RandomClusterSelector
+	executionRepo   repositoryInterfaces.ExecutionRepoInterface
+	refreshInterval time.Duration
+	// Guards the execution counts, which are refreshed from the database at most once per refresh interval.
+	loadLock sync.Mutex
+	// Non-terminal executions by cluster as of refreshedAt, plus the executions assigned since.
+	executionCounts map[string]int64
+	refreshedAt     time.Time
+}
+
+type leastLoadedCandidate struct {
+	target   executioncluster.ExecutionTarget
+	capacity int
+}
+
+// Returns the selectable clusters among the given ones, skipping unhealthy ones unless includeUnhealthy is set.
+// Expects the read lock of the clusters to be held.
+func (s *LeastLoadedClusterSelector) getEligibleCandidates(ids []string, capacities map[string]int,
+	includeUnhealthy bool) []leastLoadedCandidate {
+	candidates := make([]leastLoadedCandidate, 0, len(ids))
+	for _, id := range ids {
+		target, ok := s.executionTargetMap[id]
+		if !ok || !isSelectable(target) || (!includeUnhealthy && !s.isHealthy(target)) {
+			continue
+		}
+		candidates = append(candidates, leastLoadedCandidate{
+			target:   target,
+			capacity: capacities[id],
+		})
+	}
+	return candidates
+}
+
+// Returns the clusters of the label which are eligible for selection. As with the random selector, a label without
+// eligible clusters falls back to all clusters, and unhealthy clusters are only selected when no cluster is healthy.
+func (s *LeastLoadedClusterSelector) getCandidates(label string) []leastLoadedCandidate {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	ids := make([]string, 0, len(s.clusterConfigs))
+	capacities := make(map[string]int, len(s.clusterConfigs))
+	for _, cluster := range s.clusterConfigs {
+		ids = append(ids, cluster.Name)
+		capacities[cluster.Name] = cluster.Capacity
+	}
+	if clusterEntities, ok := s.labelClusterMap[label]; ok {
+		labelIDs := make([]string, 0, len(clusterEntities))
+		for _, clusterEntity := range clusterEntities {
+			labelIDs = append(labelIDs, clusterEntity.ID)
+		}
+		if candidates := s.getEligibleCandidates(labelIDs, capacities, false); len(candidates) > 0 {
+			return candidates
+		}
+	}
+	if candidates := s.getEligibleCandidates(ids, capacities, false); len(candidates) > 0 {
+		return candidates
+	}
+	return s.getEligibleCandidates(ids, capacities, true)
+}
+
+// Expects the load lock to be held.
+func (s *LeastLoadedClusterSelector) refreshExecutionCounts(ctx context.Context) {
+	if s.executionCounts != nil && time.Since(s.refreshedAt) < s.refreshInterval {
+		return
+	}
+	executionCounts, err := s.executionRepo.CountNonTerminalByCluster(ctx)
+	if err != nil {
+		logger.Warnf(ctx, "Failed to count the running executions per cluster, selecting by stale counts: %v", err)
+		if s.executionCounts == nil {
+			s.executionCounts = make(map[string]int64)
+		}
+		return
+	}
+	s.executionCounts = executionCounts
+	s.refreshedAt = time.Now()
+}
+
+func (s *LeastLoadedClusterSelector) getLoad(candidate leastLoadedCandidate) float64 {
+	load := float64(s.executionCounts[candidate.target.ID])
+	if candidate.capacity > 0 {
+		load /= float64(candidate.capacity)
+	}
+	return load
+}
+
+func (s *LeastLoadedClusterSelector) GetTarget(ctx context.Context, spec *executioncluster.ExecutionTargetSpec) (*executioncluster.ExecutionTarget, error) {
+	if spec == nil {
+		return nil, fmt.Errorf("empty executionTargetSpec")
+	}
+	if spec.TargetID != "" {
+		return s.getTargetByID(spec.TargetID)
+	}
+	if spec.PreferredTargetID != "" {
+		if target, ok := s.getPreferredTarget(spec); ok {
+			s.loadLock.Lock()
+			defer s.loadLock.Unlock()
+			s.refreshExecutionCounts(ctx)
+			s.executionCounts[target.ID]++
+			return &target, nil
+		}
+		logger.Debugf(ctx, "Preferred cluster [%s] can't be selected, selecting the least loaded", spec.PreferredTargetID)
+	}
+	label, err := s.getExecutionClusterLabel(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	candidates := s.getCandidates(label)
+	// Failover picks the least loaded of the remaining candidates.
+	if len(spec.ExcludedTargetIDs) > 0 {
+		remaining := make([]leastLoadedCandidate, 0, len(candidates))
+		for _, candidate := range candidates {
+			if !spec.IsExcluded(candidate.target.ID) {
+				remaining = append(remaining, candidate)
+			}
+		}
+		candidates = remaining
+	}
+	if len(candidates) == 0 {
+		return nil, errNoSelectableClusters
+	}
+	s.loadLock.Lock()
+	defer s.loadLock.Unlock()
+	s.refreshExecutionCounts(ctx)
+	// Ties go to the cluster listed first.
+	selected := candidates[0]
+	for _, candidate := range candidates[1:] {
+		if s.getLoad(candidate) < s.getLoad(selected) {
+			selected = candidate
+		}
+	}
+	execTarget := selected.target
+	// Count the execution right away so that executions assigned before the next refresh spread across clusters.
+	s.executionCounts[execTarget.ID]++
+	return &execTarget, nil
+}
+
+func NewLeastLoadedClusterSelector(scope promutils.Scope, initializationErrorCounter prometheus.Counter, config runtime.Configuration,
+	executionTargetProvider interfaces.ExecutionTargetProvider, db repositories.RepositoryInterface) (interfaces.ClusterInterface, error) {
+	randomClusterSelector, err := newRandomClusterSelector(scope, initializationErrorCounter, config, executionTargetProvider, db)
+	if err != nil {
+		return nil, err
+	}
+	return &LeastLoadedClusterSelector{
+		RandomClusterSelector: randomClusterSelector,
+		executionRepo:         db.ExecutionRepo(),
+		refreshInterval:       config.ClusterConfiguration().GetSelectorConfig().LoadRefreshInterval.Duration,
+	}, nil
+}
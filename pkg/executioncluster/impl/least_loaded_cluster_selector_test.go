@@ -0,0 +1,91 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster/mocks"
+	repo_mock "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/runtime"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeastLoadedClusterSelectorGetTarget(t *testing.T) {
+	err := initTestConfig("clusters_config.yaml")
+	assert.NoError(t, err)
+	db := getMockRepositoryForTest(t)
+	countCalls := 0
+	db.ExecutionRepo().(*repo_mock.MockExecutionRepo).CountNonTerminalByClusterFunction = func(
+		ctx context.Context) (map[string]int64, error) {
+		countCalls++
+		return map[string]int64{"testcluster2": 5, "testcluster3": 8}, nil
+	}
+	var initializationErrorCounter prometheus.Counter
+	cluster, err := NewLeastLoadedClusterSelector(promutils.NewTestScope(), initializationErrorCounter,
+		runtime.NewConfigurationProvider(), &mocks.MockExecutionTargetProvider{}, db)
+	assert.NoError(t, err)
+	spec := &executioncluster.ExecutionTargetSpec{
+		Project:     testProject,
+		Domain:      "different",
+		Workflow:    testWorkflow,
+		ExecutionID: "e1",
+	}
+
+	// testcluster2 runs 5 of 10 executions, testcluster3 runs 8 of 20.
+	for _, expected := range []string{"testcluster3", "testcluster3", "testcluster2"} {
+		target, err := cluster.GetTarget(context.Background(), spec)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, target.ID)
+	}
+	// The counts are refreshed once per refresh interval.
+	assert.Equal(t, 1, countCalls)
+
+	// Failover selects the least loaded of the remaining clusters.
+	target, err := cluster.GetTarget(context.Background(), &executioncluster.ExecutionTargetSpec{
+		Project:           testProject,
+		Domain:            "different",
+		Workflow:          testWorkflow,
+		ExecutionID:       "e1",
+		ExcludedTargetIDs: []string{"testcluster3"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "testcluster2", target.ID)
+
+	// A preferred cluster is selected regardless of its load, and counts towards it.
+	spec.PreferredTargetID = "testcluster2"
+	target, err = cluster.GetTarget(context.Background(), spec)
+	assert.Nil(t, err)
+	assert.Equal(t, "testcluster2", target.ID)
+	spec.PreferredTargetID = ""
+	target, err = cluster.GetTarget(context.Background(), spec)
+	assert.Nil(t, err)
+	assert.Equal(t, "testcluster3", target.ID)
+
+	target, err = cluster.GetTarget(context.Background(), &executioncluster.ExecutionTargetSpec{TargetID: "testcluster"})
+	assert.Nil(t, err)
+	assert.Equal(t, "testcluster", target.ID)
+}
+
+func TestLeastLoadedClusterSelectorGetTargetForDisabledLabel(t *testing.T) {
+	err := initTestConfig("clusters_config.yaml")
+	assert.NoError(t, err)
+	db := getMockRepositoryForTest(t)
+	var initializationErrorCounter prometheus.Counter
+	cluster, err := NewLeastLoadedClusterSelector(promutils.NewTestScope(), initializationErrorCounter,
+		runtime.NewConfigurationProvider(), &mocks.MockExecutionTargetProvider{}, db)
+	assert.NoError(t, err)
+
+	// The only cluster of the test label is disabled, so executions are spread across all enabled clusters.
+	for _, expected := range []string{"testcluster2", "testcluster3", "testcluster3", "testcluster2"} {
+		target, err := cluster.GetTarget(context.Background(), &executioncluster.ExecutionTargetSpec{
+			Project:     testProject,
+			Domain:      testDomain,
+			ExecutionID: "e",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, expected, target.ID)
+	}
+}
@@ -0,0 +1,29 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster/interfaces"
+)
+
+// NoCluster has no targets, for when executions aren't created in Kubernetes clusters, e.g. because they are recorded
+// by the recording workflow executor.
+type NoCluster struct{}
+
+func (NoCluster) GetTarget(ctx context.Context, spec *executioncluster.ExecutionTargetSpec) (*executioncluster.ExecutionTarget, error) {
+	return nil, fmt.Errorf("no execution clusters are configured")
+}
+
+func (NoCluster) GetAllValidTargets() []executioncluster.ExecutionTarget {
+	return nil
+}
+
+func (NoCluster) GetClusterHealth() []executioncluster.ClusterHealth {
+	return nil
+}
+
+func NewNoCluster() interfaces.ClusterInterface {
+	return &NoCluster{}
+}
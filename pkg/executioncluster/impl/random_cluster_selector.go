@@ -1,14 +1,18 @@
 package impl
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"hash/fnv"
 	"math/rand"
+	"reflect"
+	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 
@@ -24,17 +28,32 @@ import (
 	"github.com/flyteorg/flytestdlib/random"
 
 	runtime "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 // Implementation of Random cluster selector
 // Selects cluster based on weights and domains.
 type RandomClusterSelector struct {
+	// Guards the clusters and the weighted random lists. The lists are rebuilt whenever a cluster becomes unhealthy or
+	// recovers, and the clusters are reloaded periodically when their registry is dynamic. The targets of clusters whose
+	// credential files changed are rebuilt periodically too.
+	lock                     sync.RWMutex
+	clusterConfigs           []runtime.ClusterConfig
+	labelClusterMap          map[string][]runtime.ClusterEntity
+	executionTargetMap       map[string]executioncluster.ExecutionTarget
 	equalWeightedAllClusters random.WeightedRandomList
 	labelWeightedRandomMap   map[string]random.WeightedRandomList
-	executionTargetMap       map[string]executioncluster.ExecutionTarget
-	resourceManager          managerInterfaces.ResourceInterface
+	// Serializes the reloads and rebuilds, which hold the lock above only to swap in their results.
+	refreshLock                sync.Mutex
+	registry                   interfaces.ClusterRegistry
+	executionTargetProvider    interfaces.ExecutionTargetProvider
+	initializationErrorCounter prometheus.Counter
+	healthChecker              *ClusterHealthChecker
+	resourceManager            managerInterfaces.ResourceInterface
 }
 
+var errNoSelectableClusters = fmt.Errorf("no enabled cluster targets")
+
 func getRandSource(seed string) (rand.Source, error) {
 	h := fnv.New64a()
 	_, err := h.Write([]byte(seed))
@@ -45,42 +64,72 @@ func getRandSource(seed string) (rand.Source, error) {
 	return rand.NewSource(hashedSeed), nil
 }
 
-func getExecutionTargets(ctx context.Context, initializationErrorCounter prometheus.Counter, executionTargetProvider interfaces.ExecutionTargetProvider,
-	clusterConfig runtime.ClusterConfiguration) (random.WeightedRandomList, map[string]executioncluster.ExecutionTarget, error) {
+// Whether new executions may be assigned to the target.
+func isSelectable(target executioncluster.ExecutionTarget) bool {
+	return target.Enabled && !target.Draining
+}
+
+// Builds the targets of the clusters. The targets of clusters whose endpoint and credentials are unchanged from the
+// previous clusters are reused rather than rebuilt.
+func getExecutionTargets(initializationErrorCounter prometheus.Counter, executionTargetProvider interfaces.ExecutionTargetProvider,
+	clusterConfigs []runtime.ClusterConfig, previousClusterConfigs []runtime.ClusterConfig,
+	previousExecutionTargetMap map[string]executioncluster.ExecutionTarget) (map[string]executioncluster.ExecutionTarget, error) {
+	previousClusters := make(map[string]runtime.ClusterConfig, len(previousClusterConfigs))
+	for _, cluster := range previousClusterConfigs {
+		previousClusters[cluster.Name] = cluster
+	}
 	executionTargetMap := make(map[string]executioncluster.ExecutionTarget)
-	entries := make([]random.Entry, 0)
-	for _, cluster := range clusterConfig.GetClusterConfigs() {
+	for _, cluster := range clusterConfigs {
 		if _, ok := executionTargetMap[cluster.Name]; ok {
-			return nil, nil, fmt.Errorf("duplicate clusters for name %s", cluster.Name)
+			return nil, fmt.Errorf("duplicate clusters for name %s", cluster.Name)
+		}
+		if previousCluster, ok := previousClusters[cluster.Name]; ok && previousCluster.Endpoint == cluster.Endpoint &&
+			previousCluster.Auth == cluster.Auth {
+			executionTarget := previousExecutionTargetMap[cluster.Name]
+			executionTarget.Enabled = cluster.Enabled
+			executionTarget.Draining = cluster.Draining
+			executionTargetMap[cluster.Name] = executionTarget
+			continue
 		}
 		executionTarget, err := executionTargetProvider.GetExecutionTarget(initializationErrorCounter, cluster)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 		executionTargetMap[cluster.Name] = *executionTarget
-		if executionTarget.Enabled {
+	}
+	return executionTargetMap, nil
+}
+
+// Returns nil if none of the clusters is eligible.
+func getEqualWeightedRandomForClusters(ctx context.Context, clusterConfigs []runtime.ClusterConfig,
+	executionTargetMap map[string]executioncluster.ExecutionTarget, isEligible func(executioncluster.ExecutionTarget) bool) (
+	random.WeightedRandomList, error) {
+	entries := make([]random.Entry, 0)
+	for _, cluster := range clusterConfigs {
+		executionTarget := executionTargetMap[cluster.Name]
+		if isSelectable(executionTarget) && isEligible(executionTarget) {
 			targetEntry := random.Entry{
-				Item: *executionTarget,
+				Item: executionTarget,
 			}
 			entries = append(entries, targetEntry)
 		}
 	}
-	weightedRandomList, err := random.NewWeightedRandom(ctx, entries)
-	if err != nil {
-		return nil, nil, err
+	if len(entries) == 0 {
+		return nil, nil
 	}
-	return weightedRandomList, executionTargetMap, nil
+	return random.NewWeightedRandom(ctx, entries)
 }
 
 func getLabeledWeightedRandomForCluster(ctx context.Context,
-	clusterConfig runtime.ClusterConfiguration, executionTargetMap map[string]executioncluster.ExecutionTarget) (map[string]random.WeightedRandomList, error) {
+	labelClusterMap map[string][]runtime.ClusterEntity, executionTargetMap map[string]executioncluster.ExecutionTarget,
+	isEligible func(executioncluster.ExecutionTarget) bool) (map[string]random.WeightedRandomList, error) {
 	labeledWeightedRandomMap := make(map[string]random.WeightedRandomList)
-	for label, clusterEntities := range clusterConfig.GetLabelClusterMap() {
+	for label, clusterEntities := range labelClusterMap {
 		entries := make([]random.Entry, 0)
 		for _, clusterEntity := range clusterEntities {
-			cluster := executionTargetMap[clusterEntity.ID]
-			// If cluster is not enabled, it is not eligible for selection
-			if !cluster.Enabled {
+			cluster, ok := executionTargetMap[clusterEntity.ID]
+			// If cluster is not enabled, is draining (or) is unhealthy, it is not eligible for selection
+			if !ok || !isSelectable(cluster) || !isEligible(cluster) {
 				continue
 			}
 			targetEntry := random.Entry{
@@ -100,26 +149,224 @@ func getLabeledWeightedRandomForCluster(ctx context.Context,
 	return labeledWeightedRandomMap, nil
 }
 
-func (s RandomClusterSelector) GetAllValidTargets() []executioncluster.ExecutionTarget {
+// Rebuilds the weighted random list without the targets the spec excludes, keeping the weights of the remaining
+// targets. The weights are those of the cluster entities of the label the list was built from, if any. Returns nil if
+// all targets are excluded.
+func excludeTargets(ctx context.Context, weightedRandomList random.WeightedRandomList,
+	clusterEntities []runtime.ClusterEntity, spec *executioncluster.ExecutionTargetSpec) (random.WeightedRandomList, error) {
+	weights := make(map[string]float32, len(clusterEntities))
+	for _, clusterEntity := range clusterEntities {
+		weights[clusterEntity.ID] = clusterEntity.Weight
+	}
+	entries := make([]random.Entry, 0, weightedRandomList.Len())
+	for _, item := range weightedRandomList.List() {
+		target := item.(executioncluster.ExecutionTarget)
+		if spec.IsExcluded(target.ID) {
+			continue
+		}
+		entries = append(entries, random.Entry{
+			Item:   target,
+			Weight: weights[target.ID],
+		})
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	return random.NewWeightedRandom(ctx, entries)
+}
+
+func (s *RandomClusterSelector) isHealthy(target executioncluster.ExecutionTarget) bool {
+	return s.healthChecker == nil || s.healthChecker.IsHealthy(target.ID)
+}
+
+// Builds the weighted random lists from the enabled clusters which are healthy and aren't draining. If none of them is
+// healthy, executions are still assigned to all of them rather than failing outright. The lists are empty when no
+// cluster is enabled.
+func (s *RandomClusterSelector) buildWeightedRandomLists(ctx context.Context, clusterConfigs []runtime.ClusterConfig,
+	labelClusterMap map[string][]runtime.ClusterEntity, executionTargetMap map[string]executioncluster.ExecutionTarget) (
+	random.WeightedRandomList, map[string]random.WeightedRandomList, error) {
+	isEligible := s.isHealthy
+	equalWeightedAllClusters, err := getEqualWeightedRandomForClusters(ctx, clusterConfigs, executionTargetMap, isEligible)
+	if err != nil {
+		return nil, nil, err
+	}
+	if equalWeightedAllClusters == nil {
+		isEligible = func(executioncluster.ExecutionTarget) bool { return true }
+		equalWeightedAllClusters, err = getEqualWeightedRandomForClusters(ctx, clusterConfigs, executionTargetMap, isEligible)
+		if err != nil {
+			return nil, nil, err
+		}
+		if equalWeightedAllClusters != nil {
+			logger.Warnf(ctx, "None of the enabled clusters is healthy, selecting from all of them")
+		}
+	}
+	labelWeightedRandomMap, err := getLabeledWeightedRandomForCluster(ctx, labelClusterMap, executionTargetMap, isEligible)
+	if err != nil {
+		return nil, nil, err
+	}
+	return equalWeightedAllClusters, labelWeightedRandomMap, nil
+}
+
+// Rebuilds the weighted random lists of the current clusters, e.g. after one of them became unhealthy.
+func (s *RandomClusterSelector) refreshWeightedRandomLists(ctx context.Context) error {
+	s.refreshLock.Lock()
+	defer s.refreshLock.Unlock()
+	equalWeightedAllClusters, labelWeightedRandomMap, err := s.buildWeightedRandomLists(
+		ctx, s.clusterConfigs, s.labelClusterMap, s.executionTargetMap)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.equalWeightedAllClusters = equalWeightedAllClusters
+	s.labelWeightedRandomMap = labelWeightedRandomMap
+	return nil
+}
+
+// Reloads the clusters from the registry and rebuilds the weighted random lists if any of them changed.
+func (s *RandomClusterSelector) reload(ctx context.Context) error {
+	s.refreshLock.Lock()
+	defer s.refreshLock.Unlock()
+	clusterConfigs, labelClusterMap, err := s.registry.GetClusters(ctx)
+	if err != nil {
+		return err
+	}
+	if s.executionTargetMap != nil && reflect.DeepEqual(clusterConfigs, s.clusterConfigs) &&
+		reflect.DeepEqual(labelClusterMap, s.labelClusterMap) {
+		return nil
+	}
+	executionTargetMap, err := getExecutionTargets(s.initializationErrorCounter, s.executionTargetProvider,
+		clusterConfigs, s.clusterConfigs, s.executionTargetMap)
+	if err != nil {
+		return err
+	}
+	equalWeightedAllClusters, labelWeightedRandomMap, err := s.buildWeightedRandomLists(
+		ctx, clusterConfigs, labelClusterMap, executionTargetMap)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.clusterConfigs = clusterConfigs
+	s.labelClusterMap = labelClusterMap
+	s.executionTargetMap = executionTargetMap
+	s.equalWeightedAllClusters = equalWeightedAllClusters
+	s.labelWeightedRandomMap = labelWeightedRandomMap
+	logger.Infof(ctx, "Loaded %d execution clusters", len(clusterConfigs))
+	return nil
+}
+
+// Returns whether the token or CA files of the cluster no longer hold the credentials its target was built with.
+// Credentials which can't be read are considered unchanged, e.g. while their files are being rotated.
+func credentialsChanged(ctx context.Context, cluster runtime.ClusterConfig, target executioncluster.ExecutionTarget) bool {
+	token, err := cluster.Auth.GetToken()
+	if err != nil {
+		logger.Warnf(ctx, "Failed to re-read the token of cluster [%s]: %v", cluster.Name, err)
+		return false
+	}
+	caCert, err := cluster.Auth.GetCA()
+	if err != nil {
+		logger.Warnf(ctx, "Failed to re-read the CA of cluster [%s]: %v", cluster.Name, err)
+		return false
+	}
+	return token != target.Config.BearerToken || !bytes.Equal(caCert, target.Config.CAData)
+}
+
+// Rebuilds the targets of the clusters whose credentials changed, e.g. after their service account token was rotated.
+// Requests in flight keep using the clients of the targets they were given, which are left intact.
+func (s *RandomClusterSelector) refreshCredentials(ctx context.Context) error {
+	s.refreshLock.Lock()
+	defer s.refreshLock.Unlock()
+	var executionTargetMap map[string]executioncluster.ExecutionTarget
+	for _, cluster := range s.clusterConfigs {
+		if !credentialsChanged(ctx, cluster, s.executionTargetMap[cluster.Name]) {
+			continue
+		}
+		executionTarget, err := s.executionTargetProvider.GetExecutionTarget(s.initializationErrorCounter, cluster)
+		if err != nil {
+			logger.Errorf(ctx, "Failed to rebuild the target of cluster [%s] with its new credentials: %v", cluster.Name, err)
+			continue
+		}
+		if executionTargetMap == nil {
+			executionTargetMap = make(map[string]executioncluster.ExecutionTarget, len(s.executionTargetMap))
+			for id, target := range s.executionTargetMap {
+				executionTargetMap[id] = target
+			}
+		}
+		executionTargetMap[cluster.Name] = *executionTarget
+		logger.Infof(ctx, "Rebuilt the target of cluster [%s] with its new credentials", cluster.Name)
+	}
+	if executionTargetMap == nil {
+		return nil
+	}
+	equalWeightedAllClusters, labelWeightedRandomMap, err := s.buildWeightedRandomLists(
+		ctx, s.clusterConfigs, s.labelClusterMap, executionTargetMap)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.executionTargetMap = executionTargetMap
+	s.equalWeightedAllClusters = equalWeightedAllClusters
+	s.labelWeightedRandomMap = labelWeightedRandomMap
+	return nil
+}
+
+// Excludes the clusters the health checker finds unhealthy from the weighted random lists.
+func (s *RandomClusterSelector) setHealthChecker(probe interfaces.ClusterHealthProbe,
+	config runtime.ClusterHealthCheckConfig, scope promutils.Scope) *ClusterHealthChecker {
+	s.healthChecker = NewClusterHealthChecker(probe, config, func() {
+		if err := s.refreshWeightedRandomLists(context.Background()); err != nil {
+			logger.Errorf(context.Background(), "Failed to refresh the cluster weights after a health change: %v", err)
+		}
+	}, scope)
+	return s.healthChecker
+}
+
+func (s *RandomClusterSelector) GetClusterHealth() []executioncluster.ClusterHealth {
+	if s.healthChecker == nil {
+		return nil
+	}
+	return s.healthChecker.GetClusterHealth()
+}
+
+// GetAllValidTargets returns the enabled targets, including the draining ones.
+func (s *RandomClusterSelector) GetAllValidTargets() []executioncluster.ExecutionTarget {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
 	v := make([]executioncluster.ExecutionTarget, 0)
-	for _, value := range s.executionTargetMap {
-		if value.Enabled {
+	for _, cluster := range s.clusterConfigs {
+		if value := s.executionTargetMap[cluster.Name]; value.Enabled {
 			v = append(v, value)
 		}
 	}
 	return v
 }
 
-func (s RandomClusterSelector) GetTarget(ctx context.Context, spec *executioncluster.ExecutionTargetSpec) (*executioncluster.ExecutionTarget, error) {
-	if spec == nil {
-		return nil, fmt.Errorf("empty executionTargetSpec")
+// Returns the preferred target of the spec if it may be selected.
+func (s *RandomClusterSelector) getPreferredTarget(spec *executioncluster.ExecutionTargetSpec) (
+	executioncluster.ExecutionTarget, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	target, ok := s.executionTargetMap[spec.PreferredTargetID]
+	if !ok || !isSelectable(target) || !s.isHealthy(target) || spec.IsExcluded(target.ID) {
+		return executioncluster.ExecutionTarget{}, false
 	}
-	if spec.TargetID != "" {
-		if val, ok := s.executionTargetMap[spec.TargetID]; ok {
-			return &val, nil
-		}
-		return nil, fmt.Errorf("invalid cluster target %s", spec.TargetID)
+	return target, true
+}
+
+func (s *RandomClusterSelector) getTargetByID(id string) (*executioncluster.ExecutionTarget, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if val, ok := s.executionTargetMap[id]; ok {
+		return &val, nil
 	}
+	return nil, fmt.Errorf("invalid cluster target %s", id)
+}
+
+// Returns the execution cluster label matching the spec, or an empty label if there is none.
+func (s *RandomClusterSelector) getExecutionClusterLabel(ctx context.Context, spec *executioncluster.ExecutionTargetSpec) (
+	string, error) {
 	resource, err := s.resourceManager.GetResource(ctx, managerInterfaces.ResourceRequest{
 		Project:      spec.Project,
 		Domain:       spec.Domain,
@@ -129,26 +376,59 @@ func (s RandomClusterSelector) GetTarget(ctx context.Context, spec *executionclu
 	})
 	if err != nil {
 		if flyteAdminError, ok := err.(errors.FlyteAdminError); !ok || flyteAdminError.Code() != codes.NotFound {
-			return nil, err
+			return "", err
 		}
 	}
-	var weightedRandomList random.WeightedRandomList
 	if resource != nil && resource.Attributes.GetExecutionClusterLabel() != nil {
-		label := resource.Attributes.GetExecutionClusterLabel().Value
+		return resource.Attributes.GetExecutionClusterLabel().Value, nil
+	}
+	logger.Debugf(ctx, "No override found for the spec %v", spec)
+	return "", nil
+}
 
+func (s *RandomClusterSelector) GetTarget(ctx context.Context, spec *executioncluster.ExecutionTargetSpec) (*executioncluster.ExecutionTarget, error) {
+	if spec == nil {
+		return nil, fmt.Errorf("empty executionTargetSpec")
+	}
+	if spec.TargetID != "" {
+		return s.getTargetByID(spec.TargetID)
+	}
+	if spec.PreferredTargetID != "" {
+		if target, ok := s.getPreferredTarget(spec); ok {
+			return &target, nil
+		}
+		logger.Debugf(ctx, "Preferred cluster [%s] can't be selected, selecting by label", spec.PreferredTargetID)
+	}
+	label, err := s.getExecutionClusterLabel(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	var weightedRandomList random.WeightedRandomList
+	var clusterEntities []runtime.ClusterEntity
+	if label != "" {
 		if _, ok := s.labelWeightedRandomMap[label]; ok {
 			weightedRandomList = s.labelWeightedRandomMap[label]
+			clusterEntities = s.labelClusterMap[label]
 		} else {
 			logger.Debugf(ctx, "No cluster mapping found for the label %s", label)
 		}
-	} else {
-		logger.Debugf(ctx, "No override found for the spec %v", spec)
 	}
 	// If there is no label associated (or) if the label is invalid, choose from all enabled clusters.
-	// Note that if there is a valid label with zero "Enabled" clusters, we still choose from all enabled ones.
+	// Note that if there is a valid label with zero "Enabled" (or) healthy clusters, we still choose from all enabled ones.
 	if weightedRandomList == nil {
 		weightedRandomList = s.equalWeightedAllClusters
 	}
+	if weightedRandomList != nil && len(spec.ExcludedTargetIDs) > 0 {
+		weightedRandomList, err = excludeTargets(ctx, weightedRandomList, clusterEntities, spec)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if weightedRandomList == nil {
+		return nil, errNoSelectableClusters
+	}
 
 	executionName := spec.ExecutionID
 	if executionName != "" {
@@ -167,19 +447,49 @@ func (s RandomClusterSelector) GetTarget(ctx context.Context, spec *executionclu
 	return &execTarget, nil
 }
 
-func NewRandomClusterSelector(initializationErrorCounter prometheus.Counter, config runtime.Configuration, executionTargetProvider interfaces.ExecutionTargetProvider, db repositories.RepositoryInterface) (interfaces.ClusterInterface, error) {
-	equalWeightedAllClusters, executionTargetMap, err := getExecutionTargets(context.Background(), initializationErrorCounter, executionTargetProvider, config.ClusterConfiguration())
-	if err != nil {
+func newRandomClusterSelector(scope promutils.Scope, initializationErrorCounter prometheus.Counter, config runtime.Configuration,
+	executionTargetProvider interfaces.ExecutionTargetProvider, db repositories.RepositoryInterface) (*RandomClusterSelector, error) {
+	selector := &RandomClusterSelector{
+		registry:                   NewClusterRegistry(config.ClusterConfiguration(), db),
+		executionTargetProvider:    executionTargetProvider,
+		initializationErrorCounter: initializationErrorCounter,
+		resourceManager:            resources.NewResourceManager(db, config.ApplicationConfiguration()),
+	}
+	if err := selector.reload(context.Background()); err != nil {
 		return nil, err
 	}
-	labelWeightedRandomMap, err := getLabeledWeightedRandomForCluster(context.Background(), config.ClusterConfiguration(), executionTargetMap)
+	// Clusters registered in the database may all be added or enabled later on.
+	if selector.equalWeightedAllClusters == nil && !selector.registry.IsDynamic() {
+		return nil, errNoSelectableClusters
+	}
+	healthCheckConfig := config.ClusterConfiguration().GetHealthCheckConfig()
+	if healthCheckConfig.Enabled {
+		healthChecker := selector.setHealthChecker(NewK8sClusterHealthProbe(healthCheckConfig), healthCheckConfig,
+			scope.NewSubScope("health"))
+		go healthChecker.Run(context.Background(), selector.GetAllValidTargets)
+	}
+	if selector.registry.IsDynamic() {
+		go wait.UntilWithContext(context.Background(), func(ctx context.Context) {
+			if err := selector.reload(ctx); err != nil {
+				logger.Errorf(ctx, "Failed to reload the execution clusters: %v", err)
+			}
+		}, config.ClusterConfiguration().GetRegistryConfig().RefreshInterval.Duration)
+	}
+	if refreshInterval := config.ClusterConfiguration().GetCredentialsConfig().RefreshInterval.Duration; refreshInterval > 0 {
+		go wait.UntilWithContext(context.Background(), func(ctx context.Context) {
+			if err := selector.refreshCredentials(ctx); err != nil {
+				logger.Errorf(ctx, "Failed to refresh the execution cluster credentials: %v", err)
+			}
+		}, refreshInterval)
+	}
+	return selector, nil
+}
+
+func NewRandomClusterSelector(scope promutils.Scope, initializationErrorCounter prometheus.Counter, config runtime.Configuration,
+	executionTargetProvider interfaces.ExecutionTargetProvider, db repositories.RepositoryInterface) (interfaces.ClusterInterface, error) {
+	selector, err := newRandomClusterSelector(scope, initializationErrorCounter, config, executionTargetProvider, db)
 	if err != nil {
 		return nil, err
 	}
-	return &RandomClusterSelector{
-		labelWeightedRandomMap:   labelWeightedRandomMap,
-		executionTargetMap:       executionTargetMap,
-		resourceManager:          resources.NewResourceManager(db, config.ApplicationConfiguration()),
-		equalWeightedAllClusters: equalWeightedAllClusters,
-	}, nil
+	return selector, nil
 }
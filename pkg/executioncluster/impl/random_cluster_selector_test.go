@@ -2,6 +2,7 @@ package impl
 
 import (
 	"context"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"testing"
@@ -9,6 +10,8 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/resources"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
 	repo_interface "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	repo_mock "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
@@ -19,9 +22,12 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
 	interfaces2 "github.com/flyteorg/flyteadmin/pkg/executioncluster/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/executioncluster/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/flytek8s"
 	"github.com/flyteorg/flyteadmin/pkg/runtime"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flytestdlib/config"
 	"github.com/flyteorg/flytestdlib/config/viper"
+	"github.com/flyteorg/flytestdlib/promutils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -42,10 +48,7 @@ func initTestConfig(fileName string) error {
 	return configAccessor.UpdateConfig(context.Background())
 }
 
-func getRandomClusterSelectorForTest(t *testing.T) interfaces2.ClusterInterface {
-	err := initTestConfig("clusters_config.yaml")
-	assert.NoError(t, err)
-
+func getMockRepositoryForTest(t *testing.T) repositories.RepositoryInterface {
 	db := repo_mock.NewMockRepository()
 	db.ResourceRepo().(*repo_mock.MockResourceRepo).GetFunction = func(ctx context.Context, ID repo_interface.ResourceID) (resource models.Resource, e error) {
 		assert.Equal(t, "EXECUTION_CLUSTER_LABEL", ID.ResourceType)
@@ -83,9 +86,17 @@ func getRandomClusterSelectorForTest(t *testing.T) interfaces2.ClusterInterface
 		}
 		return response, nil
 	}
+	return db
+}
+
+func getRandomClusterSelectorForTest(t *testing.T) interfaces2.ClusterInterface {
+	err := initTestConfig("clusters_config.yaml")
+	assert.NoError(t, err)
+
+	db := getMockRepositoryForTest(t)
 	configProvider := runtime.NewConfigurationProvider()
 	var initializationErrorCounter prometheus.Counter
-	randomCluster, err := NewRandomClusterSelector(initializationErrorCounter, configProvider, &mocks.MockExecutionTargetProvider{}, db)
+	randomCluster, err := NewRandomClusterSelector(promutils.NewTestScope(), initializationErrorCounter, configProvider, &mocks.MockExecutionTargetProvider{}, db)
 	assert.NoError(t, err)
 	return randomCluster
 }
@@ -169,3 +180,253 @@ func TestRandomClusterSelectorGetAllValidTargets(t *testing.T) {
 	targets := cluster.GetAllValidTargets()
 	assert.Equal(t, 2, len(targets))
 }
+
+func TestRandomClusterSelectorExcludesUnhealthyTargets(t *testing.T) {
+	cluster := getRandomClusterSelectorForTest(t).(*RandomClusterSelector)
+	probe := &mockClusterHealthProbe{unhealthy: map[string]bool{"testcluster3": true}}
+	healthChecker := cluster.setHealthChecker(probe, runtimeInterfaces.ClusterHealthCheckConfig{FailureThreshold: 1},
+		promutils.NewTestScope())
+	spec := &executioncluster.ExecutionTargetSpec{
+		Project:     testProject,
+		Domain:      "different",
+		Workflow:    testWorkflow,
+		ExecutionID: "e1",
+	}
+
+	healthChecker.ProbeTargets(context.Background(), cluster.GetAllValidTargets())
+	target, err := cluster.GetTarget(context.Background(), spec)
+	assert.Nil(t, err)
+	assert.Equal(t, "testcluster2", target.ID)
+	// Unhealthy clusters remain valid targets, e.g. for cluster resources.
+	assert.Equal(t, 2, len(cluster.GetAllValidTargets()))
+	assert.Equal(t, 2, len(cluster.GetClusterHealth()))
+
+	// Without any healthy clusters, executions are assigned to all enabled ones.
+	probe.unhealthy["testcluster2"] = true
+	healthChecker.ProbeTargets(context.Background(), cluster.GetAllValidTargets())
+	target, err = cluster.GetTarget(context.Background(), spec)
+	assert.Nil(t, err)
+	assert.Equal(t, "testcluster3", target.ID)
+
+	probe.unhealthy = map[string]bool{"testcluster2": true}
+	healthChecker.ProbeTargets(context.Background(), cluster.GetAllValidTargets())
+	target, err = cluster.GetTarget(context.Background(), &executioncluster.ExecutionTargetSpec{
+		Project:     testProject,
+		Domain:      "different",
+		Workflow:    testWorkflow,
+		ExecutionID: "e22",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "testcluster3", target.ID)
+}
+
+type mockClusterRegistry struct {
+	clusterConfigs  []runtimeInterfaces.ClusterConfig
+	labelClusterMap map[string][]runtimeInterfaces.ClusterEntity
+}
+
+func (r *mockClusterRegistry) GetClusters(ctx context.Context) (
+	[]runtimeInterfaces.ClusterConfig, map[string][]runtimeInterfaces.ClusterEntity, error) {
+	return r.clusterConfigs, r.labelClusterMap, nil
+}
+
+func (r *mockClusterRegistry) IsDynamic() bool {
+	return true
+}
+
+type countingExecutionTargetProvider struct {
+	mocks.MockExecutionTargetProvider
+	built map[string]int
+}
+
+func (p *countingExecutionTargetProvider) GetExecutionTarget(counter prometheus.Counter,
+	k8sCluster runtimeInterfaces.ClusterConfig) (*executioncluster.ExecutionTarget, error) {
+	p.built[k8sCluster.Name]++
+	return p.MockExecutionTargetProvider.GetExecutionTarget(counter, k8sCluster)
+}
+
+func TestRandomClusterSelectorReload(t *testing.T) {
+	registry := &mockClusterRegistry{
+		clusterConfigs: []runtimeInterfaces.ClusterConfig{
+			{Name: "a", Endpoint: "a_endpoint", Enabled: true},
+			{Name: "b", Endpoint: "b_endpoint", Enabled: true},
+		},
+		labelClusterMap: map[string][]runtimeInterfaces.ClusterEntity{
+			"test": {{ID: "a", Weight: 1}},
+		},
+	}
+	provider := &countingExecutionTargetProvider{built: make(map[string]int)}
+	selector := &RandomClusterSelector{
+		registry:                registry,
+		executionTargetProvider: provider,
+		resourceManager: resources.NewResourceManager(getMockRepositoryForTest(t),
+			runtime.NewConfigurationProvider().ApplicationConfiguration()),
+	}
+	ctx := context.Background()
+	spec := &executioncluster.ExecutionTargetSpec{Project: testProject, Domain: testDomain, ExecutionID: "e"}
+
+	assert.NoError(t, selector.reload(ctx))
+	target, err := selector.GetTarget(ctx, spec)
+	assert.NoError(t, err)
+	assert.Equal(t, "a", target.ID)
+
+	// Draining clusters remain valid targets but are assigned no new executions, and the targets of clusters with
+	// unchanged credentials are reused.
+	registry.clusterConfigs = []runtimeInterfaces.ClusterConfig{
+		{Name: "a", Endpoint: "a_endpoint", Enabled: true, Draining: true},
+		{Name: "b", Endpoint: "b_endpoint", Enabled: true},
+		{Name: "c", Endpoint: "c_endpoint", Enabled: true},
+	}
+	registry.labelClusterMap = map[string][]runtimeInterfaces.ClusterEntity{
+		"test": {{ID: "a", Weight: 1}, {ID: "c", Weight: 1}},
+	}
+	assert.NoError(t, selector.reload(ctx))
+	target, err = selector.GetTarget(ctx, spec)
+	assert.NoError(t, err)
+	assert.Equal(t, "c", target.ID)
+	assert.Len(t, selector.GetAllValidTargets(), 3)
+	// Executions already assigned to a draining cluster can still be aborted on it.
+	target, err = selector.GetTarget(ctx, &executioncluster.ExecutionTargetSpec{TargetID: "a"})
+	assert.NoError(t, err)
+	assert.True(t, target.Draining)
+	assert.Equal(t, map[string]int{"a": 1, "b": 1, "c": 1}, provider.built)
+
+	registry.clusterConfigs = []runtimeInterfaces.ClusterConfig{
+		{Name: "a", Endpoint: "a_endpoint", Enabled: true, Draining: true},
+		{Name: "b", Endpoint: "b_new_endpoint", Enabled: true},
+		{Name: "c", Endpoint: "c_endpoint", Enabled: true},
+	}
+	assert.NoError(t, selector.reload(ctx))
+	assert.Equal(t, map[string]int{"a": 1, "b": 2, "c": 1}, provider.built)
+
+	registry.clusterConfigs = nil
+	registry.labelClusterMap = nil
+	assert.NoError(t, selector.reload(ctx))
+	_, err = selector.GetTarget(ctx, spec)
+	assert.EqualError(t, err, errNoSelectableClusters.Error())
+	assert.Empty(t, selector.GetAllValidTargets())
+}
+
+func TestRandomClusterSelectorGetTargetExcludingTargets(t *testing.T) {
+	cluster := getRandomClusterSelectorForTest(t)
+	spec := &executioncluster.ExecutionTargetSpec{
+		Project:     testProject,
+		Domain:      "different",
+		Workflow:    testWorkflow,
+		ExecutionID: "e1",
+	}
+	target, err := cluster.GetTarget(context.Background(), spec)
+	assert.Nil(t, err)
+	assert.Equal(t, "testcluster3", target.ID)
+
+	spec.ExcludedTargetIDs = []string{"testcluster3"}
+	target, err = cluster.GetTarget(context.Background(), spec)
+	assert.Nil(t, err)
+	assert.Equal(t, "testcluster2", target.ID)
+
+	spec.ExcludedTargetIDs = []string{"testcluster3", "testcluster2"}
+	_, err = cluster.GetTarget(context.Background(), spec)
+	assert.EqualError(t, err, errNoSelectableClusters.Error())
+}
+
+func TestRandomClusterSelectorGetPreferredTarget(t *testing.T) {
+	cluster := getRandomClusterSelectorForTest(t)
+	spec := &executioncluster.ExecutionTargetSpec{
+		Project:           testProject,
+		Domain:            "different",
+		Workflow:          testWorkflow,
+		ExecutionID:       "e1",
+		PreferredTargetID: "testcluster2",
+	}
+	target, err := cluster.GetTarget(context.Background(), spec)
+	assert.Nil(t, err)
+	assert.Equal(t, "testcluster2", target.ID)
+
+	// A disabled, excluded or unknown preferred cluster falls back to the regular selection.
+	for _, preferred := range []string{"testcluster", "unknown"} {
+		spec.PreferredTargetID = preferred
+		target, err = cluster.GetTarget(context.Background(), spec)
+		assert.Nil(t, err)
+		assert.Equal(t, "testcluster3", target.ID)
+	}
+	spec.PreferredTargetID = "testcluster2"
+	spec.ExcludedTargetIDs = []string{"testcluster2"}
+	target, err = cluster.GetTarget(context.Background(), spec)
+	assert.Nil(t, err)
+	assert.Equal(t, "testcluster3", target.ID)
+}
+
+// Builds targets with the credentials of the clusters, like the cluster execution target provider.
+type credentialsExecutionTargetProvider struct {
+	countingExecutionTargetProvider
+}
+
+func (p *credentialsExecutionTargetProvider) GetExecutionTarget(counter prometheus.Counter,
+	k8sCluster runtimeInterfaces.ClusterConfig) (*executioncluster.ExecutionTarget, error) {
+	target, err := p.countingExecutionTargetProvider.GetExecutionTarget(counter, k8sCluster)
+	if err != nil {
+		return nil, err
+	}
+	kubeConf, err := flytek8s.GetRestClientConfigForCluster(k8sCluster)
+	if err != nil {
+		return nil, err
+	}
+	target.Config = *kubeConf
+	return target, nil
+}
+
+func TestRandomClusterSelectorRefreshCredentials(t *testing.T) {
+	dir := t.TempDir()
+	tokenPath := filepath.Join(dir, "token")
+	certPath := filepath.Join(dir, "cert")
+	assert.NoError(t, ioutil.WriteFile(tokenPath, []byte("token"), 0600))
+	assert.NoError(t, ioutil.WriteFile(certPath, []byte("cert"), 0600))
+	registry := &mockClusterRegistry{
+		clusterConfigs: []runtimeInterfaces.ClusterConfig{
+			{Name: "a", Endpoint: "a_endpoint", Enabled: true, Auth: runtimeInterfaces.Auth{
+				TokenPath: tokenPath,
+				CertPath:  certPath,
+			}},
+			{Name: "b", Endpoint: "b_endpoint", Enabled: true, Auth: runtimeInterfaces.Auth{
+				TokenPath: filepath.Join(dir, "b_token"),
+				CertPath:  filepath.Join(dir, "b_cert"),
+			}},
+		},
+	}
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "b_token"), []byte("b_token"), 0600))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "b_cert"), []byte("b_cert"), 0600))
+	provider := &credentialsExecutionTargetProvider{
+		countingExecutionTargetProvider: countingExecutionTargetProvider{built: make(map[string]int)},
+	}
+	selector := &RandomClusterSelector{
+		registry:                registry,
+		executionTargetProvider: provider,
+	}
+	ctx := context.Background()
+	assert.NoError(t, selector.reload(ctx))
+	inFlight, err := selector.GetTarget(ctx, &executioncluster.ExecutionTargetSpec{TargetID: "a"})
+	assert.NoError(t, err)
+
+	// Unchanged credentials don't rebuild the targets.
+	assert.NoError(t, selector.refreshCredentials(ctx))
+	assert.Equal(t, map[string]int{"a": 1, "b": 1}, provider.built)
+
+	assert.NoError(t, ioutil.WriteFile(tokenPath, []byte("rotated"), 0600))
+	assert.NoError(t, selector.refreshCredentials(ctx))
+	assert.Equal(t, map[string]int{"a": 2, "b": 1}, provider.built)
+	target, err := selector.GetTarget(ctx, &executioncluster.ExecutionTargetSpec{TargetID: "a"})
+	assert.NoError(t, err)
+	assert.Equal(t, "rotated", target.Config.BearerToken)
+	for _, target := range selector.GetAllValidTargets() {
+		if target.ID == "a" {
+			assert.Equal(t, "rotated", target.Config.BearerToken)
+		}
+	}
+	// Targets handed out before the rotation keep their credentials.
+	assert.Equal(t, "token", inFlight.Config.BearerToken)
+
+	// Credentials which can't be read, e.g. mid-rotation, keep the current target.
+	assert.NoError(t, os.Remove(certPath))
+	assert.NoError(t, selector.refreshCredentials(ctx))
+	assert.Equal(t, map[string]int{"a": 2, "b": 1}, provider.built)
+}
@@ -10,4 +10,6 @@ import (
 type ClusterInterface interface {
 	GetTarget(context.Context, *executioncluster.ExecutionTargetSpec) (*executioncluster.ExecutionTarget, error)
 	GetAllValidTargets() []executioncluster.ExecutionTarget
+	// Returns the health of the targets as of their latest probes, empty when the targets aren't probed.
+	GetClusterHealth() []executioncluster.ClusterHealth
 }
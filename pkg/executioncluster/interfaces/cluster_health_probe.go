@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
+)
+
+// ClusterHealthProbe checks whether new executions can be created in an execution target.
+type ClusterHealthProbe interface {
+	// Probe returns an error describing why the target is unhealthy, or nil if it's healthy.
+	Probe(ctx context.Context, target executioncluster.ExecutionTarget) error
+}
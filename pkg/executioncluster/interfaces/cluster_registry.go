@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"context"
+
+	runtime "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+)
+
+// ClusterRegistry provides the execution clusters and the labels mapping to them.
+type ClusterRegistry interface {
+	// GetClusters returns the clusters in a stable order, and the clusters of each label by their weights.
+	GetClusters(ctx context.Context) ([]runtime.ClusterConfig, map[string][]runtime.ClusterEntity, error)
+	// IsDynamic returns whether the clusters can change while admin runs, in which case they are reloaded periodically.
+	IsDynamic() bool
+}
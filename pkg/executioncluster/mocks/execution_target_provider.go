@@ -11,7 +11,8 @@ type MockExecutionTargetProvider struct{}
 // Creates a new Execution target for a cluster based on config passed in.
 func (c *MockExecutionTargetProvider) GetExecutionTarget(_ prometheus.Counter, k8sCluster interfaces.ClusterConfig) (*executioncluster.ExecutionTarget, error) {
 	return &executioncluster.ExecutionTarget{
-		ID:      k8sCluster.Name,
-		Enabled: k8sCluster.Enabled,
+		ID:       k8sCluster.Name,
+		Enabled:  k8sCluster.Enabled,
+		Draining: k8sCluster.Draining,
 	}, nil
 }
@@ -8,10 +8,12 @@ import (
 
 type GetTargetFunc func(context.Context, *executioncluster.ExecutionTargetSpec) (*executioncluster.ExecutionTarget, error)
 type GetAllValidTargetsFunc func() []executioncluster.ExecutionTarget
+type GetClusterHealthFunc func() []executioncluster.ClusterHealth
 
 type MockCluster struct {
 	getTargetFunc          GetTargetFunc
 	getAllValidTargetsFunc GetAllValidTargetsFunc
+	getClusterHealthFunc   GetClusterHealthFunc
 }
 
 func (m *MockCluster) SetGetTargetCallback(getTargetFunc GetTargetFunc) {
@@ -22,6 +24,10 @@ func (m *MockCluster) SetGetAllValidTargetsCallback(getAllValidTargetsFunc GetAl
 	m.getAllValidTargetsFunc = getAllValidTargetsFunc
 }
 
+func (m *MockCluster) SetGetClusterHealthCallback(getClusterHealthFunc GetClusterHealthFunc) {
+	m.getClusterHealthFunc = getClusterHealthFunc
+}
+
 func (m *MockCluster) GetTarget(ctx context.Context, execCluster *executioncluster.ExecutionTargetSpec) (*executioncluster.ExecutionTarget, error) {
 	if m.getTargetFunc != nil {
 		return m.getTargetFunc(ctx, execCluster)
@@ -35,3 +41,10 @@ func (m *MockCluster) GetAllValidTargets() []executioncluster.ExecutionTarget {
 	}
 	return nil
 }
+
+func (m *MockCluster) GetClusterHealth() []executioncluster.ClusterHealth {
+	if m.getClusterHealthFunc != nil {
+		return m.getClusterHealthFunc()
+	}
+	return nil
+}
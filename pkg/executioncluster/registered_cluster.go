@@ -0,0 +1,63 @@
+package executioncluster
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+)
+
+// ValidateRegisteredCluster checks that a cluster registered through the admin API only reads credential files from the
+// credentials directory and only connects to the allowed endpoint hosts, so that admin doesn't send its own credentials
+// to an arbitrary host.
+func ValidateRegisteredCluster(config runtimeInterfaces.ClusterRegistryConfig, cluster runtimeInterfaces.ClusterConfig) error {
+	for _, path := range []string{cluster.Auth.TokenPath, cluster.Auth.CertPath} {
+		if len(path) == 0 {
+			continue
+		}
+		if !isInDirectory(config.CredentialsDirectory, path) {
+			return fmt.Errorf("credential file [%s] isn't in the credentials directory [%s]", path,
+				config.CredentialsDirectory)
+		}
+		// The file may be a symlink, e.g. in a mounted secret, which must not lead out of the directory either.
+		resolvedDirectory, err := filepath.EvalSymlinks(config.CredentialsDirectory)
+		if err != nil {
+			continue
+		}
+		if resolvedPath, err := filepath.EvalSymlinks(path); err == nil && !isInDirectory(resolvedDirectory, resolvedPath) {
+			return fmt.Errorf("credential file [%s] links out of the credentials directory [%s]", path,
+				config.CredentialsDirectory)
+		}
+	}
+	if len(config.AllowedEndpointHosts) == 0 {
+		return nil
+	}
+	endpoint := cluster.Endpoint
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid endpoint [%s]: %v", cluster.Endpoint, err)
+	}
+	for _, host := range config.AllowedEndpointHosts {
+		if strings.EqualFold(endpointURL.Hostname(), host) {
+			return nil
+		}
+	}
+	return fmt.Errorf("endpoint [%s] isn't on an allowed host", cluster.Endpoint)
+}
+
+// Whether the absolute path is in the directory, once both are cleaned. Nothing is in an unset directory.
+func isInDirectory(directory, path string) bool {
+	if len(directory) == 0 || !filepath.IsAbs(path) || !filepath.IsAbs(directory) {
+		return false
+	}
+	relativePath, err := filepath.Rel(filepath.Clean(directory), filepath.Clean(path))
+	if err != nil {
+		return false
+	}
+	return relativePath != ".." && !strings.HasPrefix(relativePath, ".."+string(filepath.Separator))
+}
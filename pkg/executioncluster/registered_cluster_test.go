@@ -0,0 +1,51 @@
+package executioncluster
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRegisteredCluster(t *testing.T) {
+	dir := t.TempDir()
+	credentialsDirectory := filepath.Join(dir, "secrets")
+	assert.NoError(t, os.Mkdir(credentialsDirectory, 0700))
+	outsideToken := filepath.Join(dir, "token")
+	assert.NoError(t, ioutil.WriteFile(outsideToken, []byte("token"), 0600))
+	assert.NoError(t, os.Symlink(outsideToken, filepath.Join(credentialsDirectory, "link")))
+	config := runtimeInterfaces.ClusterRegistryConfig{
+		CredentialsDirectory: credentialsDirectory,
+		AllowedEndpointHosts: []string{"cluster.example.com"},
+	}
+	getCluster := func(endpoint, tokenPath string) runtimeInterfaces.ClusterConfig {
+		return runtimeInterfaces.ClusterConfig{
+			Endpoint: endpoint,
+			Auth:     runtimeInterfaces.Auth{TokenPath: tokenPath},
+		}
+	}
+
+	assert.NoError(t, ValidateRegisteredCluster(config, getCluster("cluster.example.com:443",
+		filepath.Join(credentialsDirectory, "a", "token"))))
+	assert.NoError(t, ValidateRegisteredCluster(config, getCluster("https://CLUSTER.example.com", "")))
+	for name, cluster := range map[string]runtimeInterfaces.ClusterConfig{
+		"outside":       getCluster("cluster.example.com", outsideToken),
+		"parent":        getCluster("cluster.example.com", filepath.Join(credentialsDirectory, "..", "token")),
+		"relative":      getCluster("cluster.example.com", "secrets/token"),
+		"symlink":       getCluster("cluster.example.com", filepath.Join(credentialsDirectory, "link")),
+		"sibling":       getCluster("cluster.example.com", credentialsDirectory+"-other/token"),
+		"endpoint":      getCluster("https://cluster.example.com.evil.com", ""),
+		"endpoint port": getCluster("evil.com:443", ""),
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, ValidateRegisteredCluster(config, cluster))
+		})
+	}
+
+	// Without a credentials directory no credential files can be registered, while any endpoint can without hosts.
+	assert.Error(t, ValidateRegisteredCluster(runtimeInterfaces.ClusterRegistryConfig{}, getCluster("a", "/token")))
+	assert.NoError(t, ValidateRegisteredCluster(runtimeInterfaces.ClusterRegistryConfig{}, getCluster("a", "")))
+}
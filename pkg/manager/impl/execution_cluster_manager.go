@@ -0,0 +1,292 @@
+package impl
+
+import (
+	"context"
+	"sort"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
+	executionClusterInterfaces "github.com/flyteorg/flyteadmin/pkg/executioncluster/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/flytek8s"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+)
+
+const endpoint = "endpoint"
+const maxExecutionClusterNameLength = 255
+
+type ExecutionClusterManager struct {
+	cluster executionClusterInterfaces.ClusterInterface
+	db      repositories.RepositoryInterface
+	config  runtimeInterfaces.Configuration
+}
+
+// ListExecutionClusters returns the health of the enabled execution clusters. Clusters which weren't probed yet are
+// healthy.
+func (m *ExecutionClusterManager) ListExecutionClusters(ctx context.Context) (*interfaces.ExecutionClusterList, error) {
+	probed := make(map[string]executioncluster.ClusterHealth)
+	for _, health := range m.cluster.GetClusterHealth() {
+		probed[health.ID] = health
+	}
+	clusters := make([]executioncluster.ClusterHealth, 0)
+	for _, target := range m.cluster.GetAllValidTargets() {
+		health, ok := probed[target.ID]
+		if !ok {
+			health = executioncluster.ClusterHealth{ID: target.ID, Healthy: true}
+		}
+		clusters = append(clusters, health)
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		return clusters[i].ID < clusters[j].ID
+	})
+	return &interfaces.ExecutionClusterList{
+		HealthChecksEnabled: m.config.ClusterConfiguration().GetHealthCheckConfig().Enabled,
+		Clusters:            clusters,
+	}, nil
+}
+
+func (m *ExecutionClusterManager) validateRegistryIsDynamic() error {
+	if m.config.ClusterConfiguration().GetRegistryConfig().Source != runtimeInterfaces.ClusterRegistrySourceDatabase {
+		return errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+			"execution clusters are configured rather than registered in the database")
+	}
+	return nil
+}
+
+// Validates that the cluster is allowed to connect where it does, and that its credentials can be read, so that the
+// cluster can be loaded by the selector.
+func (m *ExecutionClusterManager) validateExecutionClusterConnection(cluster models.ExecutionCluster) error {
+	if err := validation.ValidateEmptyStringField(cluster.Endpoint, endpoint); err != nil {
+		return err
+	}
+	clusterConfig := runtimeInterfaces.ClusterConfig{
+		Name:     cluster.Name,
+		Endpoint: cluster.Endpoint,
+		Auth: runtimeInterfaces.Auth{
+			Type:      cluster.AuthType,
+			TokenPath: cluster.TokenPath,
+			CertPath:  cluster.CertPath,
+		},
+	}
+	if err := executioncluster.ValidateRegisteredCluster(
+		m.config.ClusterConfiguration().GetRegistryConfig(), clusterConfig); err != nil {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid execution cluster [%s]: %v", cluster.Name, err)
+	}
+	_, err := flytek8s.GetRestClientConfigForCluster(clusterConfig)
+	if err != nil {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"failed to load the credentials of execution cluster [%s]: %v", cluster.Name, err)
+	}
+	return nil
+}
+
+// The clusters of a label are selected from by weights between 0 and 1.
+func validateExecutionClusterWeight(weight float32) error {
+	if weight < 0 || weight > 1 {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "execution cluster weight [%v] must be between 0 and 1",
+			weight)
+	}
+	return nil
+}
+
+func (m *ExecutionClusterManager) getRegistration(ctx context.Context, name string) (
+	*interfaces.ExecutionClusterRegistration, error) {
+	cluster, err := m.db.ExecutionClusterRepo().Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	labels, err := m.db.ExecutionClusterRepo().ListLabels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	registration := &interfaces.ExecutionClusterRegistration{
+		Name:     cluster.Name,
+		Endpoint: cluster.Endpoint,
+		Auth: interfaces.ExecutionClusterAuth{
+			Type:      cluster.AuthType,
+			TokenPath: cluster.TokenPath,
+			CertPath:  cluster.CertPath,
+		},
+		Enabled:  cluster.Enabled,
+		Draining: cluster.Draining,
+		Capacity: cluster.Capacity,
+	}
+	for _, label := range labels {
+		if label.Cluster != cluster.Name {
+			continue
+		}
+		if registration.Labels == nil {
+			registration.Labels = make(map[string]float32)
+		}
+		registration.Labels[label.Label] = label.Weight
+	}
+	return registration, nil
+}
+
+func (m *ExecutionClusterManager) RegisterExecutionCluster(
+	ctx context.Context, request interfaces.ExecutionClusterRegistration) (*interfaces.ExecutionClusterRegistration, error) {
+	if err := m.validateRegistryIsDynamic(); err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateEmptyStringField(request.Name, shared.Name); err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateMaxLengthStringField(request.Name, shared.Name, maxExecutionClusterNameLength); err != nil {
+		return nil, err
+	}
+	for label, weight := range request.Labels {
+		if err := validation.ValidateEmptyStringField(label, "label"); err != nil {
+			return nil, err
+		}
+		if err := validateExecutionClusterWeight(weight); err != nil {
+			return nil, err
+		}
+	}
+	cluster := models.ExecutionCluster{
+		Name:      request.Name,
+		Endpoint:  request.Endpoint,
+		AuthType:  request.Auth.Type,
+		TokenPath: request.Auth.TokenPath,
+		CertPath:  request.Auth.CertPath,
+		Enabled:   request.Enabled,
+		Draining:  request.Draining,
+		Capacity:  request.Capacity,
+	}
+	if err := m.validateExecutionClusterConnection(cluster); err != nil {
+		return nil, err
+	}
+	labels := make([]models.ExecutionClusterLabel, 0, len(request.Labels))
+	for label, weight := range request.Labels {
+		labels = append(labels, models.ExecutionClusterLabel{
+			Label:   label,
+			Cluster: request.Name,
+			Weight:  weight,
+		})
+	}
+	// The cluster and its labels are written together so that a failed registration can be retried.
+	if err := m.db.ExecutionClusterRepo().Create(ctx, cluster, labels); err != nil {
+		return nil, err
+	}
+	logger.Infof(ctx, "Registered execution cluster [%s]", request.Name)
+	return m.getRegistration(ctx, request.Name)
+}
+
+func (m *ExecutionClusterManager) GetExecutionClusterRegistration(ctx context.Context, name string) (
+	*interfaces.ExecutionClusterRegistration, error) {
+	if err := m.validateRegistryIsDynamic(); err != nil {
+		return nil, err
+	}
+	return m.getRegistration(ctx, name)
+}
+
+func (m *ExecutionClusterManager) UpdateExecutionCluster(
+	ctx context.Context, request interfaces.ExecutionClusterUpdateRequest) (*interfaces.ExecutionClusterRegistration, error) {
+	if err := m.validateRegistryIsDynamic(); err != nil {
+		return nil, err
+	}
+	cluster, err := m.db.ExecutionClusterRepo().Get(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if request.Endpoint != nil {
+		cluster.Endpoint = *request.Endpoint
+	}
+	if request.Auth != nil {
+		cluster.AuthType = request.Auth.Type
+		cluster.TokenPath = request.Auth.TokenPath
+		cluster.CertPath = request.Auth.CertPath
+	}
+	if request.Endpoint != nil || request.Auth != nil {
+		if err := m.validateExecutionClusterConnection(cluster); err != nil {
+			return nil, err
+		}
+	}
+	if request.Enabled != nil {
+		cluster.Enabled = *request.Enabled
+	}
+	if request.Draining != nil {
+		cluster.Draining = *request.Draining
+	}
+	if request.Capacity != nil {
+		cluster.Capacity = *request.Capacity
+	}
+	if err := m.db.ExecutionClusterRepo().Update(ctx, cluster); err != nil {
+		return nil, err
+	}
+	logger.Infof(ctx, "Updated execution cluster [%s], enabled [%v], draining [%v]", cluster.Name, cluster.Enabled,
+		cluster.Draining)
+	return m.getRegistration(ctx, request.Name)
+}
+
+func (m *ExecutionClusterManager) SetExecutionClusterLabel(
+	ctx context.Context, request interfaces.ExecutionClusterLabelRequest) (*interfaces.ExecutionClusterRegistration, error) {
+	if err := m.validateRegistryIsDynamic(); err != nil {
+		return nil, err
+	}
+	if err := validateExecutionClusterWeight(request.Weight); err != nil {
+		return nil, err
+	}
+	if _, err := m.db.ExecutionClusterRepo().Get(ctx, request.Cluster); err != nil {
+		return nil, err
+	}
+	if err := m.db.ExecutionClusterRepo().SetLabel(ctx, models.ExecutionClusterLabel{
+		Label:   request.Label,
+		Cluster: request.Cluster,
+		Weight:  request.Weight,
+	}); err != nil {
+		return nil, err
+	}
+	return m.getRegistration(ctx, request.Cluster)
+}
+
+func (m *ExecutionClusterManager) RemoveExecutionClusterLabel(ctx context.Context, cluster, label string) (
+	*interfaces.ExecutionClusterRegistration, error) {
+	if err := m.validateRegistryIsDynamic(); err != nil {
+		return nil, err
+	}
+	if err := m.db.ExecutionClusterRepo().DeleteLabel(ctx, label, cluster); err != nil {
+		return nil, err
+	}
+	return m.getRegistration(ctx, cluster)
+}
+
+// GetExecutionClusterDrainStatus reports the executions left running on the cluster, whether it's configured or
+// registered in the database. The cluster is reported as draining once the selector stops assigning it executions.
+func (m *ExecutionClusterManager) GetExecutionClusterDrainStatus(ctx context.Context, cluster string) (
+	*interfaces.ExecutionClusterDrainStatus, error) {
+	if err := validation.ValidateEmptyStringField(cluster, shared.Name); err != nil {
+		return nil, err
+	}
+	target, err := m.cluster.GetTarget(ctx, &executioncluster.ExecutionTargetSpec{TargetID: cluster})
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.NotFound, "execution cluster [%s] not found: %v", cluster, err)
+	}
+	counts, err := m.db.ExecutionRepo().CountNonTerminalByCluster(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nonTerminalExecutions := counts[target.ID]
+	return &interfaces.ExecutionClusterDrainStatus{
+		Cluster:               target.ID,
+		Draining:              target.Draining,
+		NonTerminalExecutions: nonTerminalExecutions,
+		Drained:               target.Draining && nonTerminalExecutions == 0,
+	}, nil
+}
+
+func NewExecutionClusterManager(
+	cluster executionClusterInterfaces.ClusterInterface,
+	db repositories.RepositoryInterface,
+	config runtimeInterfaces.Configuration) interfaces.ExecutionClusterInterface {
+	return &ExecutionClusterManager{
+		cluster: cluster,
+		db:      db,
+		config:  config,
+	}
+}
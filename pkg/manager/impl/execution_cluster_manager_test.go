@@ -0,0 +1,255 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
+	clusterMocks "github.com/flyteorg/flyteadmin/pkg/executioncluster/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteadmin/pkg/runtime"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestListExecutionClusters(t *testing.T) {
+	cluster := clusterMocks.MockCluster{}
+	cluster.SetGetAllValidTargetsCallback(func() []executioncluster.ExecutionTarget {
+		return []executioncluster.ExecutionTarget{{ID: "b", Enabled: true}, {ID: "a", Enabled: true}}
+	})
+	cluster.SetGetClusterHealthCallback(func() []executioncluster.ClusterHealth {
+		return []executioncluster.ClusterHealth{
+			{ID: "b", Healthy: false, Error: "unreachable", ConsecutiveFailures: 3},
+		}
+	})
+	configProvider := runtimeMocks.NewMockConfigurationProvider(
+		nil, nil, runtime.NewClusterConfigurationProvider(), nil, nil, nil)
+	manager := NewExecutionClusterManager(&cluster, repositoryMocks.NewMockRepository(), configProvider)
+
+	clusters, err := manager.ListExecutionClusters(context.Background())
+	assert.NoError(t, err)
+	assert.False(t, clusters.HealthChecksEnabled)
+	assert.Equal(t, []executioncluster.ClusterHealth{
+		{ID: "a", Healthy: true},
+		{ID: "b", Healthy: false, Error: "unreachable", ConsecutiveFailures: 3},
+	}, clusters.Clusters)
+}
+
+type databaseClusterConfiguration struct {
+	runtime.ClusterConfigurationProvider
+	credentialsDirectory string
+}
+
+func (c *databaseClusterConfiguration) GetRegistryConfig() runtimeInterfaces.ClusterRegistryConfig {
+	return runtimeInterfaces.ClusterRegistryConfig{
+		Source:               runtimeInterfaces.ClusterRegistrySourceDatabase,
+		CredentialsDirectory: c.credentialsDirectory,
+	}
+}
+
+func getExecutionClusterManagerForTest(db repositories.RepositoryInterface,
+	credentialsDirectory string) interfaces.ExecutionClusterInterface {
+	configProvider := runtimeMocks.NewMockConfigurationProvider(
+		nil, nil, &databaseClusterConfiguration{credentialsDirectory: credentialsDirectory}, nil, nil, nil)
+	return NewExecutionClusterManager(&clusterMocks.MockCluster{}, db, configProvider)
+}
+
+// Returns the credentials directory along with the credentials written to it.
+func getExecutionClusterCredentialsForTest(t *testing.T) (string, interfaces.ExecutionClusterAuth) {
+	dir := t.TempDir()
+	tokenPath := filepath.Join(dir, "token")
+	certPath := filepath.Join(dir, "cert")
+	assert.NoError(t, ioutil.WriteFile(tokenPath, []byte("token"), 0600))
+	assert.NoError(t, ioutil.WriteFile(certPath, []byte("cert"), 0600))
+	return dir, interfaces.ExecutionClusterAuth{Type: "file_path", TokenPath: tokenPath, CertPath: certPath}
+}
+
+func TestExecutionClusterManager_ConfiguredRegistry(t *testing.T) {
+	configProvider := runtimeMocks.NewMockConfigurationProvider(
+		nil, nil, runtime.NewClusterConfigurationProvider(), nil, nil, nil)
+	manager := NewExecutionClusterManager(&clusterMocks.MockCluster{}, repositoryMocks.NewMockRepository(), configProvider)
+	_, err := manager.RegisterExecutionCluster(context.Background(), interfaces.ExecutionClusterRegistration{Name: "a"})
+	assert.Equal(t, codes.FailedPrecondition, err.(adminErrors.FlyteAdminError).Code())
+}
+
+func TestRegisterExecutionCluster(t *testing.T) {
+	db := repositoryMocks.NewMockRepository()
+	repo := db.ExecutionClusterRepo().(*repositoryMocks.MockExecutionClusterRepo)
+	var created models.ExecutionCluster
+	labels := make([]models.ExecutionClusterLabel, 0)
+	repo.SetCreateCallback(func(ctx context.Context, input models.ExecutionCluster,
+		inputLabels []models.ExecutionClusterLabel) error {
+		created = input
+		labels = append(labels, inputLabels...)
+		return nil
+	})
+	repo.SetSetLabelCallback(func(ctx context.Context, input models.ExecutionClusterLabel) error {
+		t.Fatal("labels are created along with the cluster")
+		return nil
+	})
+	repo.SetGetCallback(func(ctx context.Context, name string) (models.ExecutionCluster, error) {
+		return created, nil
+	})
+	repo.SetListLabelsCallback(func(ctx context.Context) ([]models.ExecutionClusterLabel, error) {
+		return append(labels, models.ExecutionClusterLabel{Label: "gpu", Cluster: "other", Weight: 1}), nil
+	})
+	credentialsDirectory, auth := getExecutionClusterCredentialsForTest(t)
+	manager := getExecutionClusterManagerForTest(db, credentialsDirectory)
+
+	registration, err := manager.RegisterExecutionCluster(context.Background(), interfaces.ExecutionClusterRegistration{
+		Name:     "a",
+		Endpoint: "a_endpoint",
+		Auth:     auth,
+		Enabled:  true,
+		Capacity: 10,
+		Labels:   map[string]float32{"gpu": 0.5},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &interfaces.ExecutionClusterRegistration{
+		Name:     "a",
+		Endpoint: "a_endpoint",
+		Auth:     auth,
+		Enabled:  true,
+		Capacity: 10,
+		Labels:   map[string]float32{"gpu": 0.5},
+	}, registration)
+	assert.Equal(t, auth.TokenPath, created.TokenPath)
+}
+
+func TestRegisterExecutionCluster_Invalid(t *testing.T) {
+	credentialsDirectory, auth := getExecutionClusterCredentialsForTest(t)
+	manager := getExecutionClusterManagerForTest(repositoryMocks.NewMockRepository(), credentialsDirectory)
+	_, outsideAuth := getExecutionClusterCredentialsForTest(t)
+	for name, registration := range map[string]interfaces.ExecutionClusterRegistration{
+		"name":     {Endpoint: "endpoint", Auth: auth},
+		"endpoint": {Name: "a", Auth: auth},
+		"credentials": {Name: "a", Endpoint: "endpoint", Auth: interfaces.ExecutionClusterAuth{
+			TokenPath: filepath.Join(credentialsDirectory, "missing")}},
+		"credentials directory": {Name: "a", Endpoint: "endpoint", Auth: outsideAuth},
+		"weight":                {Name: "a", Endpoint: "endpoint", Auth: auth, Labels: map[string]float32{"gpu": -1}},
+		"max weight":            {Name: "a", Endpoint: "endpoint", Auth: auth, Labels: map[string]float32{"gpu": 1.5}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := manager.RegisterExecutionCluster(context.Background(), registration)
+			assert.Equal(t, codes.InvalidArgument, err.(adminErrors.FlyteAdminError).Code())
+		})
+	}
+}
+
+func TestUpdateExecutionCluster(t *testing.T) {
+	db := repositoryMocks.NewMockRepository()
+	repo := db.ExecutionClusterRepo().(*repositoryMocks.MockExecutionClusterRepo)
+	cluster := models.ExecutionCluster{Name: "a", Endpoint: "a_endpoint", Enabled: true, Capacity: 10}
+	repo.SetGetCallback(func(ctx context.Context, name string) (models.ExecutionCluster, error) {
+		assert.Equal(t, "a", name)
+		return cluster, nil
+	})
+	repo.SetUpdateCallback(func(ctx context.Context, input models.ExecutionCluster) error {
+		cluster = input
+		return nil
+	})
+	manager := getExecutionClusterManagerForTest(db, "")
+
+	draining := true
+	registration, err := manager.UpdateExecutionCluster(context.Background(), interfaces.ExecutionClusterUpdateRequest{
+		Name:     "a",
+		Draining: &draining,
+	})
+	assert.NoError(t, err)
+	assert.True(t, registration.Draining)
+	assert.True(t, registration.Enabled)
+	assert.Equal(t, 10, registration.Capacity)
+
+	// Changed credentials are validated before they're saved.
+	endpoint := "a_new_endpoint"
+	_, err = manager.UpdateExecutionCluster(context.Background(), interfaces.ExecutionClusterUpdateRequest{
+		Name:     "a",
+		Endpoint: &endpoint,
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(adminErrors.FlyteAdminError).Code())
+	assert.Equal(t, "a_endpoint", cluster.Endpoint)
+}
+
+func TestSetExecutionClusterLabel(t *testing.T) {
+	db := repositoryMocks.NewMockRepository()
+	repo := db.ExecutionClusterRepo().(*repositoryMocks.MockExecutionClusterRepo)
+	manager := getExecutionClusterManagerForTest(db, "")
+	request := interfaces.ExecutionClusterLabelRequest{Cluster: "a", Label: "gpu", Weight: 0.5}
+
+	_, err := manager.SetExecutionClusterLabel(context.Background(), request)
+	assert.Equal(t, codes.NotFound, err.(adminErrors.FlyteAdminError).Code())
+
+	repo.SetGetCallback(func(ctx context.Context, name string) (models.ExecutionCluster, error) {
+		return models.ExecutionCluster{Name: name}, nil
+	})
+	var labels []models.ExecutionClusterLabel
+	repo.SetSetLabelCallback(func(ctx context.Context, input models.ExecutionClusterLabel) error {
+		labels = append(labels, input)
+		return nil
+	})
+	repo.SetListLabelsCallback(func(ctx context.Context) ([]models.ExecutionClusterLabel, error) {
+		return labels, nil
+	})
+	registration, err := manager.SetExecutionClusterLabel(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]float32{"gpu": 0.5}, registration.Labels)
+
+	repo.SetDeleteLabelCallback(func(ctx context.Context, label, cluster string) error {
+		assert.Equal(t, "gpu", label)
+		assert.Equal(t, "a", cluster)
+		labels = nil
+		return nil
+	})
+	registration, err = manager.RemoveExecutionClusterLabel(context.Background(), "a", "gpu")
+	assert.NoError(t, err)
+	assert.Empty(t, registration.Labels)
+}
+
+func TestGetExecutionClusterDrainStatus(t *testing.T) {
+	cluster := clusterMocks.MockCluster{}
+	cluster.SetGetTargetCallback(func(ctx context.Context, spec *executioncluster.ExecutionTargetSpec) (
+		*executioncluster.ExecutionTarget, error) {
+		switch spec.TargetID {
+		case "draining", "drained":
+			return &executioncluster.ExecutionTarget{ID: spec.TargetID, Enabled: true, Draining: true}, nil
+		case "active":
+			return &executioncluster.ExecutionTarget{ID: spec.TargetID, Enabled: true}, nil
+		}
+		return nil, fmt.Errorf("invalid cluster target %s", spec.TargetID)
+	})
+	db := repositoryMocks.NewMockRepository()
+	db.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).CountNonTerminalByClusterFunction = func(
+		ctx context.Context) (map[string]int64, error) {
+		return map[string]int64{"draining": 3, "active": 5}, nil
+	}
+	configProvider := runtimeMocks.NewMockConfigurationProvider(
+		nil, nil, runtime.NewClusterConfigurationProvider(), nil, nil, nil)
+	manager := NewExecutionClusterManager(&cluster, db, configProvider)
+
+	status, err := manager.GetExecutionClusterDrainStatus(context.Background(), "draining")
+	assert.NoError(t, err)
+	assert.Equal(t, &interfaces.ExecutionClusterDrainStatus{
+		Cluster: "draining", Draining: true, NonTerminalExecutions: 3,
+	}, status)
+
+	status, err = manager.GetExecutionClusterDrainStatus(context.Background(), "drained")
+	assert.NoError(t, err)
+	assert.True(t, status.Drained)
+
+	status, err = manager.GetExecutionClusterDrainStatus(context.Background(), "active")
+	assert.NoError(t, err)
+	assert.False(t, status.Drained)
+	assert.EqualValues(t, 5, status.NonTerminalExecutions)
+
+	_, err = manager.GetExecutionClusterDrainStatus(context.Background(), "unknown")
+	assert.Equal(t, codes.NotFound, err.(adminErrors.FlyteAdminError).Code())
+}
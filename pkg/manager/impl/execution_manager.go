@@ -30,6 +30,7 @@ import (
 
 	eventWriter "github.com/flyteorg/flyteadmin/pkg/async/events/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/async/notifications"
+	notificationImplementations "github.com/flyteorg/flyteadmin/pkg/async/notifications/implementations"
 	notificationInterfaces "github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/executions"
@@ -71,6 +72,8 @@ type executionSystemMetrics struct {
 	AcceptanceDelay            prometheus.Summary
 	PublishEventError          prometheus.Counter
 	TerminateExecutionFailures prometheus.Counter
+	TriggersEnqueued           prometheus.Counter
+	TriggerFailures            prometheus.Counter
 }
 
 type executionUserMetrics struct {
@@ -182,6 +185,36 @@ func (m *ExecutionManager) addPluginOverrides(ctx context.Context, executionID *
 	return nil, nil
 }
 
+// Returns the workflow executor the execution is routed to by the workflow executor plugin override, along with its ID,
+// and the remaining plugin overrides, which are passed on to propeller. Executions without such an override are created
+// with the executor resolved by the registry, whose ID is empty.
+func (m *ExecutionManager) getExecutor(ctx context.Context, overrides []*admin.PluginOverride) (
+	workflowengineInterfaces.WorkflowExecutor, string, []*admin.PluginOverride, error) {
+	var remainingOverrides []*admin.PluginOverride
+	var executorOverride *admin.PluginOverride
+	for _, override := range overrides {
+		if override.TaskType == workflowengineInterfaces.WorkflowExecutorTaskType {
+			executorOverride = override
+			continue
+		}
+		remainingOverrides = append(remainingOverrides, override)
+	}
+	if executorOverride == nil {
+		return workflowengine.GetRegistry().GetExecutor(), "", remainingOverrides, nil
+	}
+	for _, executorID := range executorOverride.PluginId {
+		if executor, ok := workflowengine.GetRegistry().GetExecutorByID(executorID); ok {
+			return executor, executorID, remainingOverrides, nil
+		}
+	}
+	if executorOverride.MissingPluginBehavior == admin.PluginOverride_FAIL {
+		return nil, "", nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"none of the workflow executors %v is registered", executorOverride.PluginId)
+	}
+	logger.Warnf(ctx, "None of the workflow executors %v is registered, using the default one", executorOverride.PluginId)
+	return workflowengine.GetRegistry().GetExecutor(), "", remainingOverrides, nil
+}
+
 type completeTaskResources struct {
 	Defaults runtimeInterfaces.TaskResourceSet
 	Limits   runtimeInterfaces.TaskResourceSet
@@ -429,6 +462,31 @@ func (m *ExecutionManager) getInheritedExecMetadata(ctx context.Context, request
 	return parentNodeExecutionID, sourceExecutionID, nil
 }
 
+// Returns the cluster the execution should preferably be assigned to: the cluster of the execution it relaunches or
+// recovers, so that e.g. recovery finds the original execution's data, or else, if configured, the cluster of the
+// execution which launched it. The preference is best effort, the execution is assigned by its label otherwise.
+func (m *ExecutionManager) getPreferredCluster(ctx context.Context, requestSpec *admin.ExecutionSpec) string {
+	metadata := requestSpec.GetMetadata()
+	var preferredExecutionID *core.WorkflowExecutionIdentifier
+	if metadata.GetReferenceExecution() != nil && (metadata.GetMode() == admin.ExecutionMetadata_RELAUNCH ||
+		metadata.GetMode() == admin.ExecutionMetadata_RECOVERED) {
+		preferredExecutionID = metadata.GetReferenceExecution()
+	} else if metadata.GetParentNodeExecution().GetExecutionId() != nil &&
+		m.config.ClusterConfiguration().GetSelectorConfig().ColocateChildExecutions {
+		preferredExecutionID = metadata.GetParentNodeExecution().GetExecutionId()
+	}
+	if preferredExecutionID == nil {
+		return ""
+	}
+	executionModel, err := util.GetExecutionModel(ctx, m.db, *preferredExecutionID)
+	if err != nil {
+		logger.Warnf(ctx, "Failed to get the cluster of execution [%+v], selecting the cluster by label: %v",
+			preferredExecutionID, err)
+		return ""
+	}
+	return executionModel.Cluster
+}
+
 // Produces execution-time attributes for workflow execution.
 // Defaults to overridable execution values set in the execution create request, then looks at the launch plan values
 // (if any) before defaulting to values set in the matchable resource db and further if matchable resources don't
@@ -565,7 +623,7 @@ func (m *ExecutionManager) launchSingleTaskExecution(
 	}
 	var annotations map[string]string
 	if requestSpec.Annotations != nil {
-		annotations = requestSpec.Annotations.Values
+		annotations = notifications.WithoutLaunchPlanNotificationAnnotations(requestSpec.Annotations.Values)
 	}
 
 	executionParameters := workflowengineInterfaces.ExecutionParameters{
@@ -579,12 +637,19 @@ func (m *ExecutionManager) launchSingleTaskExecution(
 		EventVersion:        m.config.ApplicationConfiguration().GetTopLevelConfig().EventVersion,
 		RoleNameKey:         m.config.ApplicationConfiguration().GetTopLevelConfig().RoleNameKey,
 		RawOutputDataConfig: launchPlan.Spec.RawOutputDataConfig,
+		InputsURI:           inputsURI,
+		OffloadInputs:       m.config.ApplicationConfiguration().GetTopLevelConfig().OffloadWorkflowInputs,
+		MaxCRDSizeBytes:     m.config.ApplicationConfiguration().GetTopLevelConfig().MaxWorkflowCRDSizeBytes,
 	}
 
 	overrides, err := m.addPluginOverrides(ctx, &workflowExecutionID, workflowExecutionID.Name, "")
 	if err != nil {
 		return nil, nil, err
 	}
+	executor, executorID, overrides, err := m.getExecutor(ctx, overrides)
+	if err != nil {
+		return nil, nil, err
+	}
 	if overrides != nil {
 		executionParameters.TaskPluginOverrides = overrides
 	}
@@ -593,13 +658,14 @@ func (m *ExecutionManager) launchSingleTaskExecution(
 		executionParameters.RecoveryExecution = request.Spec.Metadata.ReferenceExecution
 	}
 
-	execInfo, err := workflowengine.GetRegistry().GetExecutor().Execute(ctx, workflowengineInterfaces.ExecutionData{
+	execInfo, err := executor.Execute(ctx, workflowengineInterfaces.ExecutionData{
 		Namespace:               namespace,
 		ExecutionID:             &workflowExecutionID,
 		ReferenceWorkflowName:   workflow.Id.Name,
 		ReferenceLaunchPlanName: launchPlan.Id.Name,
 		WorkflowClosure:         workflow.Closure.CompiledWorkflow,
 		ExecutionParameters:     executionParameters,
+		PreferredCluster:        m.getPreferredCluster(ctx, requestSpec),
 	})
 
 	if err != nil {
@@ -638,6 +704,7 @@ func (m *ExecutionManager) launchSingleTaskExecution(
 		ParentNodeExecutionID: parentNodeExecutionID,
 		SourceExecutionID:     sourceExecutionID,
 		Cluster:               execInfo.Cluster,
+		Executor:              executorID,
 		InputsURI:             inputsURI,
 		UserInputsURI:         userInputsURI,
 	})
@@ -773,6 +840,9 @@ func (m *ExecutionManager) launchExecutionAndPrepareModel(
 	if err != nil {
 		return nil, nil, err
 	}
+	// The notification triggers of the launch plan are read from the launch plan itself, so their recipients are kept
+	// out of the annotations propagated to the workflow CRD and its pods.
+	annotations = notifications.WithoutLaunchPlanNotificationAnnotations(annotations)
 
 	executionParameters := workflowengineInterfaces.ExecutionParameters{
 		Inputs:              executionInputs,
@@ -785,12 +855,19 @@ func (m *ExecutionManager) launchExecutionAndPrepareModel(
 		EventVersion:        m.config.ApplicationConfiguration().GetTopLevelConfig().EventVersion,
 		RoleNameKey:         m.config.ApplicationConfiguration().GetTopLevelConfig().RoleNameKey,
 		RawOutputDataConfig: launchPlan.Spec.RawOutputDataConfig,
+		InputsURI:           inputsURI,
+		OffloadInputs:       m.config.ApplicationConfiguration().GetTopLevelConfig().OffloadWorkflowInputs,
+		MaxCRDSizeBytes:     m.config.ApplicationConfiguration().GetTopLevelConfig().MaxWorkflowCRDSizeBytes,
 	}
 
 	overrides, err := m.addPluginOverrides(ctx, &workflowExecutionID, launchPlan.GetSpec().WorkflowId.Name, launchPlan.Id.Name)
 	if err != nil {
 		return nil, nil, err
 	}
+	executor, executorID, overrides, err := m.getExecutor(ctx, overrides)
+	if err != nil {
+		return nil, nil, err
+	}
 	if overrides != nil {
 		executionParameters.TaskPluginOverrides = overrides
 	}
@@ -800,13 +877,14 @@ func (m *ExecutionManager) launchExecutionAndPrepareModel(
 		executionParameters.RecoveryExecution = request.Spec.Metadata.ReferenceExecution
 	}
 
-	execInfo, err := workflowengine.GetRegistry().GetExecutor().Execute(ctx, workflowengineInterfaces.ExecutionData{
+	execInfo, err := executor.Execute(ctx, workflowengineInterfaces.ExecutionData{
 		Namespace:               namespace,
 		ExecutionID:             &workflowExecutionID,
 		ReferenceWorkflowName:   workflow.Id.Name,
 		ReferenceLaunchPlanName: launchPlan.Id.Name,
 		WorkflowClosure:         workflow.Closure.CompiledWorkflow,
 		ExecutionParameters:     executionParameters,
+		PreferredCluster:        m.getPreferredCluster(ctx, requestSpec),
 	})
 
 	if err != nil {
@@ -845,6 +923,7 @@ func (m *ExecutionManager) launchExecutionAndPrepareModel(
 		ParentNodeExecutionID: parentNodeExecutionID,
 		SourceExecutionID:     sourceExecutionID,
 		Cluster:               execInfo.Cluster,
+		Executor:              executorID,
 		InputsURI:             inputsURI,
 		UserInputsURI:         userInputsURI,
 	})
@@ -1202,7 +1281,9 @@ func (m *ExecutionManager) CreateWorkflowEvent(ctx context.Context, request admi
 				request, err)
 			return nil, err
 		}
+		m.enqueueLaunchPlanTriggers(ctx, request, *executionModel)
 	}
+	m.publishWebhooks(ctx, request, *executionModel)
 	if err := m.eventPublisher.Publish(ctx, proto.MessageName(&request), &request); err != nil {
 		m.systemMetrics.PublishEventError.Inc()
 		logger.Infof(ctx, "error publishing event [%+v] with err: [%v]", request.RequestId, err)
@@ -1421,6 +1502,50 @@ func (m *ExecutionManager) publishNotifications(ctx context.Context, request adm
 	return nil
 }
 
+// publishWebhooks publishes a call for every configured webhook, and every webhook declared by the launch plan, which
+// matches the execution in its new phase. The calls are made by the notifications processor. Like notifications, errors
+// publishing the calls don't fail the event.
+func (m *ExecutionManager) publishWebhooks(ctx context.Context, request admin.WorkflowExecutionEventRequest,
+	execution models.Execution) {
+	config := m.config.ApplicationConfiguration().GetNotificationsConfig()
+	if len(config.Webhooks) == 0 && len(config.LaunchPlanWebhooks.AllowedURLPrefixes) == 0 {
+		return
+	}
+	adminExecution, err := transformers.FromExecutionModel(execution)
+	if err != nil {
+		m.systemMetrics.TransformerError.Inc()
+		logger.Errorf(ctx, "Failed to transform execution [%+v] to publish webhooks with err: %v",
+			request.Event.ExecutionId, err)
+		return
+	}
+	for _, webhook := range notifications.GetMatchingWebhooks(config.Webhooks, adminExecution, request.Event.Phase) {
+		m.publishWebhook(ctx, notifications.ToWebhookMessageFromWorkflowExecutionEvent(webhook, request, adminExecution))
+	}
+	if len(config.LaunchPlanWebhooks.AllowedURLPrefixes) == 0 {
+		return
+	}
+	launchPlanWebhooks, err := notifications.GetLaunchPlanWebhooks(ctx, m.db, config.LaunchPlanWebhooks, adminExecution)
+	if err != nil {
+		logger.Warnf(ctx, "failed to get the webhooks of the launch plan of execution [%+v] with err: %v",
+			request.Event.ExecutionId, err)
+		return
+	}
+	for _, webhook := range notifications.GetMatchingWebhooks(launchPlanWebhooks, adminExecution, request.Event.Phase) {
+		message := notifications.ToWebhookMessageFromWorkflowExecutionEvent(webhook, request, adminExecution)
+		// Launch plan webhooks aren't configured, so the call carries the URL to the processor.
+		message.URL = webhook.URL
+		m.publishWebhook(ctx, message)
+	}
+}
+
+func (m *ExecutionManager) publishWebhook(ctx context.Context, message notificationInterfaces.WebhookMessage) {
+	if err := m.notificationClient.Publish(ctx, notificationInterfaces.WebhookNotificationType,
+		notificationImplementations.MarshalWebhookMessage(message)); err != nil {
+		m.systemMetrics.PublishNotificationError.Inc()
+		logger.Infof(ctx, "error publishing call to webhook [%s] with err: [%v]", message.Webhook, err)
+	}
+}
+
 func (m *ExecutionManager) TerminateExecution(
 	ctx context.Context, request admin.ExecutionTerminateRequest) (*admin.ExecutionTerminateResponse, error) {
 	if err := validation.ValidateWorkflowExecutionIdentifier(request.Id); err != nil {
@@ -1439,7 +1564,17 @@ func (m *ExecutionManager) TerminateExecution(
 		return nil, err
 	}
 
-	err = workflowengine.GetRegistry().GetExecutor().Abort(ctx, workflowengineInterfaces.AbortData{
+	// Executions are aborted by the executor they were created with.
+	executor := workflowengine.GetRegistry().GetExecutor()
+	if len(executionModel.Executor) > 0 {
+		routedExecutor, ok := workflowengine.GetRegistry().GetExecutorByID(executionModel.Executor)
+		if !ok {
+			return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+				"workflow executor [%s] of execution [%+v] isn't registered", executionModel.Executor, request.Id)
+		}
+		executor = routedExecutor
+	}
+	err = executor.Abort(ctx, workflowengineInterfaces.AbortData{
 		Namespace: common.GetNamespaceName(
 			m.config.NamespaceMappingConfiguration().GetNamespaceTemplate(), request.Id.Project, request.Id.Domain),
 
@@ -1491,6 +1626,10 @@ func newExecutionSystemMetrics(scope promutils.Scope) executionSystemMetrics {
 			"overall count of publish event errors when invoking publish()"),
 		TerminateExecutionFailures: scope.MustNewCounter("execution_termination_failure",
 			"count of failed workflow executions terminations"),
+		TriggersEnqueued: scope.MustNewCounter("triggers_enqueued",
+			"overall count of launch plan triggers enqueued to be fired"),
+		TriggerFailures: scope.MustNewCounter("trigger_failures",
+			"count of launch plan triggers which failed to be enqueued or were skipped"),
 	}
 }
 
@@ -2,6 +2,7 @@ package impl
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"strings"
 	"testing"
@@ -40,6 +41,8 @@ import (
 
 	"fmt"
 
+	notificationImplementations "github.com/flyteorg/flyteadmin/pkg/async/notifications/implementations"
+	notificationInterfaces "github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
 	notificationMocks "github.com/flyteorg/flyteadmin/pkg/async/notifications/mocks"
 	dataMocks "github.com/flyteorg/flyteadmin/pkg/data/mocks"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
@@ -130,7 +133,7 @@ func getMockExecutionsConfigProvider() runtimeInterfaces.Configuration {
 		testutils.GetApplicationConfigWithDefaultDomains(),
 		runtimeMocks.NewMockQueueConfigurationProvider(
 			[]runtimeInterfaces.ExecutionQueue{}, []runtimeInterfaces.WorkflowConfig{}),
-		nil,
+		runtime.NewClusterConfigurationProvider(),
 		runtimeMocks.NewMockTaskResourceConfiguration(resourceDefaults, resourceLimits), nil, getMockNamespaceMappingConfig())
 	mockExecutionsConfigProvider.(*runtimeMocks.MockConfigurationProvider).AddRegistrationValidationConfiguration(
 		runtimeMocks.NewMockRegistrationValidationProvider())
@@ -848,6 +851,8 @@ func TestCreateExecutionDynamicLabelsAndAnnotations(t *testing.T) {
 		Values: map[string]string{
 			"dynamicannotation3": "dynamic3",
 			"dynamicannotation4": "dynamic4",
+			// Notification recipients aren't propagated to the workflow.
+			"flyte.org/notify-on-node-failure": "oncall@example.com",
 		},
 	}
 	response, err := execManager.CreateExecution(context.Background(), request, requestedAt)
@@ -1112,6 +1117,44 @@ func TestRecoverExecution(t *testing.T) {
 	assert.True(t, proto.Equal(expectedResponse, response))
 }
 
+func TestRecoverExecution_PrefersSourceCluster(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	mockExecutor := workflowengineMocks.WorkflowExecutor{}
+	mockExecutor.OnExecuteMatch(mock.Anything, mock.MatchedBy(func(data workflowengineInterfaces.ExecutionData) bool {
+		return data.PreferredCluster == testCluster
+	})).Return(workflowengineInterfaces.ExecutionResponse{}, nil)
+	mockExecutor.OnID().Return("testMockExecutor")
+	workflowengine.GetRegistry().Register(&mockExecutor)
+	defer resetExecutor()
+
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	startTime := time.Now()
+	startTimeProto, _ := ptypes.TimestampProto(startTime)
+	existingClosure := admin.ExecutionClosure{
+		Phase:     core.WorkflowExecution_FAILED,
+		StartedAt: startTimeProto,
+	}
+	existingClosureBytes, _ := proto.Marshal(&existingClosure)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		makeExecutionGetFunc(t, existingClosureBytes, &startTime))
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, input models.Execution) error {
+			return nil
+		})
+
+	_, err := execManager.RecoverExecution(context.Background(), admin.ExecutionRecoverRequest{
+		Id: &core.WorkflowExecutionIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		Name: "recovered",
+	}, requestedAt)
+	assert.Nil(t, err)
+	mockExecutor.AssertNumberOfCalls(t, "Execute", 1)
+}
+
 func TestRecoverExecution_RecoveredChildNode(t *testing.T) {
 	repository := getMockRepositoryForExecTest()
 	setDefaultLpCallbackForExecTest(repository)
@@ -1989,6 +2032,178 @@ func TestExecutionManager_PublishNotifications(t *testing.T) {
 	assert.Nil(t, myExecManager.publishNotifications(context.Background(), workflowRequest, executionModel))
 }
 
+func TestExecutionManager_PublishWebhooks(t *testing.T) {
+	mockApplicationConfig := runtimeMocks.MockApplicationProvider{}
+	mockApplicationConfig.SetNotificationsConfig(runtimeInterfaces.NotificationsConfig{
+		Webhooks: []runtimeInterfaces.Webhook{
+			{
+				Name:    "failures",
+				Project: "project",
+				Phases:  []string{core.WorkflowExecution_FAILED.String()},
+				Body:    `{"name": "{{ name }}", "error": "{{ error }}"}`,
+			},
+			{
+				Name:   "successes",
+				Phases: []string{core.WorkflowExecution_SUCCEEDED.String()},
+			},
+			{
+				Name:    "other-project",
+				Project: "other-project",
+			},
+		},
+	})
+	mockRuntime := runtimeMocks.NewMockConfigurationProvider(
+		&mockApplicationConfig,
+		runtimeMocks.NewMockQueueConfigurationProvider(
+			[]runtimeInterfaces.ExecutionQueue{}, []runtimeInterfaces.WorkflowConfig{}),
+		nil, nil, nil, nil)
+
+	var published []notificationInterfaces.WebhookMessage
+	publisher := notificationMocks.MockPublisher{}
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		assert.Equal(t, notificationInterfaces.WebhookNotificationType, key)
+		message, err := proto.Marshal(msg)
+		assert.NoError(t, err)
+		webhookMessage, err := notificationImplementations.UnmarshalWebhookMessage(message)
+		assert.NoError(t, err)
+		published = append(published, webhookMessage)
+		return nil
+	})
+	execManager := &ExecutionManager{
+		config:             mockRuntime,
+		systemMetrics:      newExecutionSystemMetrics(mockScope.NewTestScope()),
+		notificationClient: &publisher,
+	}
+
+	execClosure := admin.ExecutionClosure{
+		WorkflowId: &core.Identifier{
+			ResourceType: core.ResourceType_WORKFLOW,
+			Project:      "project",
+			Domain:       "domain",
+			Name:         "wf_name",
+			Version:      "wf_version",
+		},
+	}
+	execClosureBytes, _ := proto.Marshal(&execClosure)
+	executionModel := models.Execution{
+		ExecutionKey: models.ExecutionKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		Phase:   core.WorkflowExecution_FAILED.String(),
+		Closure: execClosureBytes,
+		Spec:    specBytes,
+	}
+	execManager.publishWebhooks(context.Background(), admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase: core.WorkflowExecution_FAILED,
+			OutputResult: &event.WorkflowExecutionEvent_Error{
+				Error: &core.ExecutionError{
+					Message: `"quoted"`,
+				},
+			},
+			ExecutionId: &executionIdentifier,
+		},
+	}, executionModel)
+	assert.Equal(t, []notificationInterfaces.WebhookMessage{
+		{
+			Webhook: "failures",
+			Body:    `{"name": "name", "error": " The execution failed with error: [\"quoted\"]."}`,
+		},
+	}, published)
+}
+
+func TestExecutionManager_PublishLaunchPlanWebhooks(t *testing.T) {
+	mockApplicationConfig := runtimeMocks.MockApplicationProvider{}
+	mockApplicationConfig.SetNotificationsConfig(runtimeInterfaces.NotificationsConfig{
+		LaunchPlanWebhooks: runtimeInterfaces.LaunchPlanWebhooksConfig{
+			AllowedURLPrefixes: []string{"https://hooks.example.com/"},
+		},
+	})
+	mockRuntime := runtimeMocks.NewMockConfigurationProvider(
+		&mockApplicationConfig,
+		runtimeMocks.NewMockQueueConfigurationProvider(
+			[]runtimeInterfaces.ExecutionQueue{}, []runtimeInterfaces.WorkflowConfig{}),
+		nil, nil, nil, nil)
+
+	repository := repositoryMocks.NewMockRepository()
+	lpSpec := testutils.GetSampleLpSpecForTest()
+	lpSpec.Annotations = &admin.Annotations{
+		Values: map[string]string{
+			"flyte.org/webhook-url":    "https://hooks.example.com/flyte",
+			"flyte.org/webhook-phases": core.WorkflowExecution_FAILED.String(),
+			"flyte.org/webhook-body":   `{"name": "{{ name }}", "phase": "{{ phase }}"}`,
+		},
+	}
+	lpSpecBytes, _ := proto.Marshal(&lpSpec)
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetGetCallback(
+		func(input interfaces.Identifier) (models.LaunchPlan, error) {
+			return models.LaunchPlan{
+				LaunchPlanKey: models.LaunchPlanKey{
+					Project: input.Project,
+					Domain:  input.Domain,
+					Name:    input.Name,
+					Version: input.Version,
+				},
+				Spec: lpSpecBytes,
+			}, nil
+		})
+
+	var published []notificationInterfaces.WebhookMessage
+	publisher := notificationMocks.MockPublisher{}
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		message, err := proto.Marshal(msg)
+		assert.NoError(t, err)
+		webhookMessage, err := notificationImplementations.UnmarshalWebhookMessage(message)
+		assert.NoError(t, err)
+		published = append(published, webhookMessage)
+		return nil
+	})
+	execManager := &ExecutionManager{
+		db:                 repository,
+		config:             mockRuntime,
+		systemMetrics:      newExecutionSystemMetrics(mockScope.NewTestScope()),
+		notificationClient: &publisher,
+	}
+
+	execClosureBytes, _ := proto.Marshal(&admin.ExecutionClosure{
+		WorkflowId: &core.Identifier{
+			ResourceType: core.ResourceType_WORKFLOW,
+			Project:      "project",
+			Domain:       "domain",
+			Name:         "wf_name",
+			Version:      "wf_version",
+		},
+	})
+	executionModel := models.Execution{
+		ExecutionKey: models.ExecutionKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		Phase:   core.WorkflowExecution_FAILED.String(),
+		Closure: execClosureBytes,
+		Spec:    specBytes,
+	}
+	for _, phase := range []core.WorkflowExecution_Phase{
+		core.WorkflowExecution_SUCCEEDED, core.WorkflowExecution_FAILED} {
+		execManager.publishWebhooks(context.Background(), admin.WorkflowExecutionEventRequest{
+			Event: &event.WorkflowExecutionEvent{
+				Phase:       phase,
+				ExecutionId: &executionIdentifier,
+			},
+		}, executionModel)
+	}
+	assert.Equal(t, []notificationInterfaces.WebhookMessage{
+		{
+			Webhook: "launch-plan/project/domain/name",
+			URL:     "https://hooks.example.com/flyte",
+			Body:    `{"name": "name", "phase": "failed"}`,
+		},
+	}, published)
+}
+
 func TestExecutionManager_PublishNotificationsTransformError(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
 	queue := executions.NewQueueAllocator(getMockExecutionsConfigProvider(), repository)
@@ -2206,6 +2421,39 @@ func TestTerminateExecution(t *testing.T) {
 	assert.NotNil(t, resp)
 }
 
+func TestTerminateExecution_RoutedExecutor(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	startTime := time.Now()
+	executionGetFunc := makeExecutionGetFunc(t, []byte{}, &startTime)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+			execution, err := executionGetFunc(ctx, input)
+			execution.Executor = "routedMockExecutor"
+			return execution, err
+		})
+	routedExecutor := workflowengineMocks.WorkflowExecutor{}
+	routedExecutor.OnAbortMatch(mock.Anything, mock.Anything).Return(nil)
+	routedExecutor.OnID().Return("routedMockExecutor")
+	workflowengine.GetRegistry().RegisterSelectable(&routedExecutor)
+	mockExecutor := workflowengineMocks.WorkflowExecutor{}
+	mockExecutor.OnID().Return("customMockExecutor")
+	workflowengine.GetRegistry().Register(&mockExecutor)
+	defer resetExecutor()
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+
+	_, err := execManager.TerminateExecution(context.Background(), admin.ExecutionTerminateRequest{
+		Id: &core.WorkflowExecutionIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		Cause: "abort cause",
+	})
+	assert.NoError(t, err)
+	routedExecutor.AssertNumberOfCalls(t, "Abort", 1)
+	mockExecutor.AssertNotCalled(t, "Abort", mock.Anything, mock.Anything)
+}
+
 func TestTerminateExecution_PropellerError(t *testing.T) {
 	var expectedError = errors.New("expected error")
 
@@ -2420,6 +2668,49 @@ func TestAddPluginOverrides(t *testing.T) {
 	}
 }
 
+func TestGetExecutor(t *testing.T) {
+	routedExecutor := workflowengineMocks.WorkflowExecutor{}
+	routedExecutor.OnID().Return("routedMockExecutor")
+	workflowengine.GetRegistry().RegisterSelectable(&routedExecutor)
+	resetExecutor()
+	execManager := NewExecutionManager(repositoryMocks.NewMockRepository(), getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	pythonOverride := &admin.PluginOverride{
+		TaskType: "python",
+		PluginId: []string{"plugin a"},
+	}
+	executorOverride := &admin.PluginOverride{
+		TaskType: workflowengineInterfaces.WorkflowExecutorTaskType,
+		PluginId: []string{"unknown", "routedMockExecutor"},
+	}
+
+	executor, executorID, overrides, err := execManager.(*ExecutionManager).getExecutor(
+		context.Background(), []*admin.PluginOverride{pythonOverride, executorOverride})
+	assert.NoError(t, err)
+	assert.Equal(t, &routedExecutor, executor)
+	assert.Equal(t, "routedMockExecutor", executorID)
+	assert.Equal(t, []*admin.PluginOverride{pythonOverride}, overrides)
+
+	executor, executorID, overrides, err = execManager.(*ExecutionManager).getExecutor(
+		context.Background(), []*admin.PluginOverride{pythonOverride})
+	assert.NoError(t, err)
+	assert.Equal(t, &defaultTestExecutor, executor)
+	assert.Empty(t, executorID)
+	assert.Equal(t, []*admin.PluginOverride{pythonOverride}, overrides)
+
+	executorOverride.PluginId = []string{"unknown"}
+	_, _, _, err = execManager.(*ExecutionManager).getExecutor(
+		context.Background(), []*admin.PluginOverride{executorOverride})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	executorOverride.MissingPluginBehavior = admin.PluginOverride_USE_DEFAULT
+	executor, executorID, overrides, err = execManager.(*ExecutionManager).getExecutor(
+		context.Background(), []*admin.PluginOverride{executorOverride})
+	assert.NoError(t, err)
+	assert.Equal(t, &defaultTestExecutor, executor)
+	assert.Empty(t, executorID)
+	assert.Empty(t, overrides)
+}
+
 func TestPluginOverrides_ResourceGetFailure(t *testing.T) {
 	executionID := &core.WorkflowExecutionIdentifier{
 		Project: project,
@@ -3549,3 +3840,94 @@ func TestFromAdminProtoTaskResourceSpec(t *testing.T) {
 		GPU:              resource.MustParse("2"),
 	}, taskResourceSet)
 }
+
+func TestExecutionManager_EnqueueLaunchPlanTriggers(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	repository.LaunchPlanTriggerRepo().(*repositoryMocks.MockLaunchPlanTriggerRepo).SetListByUpstreamCallback(
+		func(ctx context.Context, input interfaces.Identifier) ([]models.LaunchPlanTrigger, error) {
+			assert.Equal(t, interfaces.Identifier{
+				Project: spec.LaunchPlan.Project,
+				Domain:  spec.LaunchPlan.Domain,
+				Name:    spec.LaunchPlan.Name,
+			}, input)
+			return []models.LaunchPlanTrigger{
+				{
+					Project: "project", Domain: "domain", Name: "downstream", Version: "v1",
+					UpstreamProject: input.Project, UpstreamDomain: input.Domain, UpstreamName: input.Name,
+					Phases: "SUCCEEDED", Inputs: "foo=summary",
+				},
+				{
+					Project: "project", Domain: "domain", Name: "on_failure", Version: "v1",
+					UpstreamProject: input.Project, UpstreamDomain: input.Domain, UpstreamName: input.Name,
+					Phases: "FAILED",
+				},
+			}, nil
+		})
+	var enqueued []models.NotificationQueueItem
+	repository.NotificationQueueRepo().(*repositoryMocks.NotificationQueueRepoInterface).OnCreateMatch(
+		mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		enqueued = append(enqueued, args.Get(1).(models.NotificationQueueItem))
+	}).Return(nil)
+
+	mockConfig := getMockExecutionsConfigProvider()
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(
+		runtimeInterfaces.ApplicationConfig{MaxTriggerDepth: 2})
+	var createdExecutions int
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, input models.Execution) error {
+			createdExecutions++
+			return nil
+		})
+	execManager := NewExecutionManager(repository, mockConfig, getMockStorageForExecTest(context.Background()),
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil,
+		&eventWriterMocks.WorkflowExecutionEventWriter{})
+
+	upstreamSpec := proto.Clone(spec).(*admin.ExecutionSpec)
+	upstreamSpec.Annotations = &admin.Annotations{Values: map[string]string{common.TriggerDepthAnnotation: "1"}}
+	upstreamSpecBytes, _ := proto.Marshal(upstreamSpec)
+	upstreamExecution := models.Execution{
+		ExecutionKey: models.ExecutionKey{
+			Project: executionIdentifier.Project,
+			Domain:  executionIdentifier.Domain,
+			Name:    executionIdentifier.Name,
+		},
+		Spec:    upstreamSpecBytes,
+		Closure: closureBytes,
+		Phase:   core.WorkflowExecution_SUCCEEDED.String(),
+	}
+	outputs := &core.LiteralMap{
+		Literals: map[string]*core.Literal{"summary": coreutils.MustMakeLiteral("done")},
+	}
+	request := admin.WorkflowExecutionEventRequest{
+		RequestId: "request",
+		Event: &event.WorkflowExecutionEvent{
+			ExecutionId:  &executionIdentifier,
+			Phase:        core.WorkflowExecution_SUCCEEDED,
+			ProducerId:   "propeller",
+			OutputResult: &event.WorkflowExecutionEvent_OutputData{OutputData: outputs},
+		},
+	}
+	execManager.(*ExecutionManager).enqueueLaunchPlanTriggers(context.Background(), request, upstreamExecution)
+	// Only the triggers firing on the phase are enqueued, and no execution is created in the meantime.
+	assert.Zero(t, createdExecutions)
+	assert.Len(t, enqueued, 1)
+	assert.Equal(t, launchPlanTriggerFiringType, enqueued[0].NotificationType)
+	assert.Equal(t, models.NotificationQueueItemStatusPending, enqueued[0].Status)
+	var firing launchPlanTriggerFiring
+	assert.NoError(t, json.Unmarshal(enqueued[0].Message, &firing))
+	assert.Equal(t, "downstream", firing.Trigger.Name)
+	assert.Equal(t, 2, firing.Depth)
+	var upstreamEvent event.WorkflowExecutionEvent
+	assert.NoError(t, proto.Unmarshal(firing.Event, &upstreamEvent))
+	assert.True(t, proto.Equal(&event.WorkflowExecutionEvent{
+		ExecutionId:  &executionIdentifier,
+		Phase:        core.WorkflowExecution_SUCCEEDED,
+		OutputResult: &event.WorkflowExecutionEvent_OutputData{OutputData: outputs},
+	}, &upstreamEvent))
+
+	// Triggers which would exceed the maximum trigger depth aren't enqueued.
+	upstreamSpec.Annotations.Values[common.TriggerDepthAnnotation] = "2"
+	upstreamExecution.Spec, _ = proto.Marshal(upstreamSpec)
+	execManager.(*ExecutionManager).enqueueLaunchPlanTriggers(context.Background(), request, upstreamExecution)
+	assert.Len(t, enqueued, 1)
+}
@@ -0,0 +1,151 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/golang/protobuf/proto"
+)
+
+// The notification type launch plan trigger firings are enqueued with in the database queue shared with notifications.
+const launchPlanTriggerFiringType = "flyteadmin.LaunchPlanTriggerFiring"
+
+// launchPlanTriggerFiring is the enqueued firing of a launch plan trigger by a terminal upstream execution.
+type launchPlanTriggerFiring struct {
+	Trigger models.LaunchPlanTrigger
+	// The trigger depth of the triggered execution.
+	Depth int
+	// The serialized terminal event of the upstream execution, which carries its outputs.
+	Event []byte
+}
+
+// Returns the name of the execution a trigger creates for an upstream execution. The name is derived from both so that
+// a redelivered terminal event doesn't trigger a second execution.
+func getTriggeredExecutionName(upstreamExecutionID core.WorkflowExecutionIdentifier, trigger models.LaunchPlanTrigger) string {
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(fmt.Sprintf("%s/%s/%s:%s/%s/%s", upstreamExecutionID.Project, upstreamExecutionID.Domain,
+		upstreamExecutionID.Name, trigger.Project, trigger.Domain, trigger.Name)))
+	return common.GetExecutionName(int64(hash.Sum64()))
+}
+
+func getLaunchPlanTriggerFromModel(trigger models.LaunchPlanTrigger) (*common.LaunchPlanTrigger, error) {
+	return common.ParseLaunchPlanTrigger(core.Identifier{
+		Project: trigger.Project,
+		Domain:  trigger.Domain,
+		Name:    trigger.Name,
+		Version: trigger.Version,
+	}, map[string]string{
+		common.TriggerOnLaunchPlanAnnotation: fmt.Sprintf(
+			"%s/%s/%s", trigger.UpstreamProject, trigger.UpstreamDomain, trigger.UpstreamName),
+		common.TriggerOnPhasesAnnotation: trigger.Phases,
+		common.TriggerInputsAnnotation:   trigger.Inputs,
+	})
+}
+
+// Only the identifier, phase and outputs of the terminal event of the upstream execution are needed to fire its
+// triggers.
+func getTriggerEvent(request admin.WorkflowExecutionEventRequest) *event.WorkflowExecutionEvent {
+	triggerEvent := &event.WorkflowExecutionEvent{
+		ExecutionId: request.Event.ExecutionId,
+		Phase:       request.Event.Phase,
+	}
+	if len(request.Event.GetOutputUri()) > 0 {
+		triggerEvent.OutputResult = &event.WorkflowExecutionEvent_OutputUri{OutputUri: request.Event.GetOutputUri()}
+	} else if request.Event.GetOutputData() != nil {
+		triggerEvent.OutputResult = &event.WorkflowExecutionEvent_OutputData{OutputData: request.Event.GetOutputData()}
+	}
+	return triggerEvent
+}
+
+// enqueueLaunchPlanTriggers enqueues the firing of every launch plan triggered by the execution reaching its terminal
+// phase. The LaunchPlanTriggerProcessor creates the triggered executions, so that the event isn't held up by them.
+// Like notifications, failures to enqueue a trigger are logged and counted but never fail the event.
+func (m *ExecutionManager) enqueueLaunchPlanTriggers(ctx context.Context, request admin.WorkflowExecutionEventRequest,
+	executionModel models.Execution) {
+	execution, err := transformers.FromExecutionModel(executionModel)
+	if err != nil {
+		m.systemMetrics.TransformerError.Inc()
+		logger.Errorf(ctx, "Failed to transform execution [%+v] to fire launch plan triggers with err: %v",
+			request.Event.ExecutionId, err)
+		return
+	}
+	launchPlanID := execution.GetSpec().GetLaunchPlan()
+	if launchPlanID == nil {
+		return
+	}
+	triggers, err := m.db.LaunchPlanTriggerRepo().ListByUpstream(ctx, repoInterfaces.Identifier{
+		Project: launchPlanID.Project,
+		Domain:  launchPlanID.Domain,
+		Name:    launchPlanID.Name,
+	})
+	if err != nil {
+		m.systemMetrics.TriggerFailures.Inc()
+		logger.Errorf(ctx, "Failed to list the launch plans triggered by execution [%+v] with err: %v",
+			request.Event.ExecutionId, err)
+		return
+	}
+	if len(triggers) == 0 {
+		return
+	}
+	depth := common.GetTriggerDepth(execution.GetSpec().GetAnnotations().GetValues()) + 1
+	if maxDepth := m.config.ApplicationConfiguration().GetTopLevelConfig().GetMaxTriggerDepth(); depth > maxDepth {
+		m.systemMetrics.TriggerFailures.Inc()
+		logger.Warnf(ctx, "Not firing the triggers of execution [%+v], they would exceed the maximum trigger depth of %d",
+			request.Event.ExecutionId, maxDepth)
+		return
+	}
+	triggerEvent, err := proto.Marshal(getTriggerEvent(request))
+	if err != nil {
+		m.systemMetrics.TriggerFailures.Inc()
+		logger.Errorf(ctx, "Failed to marshal the event of execution [%+v] to fire launch plan triggers with err: %v",
+			request.Event.ExecutionId, err)
+		return
+	}
+
+	for _, triggerModel := range triggers {
+		trigger, err := getLaunchPlanTriggerFromModel(triggerModel)
+		if err != nil {
+			m.systemMetrics.TriggerFailures.Inc()
+			logger.Errorf(ctx, "Invalid trigger of launch plan [%s/%s/%s] with err: %v",
+				triggerModel.Project, triggerModel.Domain, triggerModel.Name, err)
+			continue
+		}
+		if !trigger.FiresOn(request.Event.Phase) {
+			continue
+		}
+		if err = m.enqueueLaunchPlanTrigger(ctx, launchPlanTriggerFiring{
+			Trigger: triggerModel,
+			Depth:   depth,
+			Event:   triggerEvent,
+		}); err != nil {
+			m.systemMetrics.TriggerFailures.Inc()
+			logger.Errorf(ctx, "Failed to enqueue the trigger of launch plan [%s/%s/%s] for execution [%+v] with err: %v",
+				triggerModel.Project, triggerModel.Domain, triggerModel.Name, request.Event.ExecutionId, err)
+			continue
+		}
+		m.systemMetrics.TriggersEnqueued.Inc()
+	}
+}
+
+func (m *ExecutionManager) enqueueLaunchPlanTrigger(ctx context.Context, firing launchPlanTriggerFiring) error {
+	message, err := json.Marshal(firing)
+	if err != nil {
+		return err
+	}
+	return m.db.NotificationQueueRepo().Create(ctx, models.NotificationQueueItem{
+		NotificationType: launchPlanTriggerFiringType,
+		Message:          message,
+		Status:           models.NotificationQueueItemStatusPending,
+		VisibleAt:        m._clock.Now(),
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"strconv"
+	"time"
 
 	"github.com/flyteorg/flytestdlib/contextutils"
 
@@ -24,6 +25,9 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
 	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteadmin/scheduler/calendar"
+	schedulerCore "github.com/flyteorg/flyteadmin/scheduler/core"
+	schedulerModels "github.com/flyteorg/flyteadmin/scheduler/repositories/models"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/golang/protobuf/proto"
@@ -164,6 +168,9 @@ func (m *LaunchPlanManager) enableSchedule(ctx context.Context, launchPlanIdenti
 	if err != nil {
 		return err
 	}
+	addScheduleInput.CatchupPolicy = launchPlanSpec.GetAnnotations().GetValues()[schedulerCore.CatchupPolicyAnnotation]
+	addScheduleInput.ExclusionCalendars =
+		launchPlanSpec.GetAnnotations().GetValues()[calendar.ExclusionCalendarsAnnotation]
 
 	return m.scheduler.AddSchedule(ctx, addScheduleInput)
 }
@@ -221,6 +228,139 @@ func (m *LaunchPlanManager) updateSchedules(
 	return nil
 }
 
+func getLaunchPlanTrigger(launchPlan models.LaunchPlan) (*common.LaunchPlanTrigger, error) {
+	var launchPlanSpec admin.LaunchPlanSpec
+	if err := proto.Unmarshal(launchPlan.Spec, &launchPlanSpec); err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to unmarshal launch plan spec")
+	}
+	trigger, err := common.ParseLaunchPlanTrigger(core.Identifier{
+		Project: launchPlan.Project,
+		Domain:  launchPlan.Domain,
+		Name:    launchPlan.Name,
+		Version: launchPlan.Version,
+	}, launchPlanSpec.GetAnnotations().GetValues())
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid trigger: %v", err)
+	}
+	return trigger, nil
+}
+
+// Rejects triggers which would close a loop, that is whose chain of upstream launch plans leads back to the triggered
+// launch plan. Chains longer than the maximum trigger depth are cut short when firing and aren't followed any further.
+func (m *LaunchPlanManager) checkTriggerLoop(ctx context.Context, launchPlan models.LaunchPlan,
+	trigger common.LaunchPlanTrigger) error {
+	upstream := trigger.Upstream
+	for depth := 0; depth < m.config.ApplicationConfiguration().GetTopLevelConfig().GetMaxTriggerDepth(); depth++ {
+		if upstream.Project == launchPlan.Project && upstream.Domain == launchPlan.Domain &&
+			upstream.Name == launchPlan.Name {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"invalid trigger: launch plan [%s] is triggered by its own executions through its upstream launch plans",
+				launchPlan.Name)
+		}
+		upstreamTrigger, err := m.db.LaunchPlanTriggerRepo().Get(ctx, repoInterfaces.Identifier{
+			Project: upstream.Project,
+			Domain:  upstream.Domain,
+			Name:    upstream.Name,
+		})
+		if err != nil {
+			if flyteAdminErr, ok := err.(errors.FlyteAdminError); ok && flyteAdminErr.Code() == codes.NotFound {
+				return nil
+			}
+			return err
+		}
+		upstream = core.Identifier{
+			Project: upstreamTrigger.UpstreamProject,
+			Domain:  upstreamTrigger.UpstreamDomain,
+			Name:    upstreamTrigger.UpstreamName,
+		}
+	}
+	return nil
+}
+
+// Stores the trigger of the newly active launch plan version, replacing that of the formerly active version.
+func (m *LaunchPlanManager) updateTriggers(
+	ctx context.Context, newlyActiveLaunchPlan models.LaunchPlan, formerlyActiveLaunchPlan *models.LaunchPlan) error {
+	trigger, err := getLaunchPlanTrigger(newlyActiveLaunchPlan)
+	if err != nil {
+		return err
+	}
+	if trigger != nil {
+		if err = m.checkTriggerLoop(ctx, newlyActiveLaunchPlan, *trigger); err != nil {
+			return err
+		}
+		return m.db.LaunchPlanTriggerRepo().Set(ctx, models.LaunchPlanTrigger{
+			Project:         newlyActiveLaunchPlan.Project,
+			Domain:          newlyActiveLaunchPlan.Domain,
+			Name:            newlyActiveLaunchPlan.Name,
+			Version:         newlyActiveLaunchPlan.Version,
+			UpstreamProject: trigger.Upstream.Project,
+			UpstreamDomain:  trigger.Upstream.Domain,
+			UpstreamName:    trigger.Upstream.Name,
+			Phases:          trigger.PhasesString(),
+			Inputs:          trigger.InputsString(),
+		})
+	}
+	if formerlyActiveLaunchPlan == nil {
+		return nil
+	}
+	formerTrigger, err := getLaunchPlanTrigger(*formerlyActiveLaunchPlan)
+	if err != nil || formerTrigger == nil {
+		// The trigger of the formerly active version, if any, was valid when it was stored.
+		return nil
+	}
+	return m.db.LaunchPlanTriggerRepo().Delete(ctx, repoInterfaces.Identifier{
+		Project: formerlyActiveLaunchPlan.Project,
+		Domain:  formerlyActiveLaunchPlan.Domain,
+		Name:    formerlyActiveLaunchPlan.Name,
+		Version: formerlyActiveLaunchPlan.Version,
+	})
+}
+
+func getStorageTrigger(launchPlan models.LaunchPlan) (*schedulerModels.StorageTrigger, error) {
+	var launchPlanSpec admin.LaunchPlanSpec
+	if err := proto.Unmarshal(launchPlan.Spec, &launchPlanSpec); err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to unmarshal launch plan spec")
+	}
+	trigger, err := schedulerCore.NewStorageTrigger(schedulerModels.SchedulableEntityKey{
+		Project: launchPlan.Project,
+		Domain:  launchPlan.Domain,
+		Name:    launchPlan.Name,
+		Version: launchPlan.Version,
+	}, launchPlanSpec.GetAnnotations().GetValues(), launchPlanSpec.GetDefaultInputs())
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid storage trigger: %v", err)
+	}
+	return trigger, nil
+}
+
+// Activates the storage trigger of the newly active launch plan version, or deactivates that of the formerly active
+// version. Only objects which arrive after a trigger is first activated are launched for.
+func (m *LaunchPlanManager) updateStorageTriggers(
+	ctx context.Context, newlyActiveLaunchPlan models.LaunchPlan, formerlyActiveLaunchPlan *models.LaunchPlan) error {
+	trigger, err := getStorageTrigger(newlyActiveLaunchPlan)
+	if err != nil {
+		return err
+	}
+	if trigger != nil {
+		trigger.WatermarkTime = time.Now().UTC().Truncate(time.Microsecond)
+		return m.db.StorageTriggerRepo().Activate(ctx, *trigger)
+	}
+	if formerlyActiveLaunchPlan == nil {
+		return nil
+	}
+	formerTrigger, err := getStorageTrigger(*formerlyActiveLaunchPlan)
+	if err != nil || formerTrigger == nil {
+		// The storage trigger of the formerly active version, if any, was valid when it was activated.
+		return nil
+	}
+	return m.db.StorageTriggerRepo().Deactivate(ctx, schedulerModels.SchedulableEntityKey{
+		Project: formerlyActiveLaunchPlan.Project,
+		Domain:  formerlyActiveLaunchPlan.Domain,
+		Name:    formerlyActiveLaunchPlan.Name,
+		Version: formerlyActiveLaunchPlan.Version,
+	})
+}
+
 func (m *LaunchPlanManager) disableLaunchPlan(ctx context.Context, request admin.LaunchPlanUpdateRequest) (
 	*admin.LaunchPlanUpdateResponse, error) {
 	if err := validation.ValidateIdentifier(request.Id, common.LaunchPlan); err != nil {
@@ -257,6 +397,28 @@ func (m *LaunchPlanManager) disableLaunchPlan(ctx context.Context, request admin
 			return nil, err
 		}
 	}
+	if _, ok := launchPlanSpec.GetAnnotations().GetValues()[common.TriggerOnLaunchPlanAnnotation]; ok {
+		err = m.db.LaunchPlanTriggerRepo().Delete(ctx, repoInterfaces.Identifier{
+			Project: launchPlanModel.Project,
+			Domain:  launchPlanModel.Domain,
+			Name:    launchPlanModel.Name,
+			Version: launchPlanModel.Version,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := launchPlanSpec.GetAnnotations().GetValues()[schedulerCore.StorageTriggerPrefixAnnotation]; ok {
+		err = m.db.StorageTriggerRepo().Deactivate(ctx, schedulerModels.SchedulableEntityKey{
+			Project: launchPlanModel.Project,
+			Domain:  launchPlanModel.Domain,
+			Name:    launchPlanModel.Name,
+			Version: launchPlanModel.Version,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
 	err = m.db.LaunchPlanRepo().Update(ctx, launchPlanModel)
 	if err != nil {
 		logger.Debugf(ctx, "Failed to update launchPlanModel with ID [%+v] with err %v", request.Id, err)
@@ -311,6 +473,14 @@ func (m *LaunchPlanManager) enableLaunchPlan(ctx context.Context, request admin.
 			return nil, err
 		}
 	}
+	err = m.updateTriggers(ctx, newlyActiveLaunchPlanModel, formerlyActiveLaunchPlanModel)
+	if err != nil {
+		return nil, err
+	}
+	err = m.updateStorageTriggers(ctx, newlyActiveLaunchPlanModel, formerlyActiveLaunchPlanModel)
+	if err != nil {
+		return nil, err
+	}
 	err = m.updateSchedules(ctx, newlyActiveLaunchPlanModel, formerlyActiveLaunchPlanModel)
 	if err != nil {
 		m.metrics.FailedScheduleUpdates.Inc()
@@ -26,6 +26,10 @@ import (
 
 	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	schedulerCore "github.com/flyteorg/flyteadmin/scheduler/core"
+	schedMocks "github.com/flyteorg/flyteadmin/scheduler/repositories/mocks"
+	schedulerModels "github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
@@ -429,6 +433,35 @@ func TestEnableSchedule(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestEnableSchedule_CatchupPolicy(t *testing.T) {
+	repository := getMockRepositoryForLpTest()
+	mockScheduler := mocks.NewMockEventScheduler()
+	var catchupPolicy string
+	mockScheduler.(*mocks.MockEventScheduler).SetAddScheduleFunc(
+		func(ctx context.Context, input scheduleInterfaces.AddScheduleInput) error {
+			catchupPolicy = input.CatchupPolicy
+			return nil
+		})
+	lpManager := NewLaunchPlanManager(repository, getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
+	err := lpManager.(*LaunchPlanManager).enableSchedule(
+		context.Background(),
+		launchPlanNamedIdentifier,
+		admin.LaunchPlanSpec{
+			EntityMetadata: &admin.LaunchPlanMetadata{
+				Schedule: &admin.Schedule{
+					ScheduleExpression: &admin.Schedule_CronSchedule{
+						CronSchedule: &admin.CronSchedule{Schedule: "0 * * * *"},
+					},
+				},
+			},
+			Annotations: &admin.Annotations{
+				Values: map[string]string{"flyte.org/catchup-policy": "latest-only"},
+			},
+		})
+	assert.Nil(t, err)
+	assert.Equal(t, "latest-only", catchupPolicy)
+}
+
 func TestEnableSchedule_Error(t *testing.T) {
 	expectedErr := errors.New("expected error")
 
@@ -782,6 +815,168 @@ func TestUpdateSchedules_EnableNoSchedule(t *testing.T) {
 	assert.False(t, addCalled)
 }
 
+func getLaunchPlanModelWithAnnotations(version string, annotations map[string]string) models.LaunchPlan {
+	launchPlanSpecBytes, _ := proto.Marshal(&admin.LaunchPlanSpec{
+		Annotations: &admin.Annotations{Values: annotations},
+	})
+	return models.LaunchPlan{
+		LaunchPlanKey: models.LaunchPlanKey{
+			Project: project,
+			Domain:  domain,
+			Name:    name,
+			Version: version,
+		},
+		Spec: launchPlanSpecBytes,
+	}
+}
+
+func getMockConfigWithMaxTriggerDepth(maxTriggerDepth int) runtimeInterfaces.Configuration {
+	mockConfig := getMockConfigForLpTest()
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(
+		runtimeInterfaces.ApplicationConfig{MaxTriggerDepth: maxTriggerDepth})
+	return mockConfig
+}
+
+func TestUpdateTriggers(t *testing.T) {
+	repository := getMockRepositoryForLpTest()
+	var setTrigger models.LaunchPlanTrigger
+	repository.LaunchPlanTriggerRepo().(*repositoryMocks.MockLaunchPlanTriggerRepo).SetSetCallback(
+		func(ctx context.Context, input models.LaunchPlanTrigger) error {
+			setTrigger = input
+			return nil
+		})
+	var deleted []interfaces.Identifier
+	repository.LaunchPlanTriggerRepo().(*repositoryMocks.MockLaunchPlanTriggerRepo).SetDeleteCallback(
+		func(ctx context.Context, input interfaces.Identifier) error {
+			deleted = append(deleted, input)
+			return nil
+		})
+	lpManager := NewLaunchPlanManager(repository, getMockConfigWithMaxTriggerDepth(5), mockScheduler,
+		mockScope.NewTestScope())
+
+	formerlyActive := getLaunchPlanModelWithAnnotations("v1", map[string]string{
+		common.TriggerOnLaunchPlanAnnotation: "upstream",
+	})
+	err := lpManager.(*LaunchPlanManager).updateTriggers(context.Background(),
+		getLaunchPlanModelWithAnnotations("v2", map[string]string{
+			common.TriggerOnLaunchPlanAnnotation: "other/production/upstream",
+			common.TriggerOnPhasesAnnotation:     "failed",
+			common.TriggerInputsAnnotation:       "report=summary",
+		}), &formerlyActive)
+	assert.NoError(t, err)
+	assert.Equal(t, models.LaunchPlanTrigger{
+		Project:         project,
+		Domain:          domain,
+		Name:            name,
+		Version:         "v2",
+		UpstreamProject: "other",
+		UpstreamDomain:  "production",
+		UpstreamName:    "upstream",
+		Phases:          "FAILED",
+		Inputs:          "report=summary",
+	}, setTrigger)
+	// Setting the trigger of the newly active version replaces that of the formerly active one.
+	assert.Empty(t, deleted)
+
+	err = lpManager.(*LaunchPlanManager).updateTriggers(context.Background(),
+		getLaunchPlanModelWithAnnotations("v3", nil), &formerlyActive)
+	assert.NoError(t, err)
+	assert.Equal(t, []interfaces.Identifier{
+		{Project: project, Domain: domain, Name: name, Version: "v1"},
+	}, deleted)
+}
+
+func TestUpdateTriggers_Loop(t *testing.T) {
+	repository := getMockRepositoryForLpTest()
+	// upstream is triggered by middle, which is triggered by the launch plan being enabled.
+	repository.LaunchPlanTriggerRepo().(*repositoryMocks.MockLaunchPlanTriggerRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.Identifier) (models.LaunchPlanTrigger, error) {
+			switch input.Name {
+			case "upstream":
+				return models.LaunchPlanTrigger{UpstreamProject: project, UpstreamDomain: domain, UpstreamName: "middle"}, nil
+			case "middle":
+				return models.LaunchPlanTrigger{UpstreamProject: project, UpstreamDomain: domain, UpstreamName: name}, nil
+			}
+			return models.LaunchPlanTrigger{}, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "not found")
+		})
+	var setCalled bool
+	repository.LaunchPlanTriggerRepo().(*repositoryMocks.MockLaunchPlanTriggerRepo).SetSetCallback(
+		func(ctx context.Context, input models.LaunchPlanTrigger) error {
+			setCalled = true
+			return nil
+		})
+	launchPlan := getLaunchPlanModelWithAnnotations("v1", map[string]string{
+		common.TriggerOnLaunchPlanAnnotation: "upstream",
+	})
+
+	lpManager := NewLaunchPlanManager(repository, getMockConfigWithMaxTriggerDepth(5), mockScheduler,
+		mockScope.NewTestScope())
+	err := lpManager.(*LaunchPlanManager).updateTriggers(context.Background(), launchPlan, nil)
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+	assert.False(t, setCalled)
+
+	// Chains longer than the maximum trigger depth are cut short when firing, so they aren't followed.
+	lpManager = NewLaunchPlanManager(repository, getMockConfigWithMaxTriggerDepth(2), mockScheduler,
+		mockScope.NewTestScope())
+	err = lpManager.(*LaunchPlanManager).updateTriggers(context.Background(), launchPlan, nil)
+	assert.NoError(t, err)
+	assert.True(t, setCalled)
+}
+
+func TestUpdateStorageTriggers(t *testing.T) {
+	repository := getMockRepositoryForLpTest()
+	storageTriggerRepo := repository.StorageTriggerRepo().(*schedMocks.StorageTriggerRepoInterface)
+	var activated []schedulerModels.StorageTrigger
+	storageTriggerRepo.OnActivateMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		activated = append(activated, args.Get(1).(schedulerModels.StorageTrigger))
+	}).Return(nil)
+	var deactivated []schedulerModels.SchedulableEntityKey
+	storageTriggerRepo.OnDeactivateMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		deactivated = append(deactivated, args.Get(1).(schedulerModels.SchedulableEntityKey))
+	}).Return(nil)
+	lpManager := NewLaunchPlanManager(repository, getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
+
+	launchPlanSpecBytes, _ := proto.Marshal(&admin.LaunchPlanSpec{
+		Annotations: &admin.Annotations{Values: map[string]string{
+			schedulerCore.StorageTriggerPrefixAnnotation:  "s3://bucket/landing/",
+			schedulerCore.StorageTriggerPatternAnnotation: "*.csv",
+			schedulerCore.StorageTriggerInputAnnotation:   "path",
+		}},
+		DefaultInputs: &core.ParameterMap{
+			Parameters: map[string]*core.Parameter{
+				"path": {
+					Var: &core.Variable{
+						Type: &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_STRING}},
+					},
+				},
+			},
+		},
+	})
+	formerlyActive := getLaunchPlanModelWithAnnotations("v1", nil)
+	formerlyActive.Spec = launchPlanSpecBytes
+	newlyActive := formerlyActive
+	newlyActive.Version = "v2"
+	before := time.Now()
+	err := lpManager.(*LaunchPlanManager).updateStorageTriggers(context.Background(), newlyActive, &formerlyActive)
+	assert.NoError(t, err)
+	assert.Len(t, activated, 1)
+	assert.Equal(t, "v2", activated[0].Version)
+	assert.Equal(t, "s3://bucket/landing/", activated[0].Prefix)
+	assert.Equal(t, "*.csv", activated[0].Pattern)
+	assert.Equal(t, schedulerModels.StorageTriggerInputTypeString, activated[0].InputType)
+	assert.False(t, activated[0].WatermarkTime.Before(before.Truncate(time.Microsecond)))
+	// Activating the trigger of the newly active version replaces that of the formerly active one.
+	assert.Empty(t, deactivated)
+
+	err = lpManager.(*LaunchPlanManager).updateStorageTriggers(context.Background(),
+		getLaunchPlanModelWithAnnotations("v3", nil), &formerlyActive)
+	assert.NoError(t, err)
+	assert.Len(t, activated, 1)
+	assert.Equal(t, []schedulerModels.SchedulableEntityKey{
+		{Project: project, Domain: domain, Name: name, Version: "v1"},
+	}, deactivated)
+}
+
 func TestDisableLaunchPlan(t *testing.T) {
 	repository := getMockRepositoryForLpTest()
 
@@ -0,0 +1,248 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/benbjohnson/clock"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/flyteorg/flytestdlib/storage"
+	"github.com/golang/protobuf/proto"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+)
+
+type launchPlanTriggerProcessorMetrics struct {
+	Scope                      promutils.Scope
+	ClaimError                 prometheus.Counter
+	TriggeredExecutionsCreated prometheus.Counter
+	TriggerFailures            prometheus.Counter
+	TriggerDeadLetter          prometheus.Counter
+	QueueUpdateError           prometheus.Counter
+}
+
+// LaunchPlanTriggerProcessor fires the launch plan triggers enqueued by the execution manager when upstream executions
+// reach a terminal phase. Triggers which fail to fire are retried once their visibility timeout passes, up to the
+// configured number of attempts. Triggered executions are named after the upstream execution and the trigger, so a
+// retried trigger never creates a second execution.
+type LaunchPlanTriggerProcessor struct {
+	db               repositories.RepositoryInterface
+	executionManager interfaces.ExecutionInterface
+	storageClient    *storage.DataStore
+	config           runtimeInterfaces.LaunchPlanTriggerQueueConfig
+	clock            clock.Clock
+	stop             chan struct{}
+	metrics          launchPlanTriggerProcessorMetrics
+}
+
+func (p *LaunchPlanTriggerProcessor) StartProcessing() {
+	logger.Infof(context.Background(), "Starting launch plan trigger processor with poll interval [%v]",
+		p.config.PollInterval.Duration)
+	ticker := p.clock.Ticker(p.config.PollInterval.Duration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			p.run(context.Background())
+		}
+	}
+}
+
+func (p *LaunchPlanTriggerProcessor) StopProcessing() error {
+	close(p.stop)
+	return nil
+}
+
+// Claims and fires batches of triggers until the queue has no more visible firings.
+func (p *LaunchPlanTriggerProcessor) run(ctx context.Context) {
+	for {
+		items, err := p.db.NotificationQueueRepo().Claim(ctx, repoInterfaces.ClaimNotificationsInput{
+			Now:               p.clock.Now(),
+			VisibilityTimeout: p.config.VisibilityTimeout.Duration,
+			Limit:             p.config.BatchSize,
+			NotificationTypes: []string{launchPlanTriggerFiringType},
+		})
+		if err != nil {
+			p.metrics.ClaimError.Inc()
+			logger.Errorf(ctx, "failed to claim launch plan triggers from the database queue with err: %v", err)
+			return
+		}
+		for _, item := range items {
+			p.process(ctx, item)
+		}
+		if len(items) < p.config.BatchSize {
+			return
+		}
+	}
+}
+
+func (p *LaunchPlanTriggerProcessor) process(ctx context.Context, item models.NotificationQueueItem) {
+	var firing launchPlanTriggerFiring
+	if err := json.Unmarshal(item.Message, &firing); err != nil {
+		p.metrics.TriggerFailures.Inc()
+		logger.Errorf(ctx, "failed to unmarshal launch plan trigger [%d] with err: %v", item.ID, err)
+		p.deadLetter(ctx, item, err)
+		return
+	}
+	if err := p.fire(ctx, firing); err != nil {
+		p.metrics.TriggerFailures.Inc()
+		logger.Errorf(ctx, "Failed to fire the trigger of launch plan [%s/%s/%s] on attempt [%d] with err: %v",
+			firing.Trigger.Project, firing.Trigger.Domain, firing.Trigger.Name, item.Attempts, err)
+		if item.Attempts >= p.config.MaxAttempts {
+			p.deadLetter(ctx, item, err)
+			return
+		}
+		// The firing becomes visible for another attempt once its visibility timeout passes.
+		item.LastError = err.Error()
+		if err := p.db.NotificationQueueRepo().UpdateStatus(ctx, item); err != nil {
+			p.metrics.QueueUpdateError.Inc()
+			logger.Errorf(ctx, "failed to record the error for launch plan trigger [%d] with err: %v", item.ID, err)
+		}
+		return
+	}
+	if err := p.db.NotificationQueueRepo().Delete(ctx, item.ID); err != nil {
+		p.metrics.QueueUpdateError.Inc()
+		logger.Errorf(ctx, "failed to remove fired launch plan trigger [%d] from the queue with err: %v", item.ID, err)
+	}
+}
+
+func (p *LaunchPlanTriggerProcessor) deadLetter(ctx context.Context, item models.NotificationQueueItem, cause error) {
+	p.metrics.TriggerDeadLetter.Inc()
+	item.Status = models.NotificationQueueItemStatusDeadLetter
+	item.LastError = cause.Error()
+	if err := p.db.NotificationQueueRepo().UpdateStatus(ctx, item); err != nil {
+		p.metrics.QueueUpdateError.Inc()
+		logger.Errorf(ctx, "failed to move launch plan trigger [%d] to the dead-letter state with err: %v", item.ID, err)
+	}
+}
+
+func (p *LaunchPlanTriggerProcessor) getUpstreamOutputs(ctx context.Context, upstreamEvent *event.WorkflowExecutionEvent) (
+	*core.LiteralMap, error) {
+	if upstreamEvent.GetOutputData() != nil {
+		return upstreamEvent.GetOutputData(), nil
+	}
+	outputs := &core.LiteralMap{}
+	if len(upstreamEvent.GetOutputUri()) == 0 {
+		return outputs, nil
+	}
+	if err := p.storageClient.ReadProtobuf(ctx, storage.DataReference(upstreamEvent.GetOutputUri()), outputs); err != nil {
+		return nil, err
+	}
+	return outputs, nil
+}
+
+func (p *LaunchPlanTriggerProcessor) fire(ctx context.Context, firing launchPlanTriggerFiring) error {
+	upstreamEvent := &event.WorkflowExecutionEvent{}
+	if err := proto.Unmarshal(firing.Event, upstreamEvent); err != nil {
+		return err
+	}
+	if upstreamEvent.ExecutionId == nil {
+		return fmt.Errorf("launch plan trigger is missing the upstream execution")
+	}
+	trigger, err := getLaunchPlanTriggerFromModel(firing.Trigger)
+	if err != nil {
+		return err
+	}
+	var outputs *core.LiteralMap
+	if len(trigger.Inputs) > 0 {
+		if outputs, err = p.getUpstreamOutputs(ctx, upstreamEvent); err != nil {
+			return fmt.Errorf("failed to read the outputs of execution [%+v] with err: %v", upstreamEvent.ExecutionId, err)
+		}
+	}
+	inputs, err := common.GetTriggerInputs(*trigger, outputs)
+	if err != nil {
+		return err
+	}
+	launchPlanID := core.Identifier{
+		ResourceType: core.ResourceType_LAUNCH_PLAN,
+		Project:      firing.Trigger.Project,
+		Domain:       firing.Trigger.Domain,
+		Name:         firing.Trigger.Name,
+		Version:      firing.Trigger.Version,
+	}
+	launchPlan, err := util.GetLaunchPlan(ctx, p.db, launchPlanID)
+	if err != nil {
+		return err
+	}
+	// Annotations of the execution request replace those of the launch plan, so these are carried over explicitly.
+	annotations := map[string]string{}
+	for key, value := range launchPlan.GetSpec().GetAnnotations().GetValues() {
+		annotations[key] = value
+	}
+	annotations[common.TriggerDepthAnnotation] = fmt.Sprintf("%d", firing.Depth)
+
+	upstreamExecutionID := *upstreamEvent.ExecutionId
+	_, err = p.executionManager.CreateExecution(ctx, admin.ExecutionCreateRequest{
+		Project: firing.Trigger.Project,
+		Domain:  firing.Trigger.Domain,
+		Name:    getTriggeredExecutionName(upstreamExecutionID, firing.Trigger),
+		Spec: &admin.ExecutionSpec{
+			LaunchPlan: &launchPlanID,
+			Metadata: &admin.ExecutionMetadata{
+				Mode:               admin.ExecutionMetadata_SYSTEM,
+				ReferenceExecution: upstreamEvent.ExecutionId,
+			},
+			Annotations: &admin.Annotations{Values: annotations},
+		},
+		Inputs: inputs,
+	}, p.clock.Now())
+	if err != nil {
+		if flyteAdminErr, ok := err.(errors.FlyteAdminError); ok && flyteAdminErr.Code() == codes.AlreadyExists {
+			logger.Debugf(ctx, "Launch plan [%+v] was already triggered by execution [%+v]", launchPlanID,
+				upstreamExecutionID)
+			return nil
+		}
+		return err
+	}
+	p.metrics.TriggeredExecutionsCreated.Inc()
+	return nil
+}
+
+func newLaunchPlanTriggerProcessor(db repositories.RepositoryInterface, executionManager interfaces.ExecutionInterface,
+	storageClient *storage.DataStore, config runtimeInterfaces.LaunchPlanTriggerQueueConfig, scope promutils.Scope,
+	clock clock.Clock) *LaunchPlanTriggerProcessor {
+	return &LaunchPlanTriggerProcessor{
+		db:               db,
+		executionManager: executionManager,
+		storageClient:    storageClient,
+		config:           config,
+		clock:            clock,
+		stop:             make(chan struct{}),
+		metrics: launchPlanTriggerProcessorMetrics{
+			Scope:      scope,
+			ClaimError: scope.MustNewCounter("claim_error", "count of errors claiming launch plan triggers from the queue"),
+			TriggeredExecutionsCreated: scope.MustNewCounter("triggered_executions_created",
+				"overall count of executions created by launch plan triggers"),
+			TriggerFailures: scope.MustNewCounter("trigger_failures",
+				"count of launch plan trigger attempts which failed to fire"),
+			TriggerDeadLetter: scope.MustNewCounter("trigger_dead_letter",
+				"count of launch plan triggers moved to the dead-letter state"),
+			QueueUpdateError: scope.MustNewCounter("queue_update_error",
+				"count of errors updating fired or failed launch plan triggers in the queue"),
+		},
+	}
+}
+
+// NewLaunchPlanTriggerProcessor returns the processor which fires the launch plan triggers enqueued by the execution
+// manager through the given execution manager.
+func NewLaunchPlanTriggerProcessor(db repositories.RepositoryInterface, executionManager interfaces.ExecutionInterface,
+	storageClient *storage.DataStore, config runtimeInterfaces.LaunchPlanTriggerQueueConfig,
+	scope promutils.Scope) *LaunchPlanTriggerProcessor {
+	return newLaunchPlanTriggerProcessor(db, executionManager, storageClient, config,
+		scope.NewSubScope("launch_plan_trigger_processor"), clock.New())
+}
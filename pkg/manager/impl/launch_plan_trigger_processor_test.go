@@ -0,0 +1,163 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	managerMocks "github.com/flyteorg/flyteadmin/pkg/manager/mocks"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/clients/go/coreutils"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/flyteorg/flytestdlib/config"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+var launchPlanTriggerQueueConfig = runtimeInterfaces.LaunchPlanTriggerQueueConfig{
+	PollInterval:      config.Duration{Duration: time.Second},
+	BatchSize:         10,
+	VisibilityTimeout: config.Duration{Duration: time.Minute},
+	MaxAttempts:       2,
+}
+
+func getLaunchPlanTriggerFiringItem(t *testing.T, id uint, attempts uint32, trigger models.LaunchPlanTrigger,
+	upstreamEvent *event.WorkflowExecutionEvent) models.NotificationQueueItem {
+	serializedEvent, err := proto.Marshal(upstreamEvent)
+	assert.NoError(t, err)
+	message, err := json.Marshal(launchPlanTriggerFiring{
+		Trigger: trigger,
+		Depth:   2,
+		Event:   serializedEvent,
+	})
+	assert.NoError(t, err)
+	return models.NotificationQueueItem{
+		ID:               id,
+		NotificationType: launchPlanTriggerFiringType,
+		Message:          message,
+		Attempts:         attempts,
+	}
+}
+
+func TestLaunchPlanTriggerProcessor_Run(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	mockClock := clock.NewMock()
+
+	downstreamTrigger := models.LaunchPlanTrigger{
+		Project: "project", Domain: "domain", Name: "downstream", Version: "v1",
+		UpstreamProject: "project", UpstreamDomain: "domain", UpstreamName: "upstream",
+		Phases: "SUCCEEDED", Inputs: "foo=summary",
+	}
+	outputsTrigger := downstreamTrigger
+	outputsTrigger.Name = "missing_outputs"
+	upstreamEvent := &event.WorkflowExecutionEvent{
+		ExecutionId: &executionIdentifier,
+		Phase:       core.WorkflowExecution_SUCCEEDED,
+		OutputResult: &event.WorkflowExecutionEvent_OutputData{
+			OutputData: &core.LiteralMap{
+				Literals: map[string]*core.Literal{"summary": coreutils.MustMakeLiteral("done")},
+			},
+		},
+	}
+	missingOutputsEvent := &event.WorkflowExecutionEvent{
+		ExecutionId:  &executionIdentifier,
+		Phase:        core.WorkflowExecution_SUCCEEDED,
+		OutputResult: &event.WorkflowExecutionEvent_OutputUri{OutputUri: "s3://bucket/missing"},
+	}
+
+	queueRepo := repository.NotificationQueueRepo().(*repositoryMocks.NotificationQueueRepoInterface)
+	queueRepo.OnClaim(context.Background(), repoInterfaces.ClaimNotificationsInput{
+		Now:               mockClock.Now(),
+		VisibilityTimeout: time.Minute,
+		Limit:             10,
+		NotificationTypes: []string{launchPlanTriggerFiringType},
+	}).Return([]models.NotificationQueueItem{
+		// The outputs of the first firing can't be read, which doesn't keep the second from firing.
+		getLaunchPlanTriggerFiringItem(t, 1, 1, outputsTrigger, missingOutputsEvent),
+		getLaunchPlanTriggerFiringItem(t, 2, 1, downstreamTrigger, upstreamEvent),
+		{ID: 3, NotificationType: launchPlanTriggerFiringType, Message: []byte("invalid"), Attempts: 1},
+	}, nil).Once()
+	queueRepo.OnUpdateStatusMatch(context.Background(), mock.MatchedBy(func(item models.NotificationQueueItem) bool {
+		return item.ID == 1 && item.Status == "" && len(item.LastError) > 0
+	})).Return(nil).Once()
+	queueRepo.OnDelete(context.Background(), uint(2)).Return(nil).Once()
+	queueRepo.OnUpdateStatusMatch(context.Background(), mock.MatchedBy(func(item models.NotificationQueueItem) bool {
+		return item.ID == 3 && item.Status == models.NotificationQueueItemStatusDeadLetter
+	})).Return(nil).Once()
+
+	var requests []admin.ExecutionCreateRequest
+	executionManager := managerMocks.MockExecutionManager{}
+	executionManager.SetCreateCallback(func(ctx context.Context, request admin.ExecutionCreateRequest,
+		requestedAt time.Time) (*admin.ExecutionCreateResponse, error) {
+		requests = append(requests, request)
+		return &admin.ExecutionCreateResponse{}, nil
+	})
+
+	processor := newLaunchPlanTriggerProcessor(repository, &executionManager,
+		getMockStorageForExecTest(context.Background()), launchPlanTriggerQueueConfig, mockScope.NewTestScope(),
+		mockClock)
+	processor.run(context.Background())
+	queueRepo.AssertExpectations(t)
+
+	assert.Len(t, requests, 1)
+	request := requests[0]
+	assert.Equal(t, getTriggeredExecutionName(executionIdentifier, downstreamTrigger), request.Name)
+	assert.Equal(t, "downstream", request.Spec.LaunchPlan.Name)
+	assert.Equal(t, admin.ExecutionMetadata_SYSTEM, request.Spec.Metadata.Mode)
+	assert.True(t, proto.Equal(&executionIdentifier, request.Spec.Metadata.ReferenceExecution))
+	assert.Equal(t, "2", request.Spec.Annotations.Values[common.TriggerDepthAnnotation])
+	// The launch plan annotations are carried over to the triggered execution.
+	assert.Equal(t, "3", request.Spec.Annotations.Values["annotation3"])
+	assert.True(t, proto.Equal(coreutils.MustMakeLiteral("done"), request.Inputs.Literals["foo"]))
+}
+
+func TestLaunchPlanTriggerProcessor_Process(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	trigger := models.LaunchPlanTrigger{
+		Project: "project", Domain: "domain", Name: "downstream", Version: "v1",
+		UpstreamProject: "project", UpstreamDomain: "domain", UpstreamName: "upstream",
+		Phases: "FAILED",
+	}
+	upstreamEvent := &event.WorkflowExecutionEvent{
+		ExecutionId: &executionIdentifier,
+		Phase:       core.WorkflowExecution_FAILED,
+	}
+	var createErr error
+	executionManager := managerMocks.MockExecutionManager{}
+	executionManager.SetCreateCallback(func(ctx context.Context, request admin.ExecutionCreateRequest,
+		requestedAt time.Time) (*admin.ExecutionCreateResponse, error) {
+		return nil, createErr
+	})
+	processor := newLaunchPlanTriggerProcessor(repository, &executionManager,
+		getMockStorageForExecTest(context.Background()), launchPlanTriggerQueueConfig, mockScope.NewTestScope(),
+		clock.NewMock())
+	queueRepo := repository.NotificationQueueRepo().(*repositoryMocks.NotificationQueueRepoInterface)
+
+	// A redelivered firing whose execution already exists is done.
+	createErr = flyteAdminErrors.NewFlyteAdminError(codes.AlreadyExists, "already exists")
+	queueRepo.OnDelete(context.Background(), uint(1)).Return(nil).Once()
+	processor.process(context.Background(), getLaunchPlanTriggerFiringItem(t, 1, 2, trigger, upstreamEvent))
+
+	// Firings which keep failing are dead-lettered once they run out of attempts.
+	createErr = errors.New("foo")
+	queueRepo.OnUpdateStatusMatch(context.Background(), mock.MatchedBy(func(item models.NotificationQueueItem) bool {
+		return item.ID == 2 && item.Status == models.NotificationQueueItemStatusDeadLetter && item.LastError == "foo"
+	})).Return(nil).Once()
+	processor.process(context.Background(), getLaunchPlanTriggerFiringItem(t, 2, 2, trigger, upstreamEvent))
+	queueRepo.AssertExpectations(t)
+}
@@ -6,6 +6,7 @@ import (
 
 	eventWriter "github.com/flyteorg/flyteadmin/pkg/async/events/interfaces"
 
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications"
 	notificationInterfaces "github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
 	"github.com/golang/protobuf/proto"
 
@@ -49,17 +50,19 @@ type nodeExecutionMetrics struct {
 	NodeExecutionInputBytes    prometheus.Summary
 	NodeExecutionOutputBytes   prometheus.Summary
 	PublishEventError          prometheus.Counter
+	PublishNotificationError   prometheus.Counter
 }
 
 type NodeExecutionManager struct {
-	db             repositories.RepositoryInterface
-	config         runtimeInterfaces.Configuration
-	storagePrefix  []string
-	storageClient  *storage.DataStore
-	metrics        nodeExecutionMetrics
-	urlData        dataInterfaces.RemoteURLInterface
-	eventPublisher notificationInterfaces.Publisher
-	dbEventWriter  eventWriter.NodeExecutionEventWriter
+	db                 repositories.RepositoryInterface
+	config             runtimeInterfaces.Configuration
+	storagePrefix      []string
+	storageClient      *storage.DataStore
+	metrics            nodeExecutionMetrics
+	urlData            dataInterfaces.RemoteURLInterface
+	eventPublisher     notificationInterfaces.Publisher
+	notificationClient notificationInterfaces.Publisher
+	dbEventWriter      eventWriter.NodeExecutionEventWriter
 }
 
 type updateNodeExecutionStatus int
@@ -273,6 +276,15 @@ func (m *NodeExecutionManager) CreateNodeEvent(ctx context.Context, request admi
 	}
 	m.dbEventWriter.Write(request)
 
+	if request.Event.Phase == core.NodeExecution_FAILED {
+		publishTriggerNotifications(ctx, m.db, *m.config.ApplicationConfiguration().GetNotificationsConfig(),
+			m.notificationClient, m.metrics.PublishNotificationError, runtimeInterfaces.NotificationTriggerTypeNodeFailure,
+			request.Event.Id.ExecutionId, notifications.TriggerEvent{
+				NodeID: request.Event.Id.NodeId,
+				Error:  request.Event.GetError(),
+			})
+	}
+
 	if request.Event.Phase == core.NodeExecution_RUNNING {
 		m.metrics.ActiveNodeExecutions.Inc()
 	} else if common.IsNodeExecutionTerminal(request.Event.Phase) {
@@ -498,7 +510,8 @@ func (m *NodeExecutionManager) GetNodeExecutionData(
 
 func NewNodeExecutionManager(db repositories.RepositoryInterface, config runtimeInterfaces.Configuration,
 	storagePrefix []string, storageClient *storage.DataStore, scope promutils.Scope, urlData dataInterfaces.RemoteURLInterface,
-	eventPublisher notificationInterfaces.Publisher, eventWriter eventWriter.NodeExecutionEventWriter) interfaces.NodeExecutionInterface {
+	eventPublisher notificationInterfaces.Publisher, eventWriter eventWriter.NodeExecutionEventWriter,
+	notificationPublisher notificationInterfaces.Publisher) interfaces.NodeExecutionInterface {
 	metrics := nodeExecutionMetrics{
 		Scope: scope,
 		ActiveNodeExecutions: scope.MustNewGauge("active_node_executions",
@@ -519,16 +532,19 @@ func NewNodeExecutionManager(db repositories.RepositoryInterface, config runtime
 			"size in bytes of serialized node execution outputs"),
 		PublishEventError: scope.MustNewCounter("publish_event_error",
 			"overall count of publish event errors when invoking publish()"),
+		PublishNotificationError: scope.MustNewCounter("publish_notification_error",
+			"overall count of publish notification errors when invoking publish()"),
 	}
 	return &NodeExecutionManager{
 		db:     db,
 		config: config,
 
-		storagePrefix:  storagePrefix,
-		storageClient:  storageClient,
-		metrics:        metrics,
-		urlData:        urlData,
-		eventPublisher: eventPublisher,
-		dbEventWriter:  eventWriter,
+		storagePrefix:      storagePrefix,
+		storageClient:      storageClient,
+		metrics:            metrics,
+		urlData:            urlData,
+		eventPublisher:     eventPublisher,
+		notificationClient: notificationPublisher,
+		dbEventWriter:      eventWriter,
 	}
 }
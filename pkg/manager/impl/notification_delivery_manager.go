@@ -0,0 +1,212 @@
+package impl
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	notificationImplementations "github.com/flyteorg/flyteadmin/pkg/async/notifications/implementations"
+	notificationInterfaces "github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/contextutils"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+)
+
+type NotificationDeliveryManager struct {
+	db repositories.RepositoryInterface
+	// Publishes re-driven notifications. This is expected to be the underlying notifications publisher, rather than
+	// one which records a new delivery for every published notification.
+	publisher notificationInterfaces.Publisher
+}
+
+func fromNotificationDeliveryModel(delivery models.NotificationDelivery) interfaces.NotificationDelivery {
+	var executionID *core.WorkflowExecutionIdentifier
+	if len(delivery.Name) > 0 {
+		executionID = &core.WorkflowExecutionIdentifier{
+			Project: delivery.Project,
+			Domain:  delivery.Domain,
+			Name:    delivery.Name,
+		}
+	}
+	var recipients []string
+	if len(delivery.Recipients) > 0 {
+		recipients = strings.Split(delivery.Recipients, ",")
+	}
+	return interfaces.NotificationDelivery{
+		ID:          delivery.ID,
+		ExecutionID: executionID,
+		Channel:     delivery.Channel,
+		Recipients:  recipients,
+		Status:      delivery.Status,
+		Attempts:    delivery.Attempts,
+		LastError:   delivery.LastError,
+		CreatedAt:   delivery.CreatedAt,
+		UpdatedAt:   delivery.UpdatedAt,
+	}
+}
+
+func (m *NotificationDeliveryManager) ListNotificationDeliveries(
+	ctx context.Context, request interfaces.NotificationDeliveryListRequest) (*interfaces.NotificationDeliveryList, error) {
+	if err := validation.ValidateEmptyStringField(request.Project, shared.Project); err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateLimit(request.Limit); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	fieldValues := []struct {
+		field string
+		value string
+	}{
+		{field: "execution_project", value: request.Project},
+		{field: "execution_domain", value: request.Domain},
+		{field: "status", value: request.Status},
+	}
+	var filters []common.InlineFilter
+	for _, fieldValue := range fieldValues {
+		if len(fieldValue.value) == 0 {
+			continue
+		}
+		filter, err := common.NewSingleValueFilter(common.NotificationDelivery, common.Equal, fieldValue.field,
+			fieldValue.value)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	offset, err := validation.ValidateToken(request.Token)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for ListNotificationDeliveries", request.Token)
+	}
+	output, err := m.db.NotificationDeliveryRepo().List(ctx, repoInterfaces.ListResourceInput{
+		Limit:         int(request.Limit),
+		Offset:        offset,
+		InlineFilters: filters,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "Failed to list notification deliveries with request [%+v] with err %v", request, err)
+		return nil, err
+	}
+	deliveries := make([]interfaces.NotificationDelivery, len(output.Deliveries))
+	for idx, delivery := range output.Deliveries {
+		deliveries[idx] = fromNotificationDeliveryModel(delivery)
+	}
+	var token string
+	if len(deliveries) == int(request.Limit) {
+		token = strconv.Itoa(offset + len(deliveries))
+	}
+	return &interfaces.NotificationDeliveryList{
+		Deliveries: deliveries,
+		Token:      token,
+	}, nil
+}
+
+// Returns the notification type and the message a delivery is published with.
+func getDeliveryMessage(delivery models.NotificationDelivery) (string, proto.Message, error) {
+	if delivery.Channel == notificationImplementations.WebhookChannel {
+		webhookMessage, err := notificationImplementations.UnmarshalWebhookMessage(delivery.Message)
+		if err != nil {
+			return "", nil, err
+		}
+		return notificationInterfaces.WebhookNotificationType,
+			notificationImplementations.MarshalWebhookMessage(webhookMessage), nil
+	}
+	var email admin.EmailMessage
+	if err := proto.Unmarshal(delivery.Message, &email); err != nil {
+		return "", nil, err
+	}
+	return proto.MessageName(&admin.EmailNotification{}), &email, nil
+}
+
+// Deliveries are only visible through the project of the execution they were sent for.
+func (m *NotificationDeliveryManager) getDeliveryModel(ctx context.Context, project string, id uint) (
+	models.NotificationDelivery, error) {
+	if err := validation.ValidateEmptyStringField(project, shared.Project); err != nil {
+		return models.NotificationDelivery{}, err
+	}
+	delivery, err := m.db.NotificationDeliveryRepo().Get(ctx, id)
+	if err != nil {
+		return models.NotificationDelivery{}, err
+	}
+	if delivery.Project != project {
+		return models.NotificationDelivery{}, errors.NewFlyteAdminErrorf(codes.NotFound,
+			"notification delivery [%d] not found in project [%s]", id, project)
+	}
+	return delivery, nil
+}
+
+func (m *NotificationDeliveryManager) GetNotificationDelivery(ctx context.Context, project string, id uint) (
+	*interfaces.NotificationDelivery, error) {
+	deliveryModel, err := m.getDeliveryModel(ctx, project, id)
+	if err != nil {
+		return nil, err
+	}
+	delivery := fromNotificationDeliveryModel(deliveryModel)
+	_, msg, err := getDeliveryMessage(deliveryModel)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to unmarshal message of notification delivery [%d] with err: %v", id, err)
+	}
+	if email, ok := msg.(*admin.EmailMessage); ok {
+		delivery.Email = email
+	}
+	return &delivery, nil
+}
+
+func (m *NotificationDeliveryManager) RedriveNotificationDelivery(ctx context.Context, project string, id uint) (
+	*interfaces.NotificationDelivery, error) {
+	delivery, err := m.getDeliveryModel(ctx, project, id)
+	if err != nil {
+		return nil, err
+	}
+	if delivery.Status != models.NotificationDeliveryStatusDeadLetter {
+		return nil, errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+			"only dead-lettered notifications can be re-driven, delivery [%d] is %s", id, delivery.Status)
+	}
+	// The message carries the delivery ID, so the processors record their attempts against this delivery.
+	notificationType, msg, err := getDeliveryMessage(delivery)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to unmarshal message of notification delivery [%d] with err: %v", id, err)
+	}
+
+	delivery.Status = models.NotificationDeliveryStatusPending
+	delivery.Attempts = 0
+	delivery.LastError = ""
+	if err := m.db.NotificationDeliveryRepo().Update(ctx, delivery); err != nil {
+		return nil, err
+	}
+	if err := m.publisher.Publish(ctx, notificationType, msg); err != nil {
+		logger.Warningf(ctx, "failed to re-drive notification delivery [%d] with err: %v", id, err)
+		delivery.Status = models.NotificationDeliveryStatusDeadLetter
+		delivery.LastError = err.Error()
+		if updateErr := m.db.NotificationDeliveryRepo().Update(ctx, delivery); updateErr != nil {
+			logger.Errorf(ctx, "failed to record re-drive failure of notification delivery [%d] with err: %v",
+				id, updateErr)
+		}
+		return nil, errors.NewFlyteAdminErrorf(codes.Unavailable,
+			"failed to re-drive notification delivery [%d] with err: %v", id, err)
+	}
+	redriven := fromNotificationDeliveryModel(delivery)
+	return &redriven, nil
+}
+
+func NewNotificationDeliveryManager(
+	db repositories.RepositoryInterface, publisher notificationInterfaces.Publisher) interfaces.NotificationDeliveryInterface {
+	return &NotificationDeliveryManager{
+		db:        db,
+		publisher: publisher,
+	}
+}
@@ -0,0 +1,201 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	notificationImplementations "github.com/flyteorg/flyteadmin/pkg/async/notifications/implementations"
+	notificationInterfaces "github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	notificationMocks "github.com/flyteorg/flyteadmin/pkg/async/notifications/mocks"
+	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+var deliveryEmail = admin.EmailMessage{
+	RecipientsEmail: []string{"a@example.com", "b@example.com"},
+	SenderEmail:     "no-reply@example.com",
+	SubjectLine:     "Execution failed",
+	Body:            "body",
+}
+
+func getDeliveryModel(t *testing.T, status string) models.NotificationDelivery {
+	message, err := proto.Marshal(&deliveryEmail)
+	assert.NoError(t, err)
+	return models.NotificationDelivery{
+		ID: 1,
+		ExecutionKey: models.ExecutionKey{
+			Project: project,
+			Domain:  domain,
+			Name:    name,
+		},
+		Channel:    "email",
+		Recipients: "a@example.com,b@example.com",
+		Message:    message,
+		Status:     status,
+		Attempts:   5,
+		LastError:  "send failed",
+	}
+}
+
+func getMockDeliveryRepo(repository *repositoryMocks.MockRepository) *repositoryMocks.NotificationDeliveryRepoInterface {
+	return repository.NotificationDeliveryRepo().(*repositoryMocks.NotificationDeliveryRepoInterface)
+}
+
+func TestListNotificationDeliveries(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	getMockDeliveryRepo(repository).OnListMatch(mock.Anything, mock.MatchedBy(func(input repoInterfaces.ListResourceInput) bool {
+		return input.Limit == 1 && input.Offset == 0 && len(input.InlineFilters) == 2
+	})).Return(repoInterfaces.NotificationDeliveryCollectionOutput{
+		Deliveries: []models.NotificationDelivery{getDeliveryModel(t, models.NotificationDeliveryStatusDeadLetter)},
+	}, nil)
+
+	manager := NewNotificationDeliveryManager(repository, &notificationMocks.MockPublisher{})
+	deliveries, err := manager.ListNotificationDeliveries(context.Background(), interfaces.NotificationDeliveryListRequest{
+		Project: project,
+		Status:  models.NotificationDeliveryStatusDeadLetter,
+		Limit:   1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "1", deliveries.Token)
+	assert.Len(t, deliveries.Deliveries, 1)
+	assert.Equal(t, name, deliveries.Deliveries[0].ExecutionID.Name)
+	assert.Equal(t, []string{"a@example.com", "b@example.com"}, deliveries.Deliveries[0].Recipients)
+	assert.Nil(t, deliveries.Deliveries[0].Email)
+}
+
+func TestListNotificationDeliveries_InvalidRequest(t *testing.T) {
+	manager := NewNotificationDeliveryManager(repositoryMocks.NewMockRepository(), &notificationMocks.MockPublisher{})
+	_, err := manager.ListNotificationDeliveries(context.Background(), interfaces.NotificationDeliveryListRequest{
+		Limit: 1,
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(adminErrors.FlyteAdminError).Code())
+
+	_, err = manager.ListNotificationDeliveries(context.Background(), interfaces.NotificationDeliveryListRequest{
+		Project: project,
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(adminErrors.FlyteAdminError).Code())
+}
+
+func TestGetNotificationDelivery(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	getMockDeliveryRepo(repository).OnGetMatch(mock.Anything, uint(1)).Return(
+		getDeliveryModel(t, models.NotificationDeliveryStatusDelivered), nil)
+
+	manager := NewNotificationDeliveryManager(repository, &notificationMocks.MockPublisher{})
+	delivery, err := manager.GetNotificationDelivery(context.Background(), project, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, models.NotificationDeliveryStatusDelivered, delivery.Status)
+	assert.True(t, proto.Equal(&deliveryEmail, delivery.Email))
+
+	_, err = manager.GetNotificationDelivery(context.Background(), "other-project", 1)
+	assert.Equal(t, codes.NotFound, err.(adminErrors.FlyteAdminError).Code())
+}
+
+func TestRedriveNotificationDelivery(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	deliveryRepo := getMockDeliveryRepo(repository)
+	deliveryRepo.OnGetMatch(mock.Anything, uint(1)).Return(
+		getDeliveryModel(t, models.NotificationDeliveryStatusDeadLetter), nil)
+	deliveryRepo.OnUpdateMatch(mock.Anything, mock.MatchedBy(func(delivery models.NotificationDelivery) bool {
+		return delivery.Status == models.NotificationDeliveryStatusPending && delivery.Attempts == 0 &&
+			len(delivery.LastError) == 0
+	})).Return(nil)
+
+	var published bool
+	publisher := notificationMocks.MockPublisher{}
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		published = true
+		assert.Equal(t, "flyteidl.admin.EmailNotification", key)
+		assert.True(t, proto.Equal(&deliveryEmail, msg))
+		return nil
+	})
+	manager := NewNotificationDeliveryManager(repository, &publisher)
+	delivery, err := manager.RedriveNotificationDelivery(context.Background(), project, 1)
+	assert.NoError(t, err)
+	assert.True(t, published)
+	assert.Equal(t, models.NotificationDeliveryStatusPending, delivery.Status)
+	deliveryRepo.AssertExpectations(t)
+}
+
+func TestRedriveNotificationDelivery_Webhook(t *testing.T) {
+	webhookMessage := notificationInterfaces.WebhookMessage{
+		Webhook:    "launch-plan/project/domain/name",
+		URL:        "https://hooks.example.com/flyte",
+		Body:       `{"name": "execution"}`,
+		DeliveryID: "delivery-id",
+	}
+	message, err := proto.Marshal(notificationImplementations.MarshalWebhookMessage(webhookMessage))
+	assert.NoError(t, err)
+	deliveryModel := getDeliveryModel(t, models.NotificationDeliveryStatusDeadLetter)
+	deliveryModel.Channel = notificationImplementations.WebhookChannel
+	deliveryModel.Recipients = webhookMessage.URL
+	deliveryModel.Message = message
+
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	deliveryRepo := getMockDeliveryRepo(repository)
+	deliveryRepo.OnGetMatch(mock.Anything, uint(1)).Return(deliveryModel, nil)
+	deliveryRepo.OnUpdateMatch(mock.Anything, mock.Anything).Return(nil)
+
+	var published bool
+	publisher := notificationMocks.MockPublisher{}
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		published = true
+		assert.Equal(t, notificationInterfaces.WebhookNotificationType, key)
+		// The re-driven call carries the ID of the same delivery.
+		serialized, err := proto.Marshal(msg)
+		assert.NoError(t, err)
+		redriven, err := notificationImplementations.UnmarshalWebhookMessage(serialized)
+		assert.NoError(t, err)
+		assert.Equal(t, webhookMessage, redriven)
+		return nil
+	})
+	manager := NewNotificationDeliveryManager(repository, &publisher)
+	_, err = manager.RedriveNotificationDelivery(context.Background(), project, 1)
+	assert.NoError(t, err)
+	assert.True(t, published)
+
+	delivery, err := manager.GetNotificationDelivery(context.Background(), project, 1)
+	assert.NoError(t, err)
+	assert.Nil(t, delivery.Email)
+}
+
+func TestRedriveNotificationDelivery_NotDeadLettered(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	getMockDeliveryRepo(repository).OnGetMatch(mock.Anything, uint(1)).Return(
+		getDeliveryModel(t, models.NotificationDeliveryStatusDelivered), nil)
+
+	manager := NewNotificationDeliveryManager(repository, &notificationMocks.MockPublisher{})
+	_, err := manager.RedriveNotificationDelivery(context.Background(), project, 1)
+	assert.Equal(t, codes.FailedPrecondition, err.(adminErrors.FlyteAdminError).Code())
+}
+
+func TestRedriveNotificationDelivery_PublishError(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	deliveryRepo := getMockDeliveryRepo(repository)
+	deliveryRepo.OnGetMatch(mock.Anything, uint(1)).Return(
+		getDeliveryModel(t, models.NotificationDeliveryStatusDeadLetter), nil)
+	deliveryRepo.OnUpdateMatch(mock.Anything, mock.MatchedBy(func(delivery models.NotificationDelivery) bool {
+		return delivery.Status == models.NotificationDeliveryStatusPending
+	})).Return(nil).Once()
+	deliveryRepo.OnUpdateMatch(mock.Anything, mock.MatchedBy(func(delivery models.NotificationDelivery) bool {
+		return delivery.Status == models.NotificationDeliveryStatusDeadLetter && delivery.LastError == "foo"
+	})).Return(nil).Once()
+
+	publisher := notificationMocks.MockPublisher{}
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		return errors.New("foo")
+	})
+	manager := NewNotificationDeliveryManager(repository, &publisher)
+	_, err := manager.RedriveNotificationDelivery(context.Background(), project, 1)
+	assert.Equal(t, codes.Unavailable, err.(adminErrors.FlyteAdminError).Code())
+	deliveryRepo.AssertExpectations(t)
+}
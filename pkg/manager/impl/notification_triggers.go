@@ -0,0 +1,76 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications"
+	notificationInterfaces "github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/golang/protobuf/proto"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Returns whether any trigger of the given type may match an execution. Evaluating triggers costs a few database
+// reads, which event requests only pay when triggers are configured or launch plans may declare their own.
+func hasNotificationTriggers(config runtimeInterfaces.NotificationsConfig,
+	triggerType runtimeInterfaces.NotificationTriggerType) bool {
+	if config.LaunchPlanTriggersEnabled {
+		return true
+	}
+	for _, trigger := range config.Triggers {
+		if trigger.Type == triggerType {
+			return true
+		}
+	}
+	return false
+}
+
+// publishTriggerNotifications publishes an email for every notification trigger of the given type, configured or
+// declared by the launch plan of the execution, which matches the execution. Like workflow execution notifications,
+// failures to publish are logged and counted but never fail the event request which fired the trigger.
+func publishTriggerNotifications(ctx context.Context, db repositories.RepositoryInterface,
+	config runtimeInterfaces.NotificationsConfig, publisher notificationInterfaces.Publisher,
+	publishErrors prometheus.Counter, triggerType runtimeInterfaces.NotificationTriggerType,
+	executionID *core.WorkflowExecutionIdentifier, triggerEvent notifications.TriggerEvent) {
+	if !hasNotificationTriggers(config, triggerType) {
+		return
+	}
+	ctx = getExecutionContext(ctx, executionID)
+	executionModel, err := util.GetExecutionModel(ctx, db, *executionID)
+	if err != nil {
+		logger.Warnf(ctx, "failed to fetch execution [%+v] to evaluate [%s] notification triggers with err: %v",
+			executionID, triggerType, err)
+		return
+	}
+	execution, err := transformers.FromExecutionModel(*executionModel)
+	if err != nil {
+		logger.Warnf(ctx, "failed to transform execution [%+v] to evaluate [%s] notification triggers with err: %v",
+			executionID, triggerType, err)
+		return
+	}
+	triggerEvent.Execution = execution
+	triggers := config.Triggers
+	if config.LaunchPlanTriggersEnabled {
+		launchPlanTriggers, err := notifications.GetLaunchPlanNotificationTriggers(ctx, db, execution)
+		if err != nil {
+			logger.Warnf(ctx, "failed to get the notification triggers of the launch plan of execution [%+v] with err: %v",
+				executionID, err)
+		} else if len(launchPlanTriggers) > 0 {
+			triggers = append(append([]runtimeInterfaces.NotificationTrigger{}, config.Triggers...), launchPlanTriggers...)
+		}
+	}
+	for _, trigger := range notifications.GetMatchingTriggers(triggers, triggerType, execution, triggerEvent.NodeID) {
+		email := notifications.ToEmailMessageFromTriggerEvent(config, trigger, triggerEvent)
+		if err := publisher.Publish(ctx, proto.MessageName(&admin.EmailNotification{}), email); err != nil {
+			publishErrors.Inc()
+			logger.Infof(ctx, "error publishing [%s] notification for execution [%+v] with err: [%v]",
+				triggerType, executionID, err)
+		}
+	}
+}
@@ -0,0 +1,301 @@
+package impl
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteadmin/scheduler/calendar"
+	schedulerCore "github.com/flyteorg/flyteadmin/scheduler/core"
+	schedulerInterfaces "github.com/flyteorg/flyteadmin/scheduler/repositories/interfaces"
+	schedulerModels "github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/contextutils"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+)
+
+type ScheduleBackfillManager struct {
+	db     repositories.RepositoryInterface
+	config runtimeInterfaces.Configuration
+}
+
+func fromScheduleBackfillModel(backfill schedulerModels.ScheduleBackfill) interfaces.ScheduleBackfill {
+	return interfaces.ScheduleBackfill{
+		ID: backfill.ID,
+		LaunchPlan: &core.Identifier{
+			ResourceType: core.ResourceType_LAUNCH_PLAN,
+			Project:      backfill.Project,
+			Domain:       backfill.Domain,
+			Name:         backfill.Name,
+			Version:      backfill.Version,
+		},
+		StartTime:           backfill.StartTime,
+		EndTime:             backfill.EndTime,
+		Parallelism:         backfill.Parallelism,
+		Order:               backfill.Order,
+		CatchupPolicy:       backfill.CatchupPolicy,
+		Phase:               backfill.Phase,
+		TotalExecutions:     backfill.TotalExecutions,
+		CompletedExecutions: backfill.CompletedExecutions,
+		LastFiredTime:       backfill.LastFiredTime,
+		Error:               backfill.Error,
+		CreatedAt:           backfill.CreatedAt,
+		UpdatedAt:           backfill.UpdatedAt,
+	}
+}
+
+func (m *ScheduleBackfillManager) getBackfillConfig() runtimeInterfaces.ScheduleBackfillConfig {
+	eventSchedulerConfig := m.config.ApplicationConfiguration().GetSchedulerConfig().GetEventSchedulerConfig()
+	if eventSchedulerConfig.GetFlyteSchedulerConfig() == nil {
+		return runtimeInterfaces.ScheduleBackfillConfig{}
+	}
+	return eventSchedulerConfig.GetFlyteSchedulerConfig().GetBackfill()
+}
+
+func (m *ScheduleBackfillManager) validateCreateRequest(request *interfaces.ScheduleBackfillCreateRequest) error {
+	fieldValues := []struct {
+		field string
+		value string
+	}{
+		{field: shared.Project, value: request.Project},
+		{field: shared.Domain, value: request.Domain},
+		{field: shared.Name, value: request.Name},
+		{field: shared.Version, value: request.Version},
+	}
+	for _, fieldValue := range fieldValues {
+		if err := validation.ValidateEmptyStringField(fieldValue.value, fieldValue.field); err != nil {
+			return err
+		}
+	}
+	if request.StartTime.IsZero() || request.EndTime.IsZero() || !request.StartTime.Before(request.EndTime) {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"backfill start time [%v] must be before its end time [%v]", request.StartTime, request.EndTime)
+	}
+	switch request.Order {
+	case "":
+		request.Order = schedulerModels.ScheduleBackfillOrderAscending
+	case schedulerModels.ScheduleBackfillOrderAscending, schedulerModels.ScheduleBackfillOrderDescending:
+	default:
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid backfill order [%s]", request.Order)
+	}
+	if request.Parallelism == 0 {
+		request.Parallelism = 1
+	}
+	if maxParallelism := m.getBackfillConfig().MaxParallelism; maxParallelism > 0 &&
+		request.Parallelism > maxParallelism {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"backfill parallelism [%d] exceeds the maximum of %d", request.Parallelism, maxParallelism)
+	}
+	return nil
+}
+
+// Only schedules supported by the native scheduler can be backfilled.
+func getScheduleBackfillModel(request interfaces.ScheduleBackfillCreateRequest, schedule *admin.Schedule) (
+	schedulerModels.ScheduleBackfill, error) {
+	backfill := schedulerModels.ScheduleBackfill{
+		Project:             request.Project,
+		Domain:              request.Domain,
+		Name:                request.Name,
+		Version:             request.Version,
+		KickoffTimeInputArg: schedule.GetKickoffTimeInputArg(),
+		StartTime:           request.StartTime,
+		EndTime:             request.EndTime,
+		Parallelism:         request.Parallelism,
+		Order:               request.Order,
+		Phase:               schedulerModels.ScheduleBackfillPhasePending,
+	}
+	switch expression := schedule.GetScheduleExpression().(type) {
+	case *admin.Schedule_CronSchedule:
+		backfill.CronExpression, backfill.Timezone = schedulerCore.SplitCronTimezone(expression.CronSchedule.GetSchedule())
+	case *admin.Schedule_Rate:
+		backfill.FixedRateValue = expression.Rate.GetValue()
+		backfill.Unit = expression.Rate.GetUnit()
+	default:
+		return schedulerModels.ScheduleBackfill{}, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"launch plan [%s] has a schedule which can't be backfilled", request.Name)
+	}
+	return backfill, nil
+}
+
+func (m *ScheduleBackfillManager) CreateScheduleBackfill(
+	ctx context.Context, request interfaces.ScheduleBackfillCreateRequest) (*interfaces.ScheduleBackfill, error) {
+	if err := m.validateCreateRequest(&request); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	launchPlan, err := util.GetLaunchPlan(ctx, m.db, core.Identifier{
+		ResourceType: core.ResourceType_LAUNCH_PLAN,
+		Project:      request.Project,
+		Domain:       request.Domain,
+		Name:         request.Name,
+		Version:      request.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	schedule := launchPlan.GetSpec().GetEntityMetadata().GetSchedule()
+	if schedule == nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"launch plan [%s] has no schedule to backfill", request.Name)
+	}
+	backfill, err := getScheduleBackfillModel(request, schedule)
+	if err != nil {
+		return nil, err
+	}
+	backfill.CatchupPolicy = request.CatchupPolicy
+	if len(backfill.CatchupPolicy) == 0 {
+		backfill.CatchupPolicy = launchPlan.GetSpec().GetAnnotations().GetValues()[schedulerCore.CatchupPolicyAnnotation]
+	}
+	backfill.ExclusionCalendars = launchPlan.GetSpec().GetAnnotations().GetValues()[calendar.ExclusionCalendarsAnnotation]
+	calendars, err := calendar.Load(m.config.ApplicationConfiguration())
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "invalid calendar configuration: %v", err)
+	}
+	policyLimit, err := schedulerCore.ParseCatchupPolicy(backfill.CatchupPolicy)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "%v", err)
+	}
+	if policyLimit == 0 {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"catch-up policy [%s] doesn't fire any of the schedule times", backfill.CatchupPolicy)
+	}
+
+	// Enumerate one more time than allowed to tell whether the backfill is too large. Policies which only fire the
+	// most recent times need all of them.
+	maxExecutions := int(m.getBackfillConfig().MaxExecutions)
+	limit := 0
+	if maxExecutions > 0 && policyLimit < 0 {
+		limit = maxExecutions + 1
+	}
+	backfillTimes, err := schedulerCore.GetBackfillTimes(
+		schedulerCore.GetBackfillSchedule(backfill), backfill.StartTime, backfill.EndTime, limit, calendars)
+	if err == nil {
+		backfillTimes, err = schedulerCore.ApplyCatchupPolicy(backfill.CatchupPolicy, backfillTimes)
+	}
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"failed to compute the schedule times of launch plan [%s] with err: %v", request.Name, err)
+	}
+	if len(backfillTimes) == 0 {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"launch plan [%s] isn't scheduled between %v and %v", request.Name, request.StartTime, request.EndTime)
+	}
+	if maxExecutions > 0 && len(backfillTimes) > maxExecutions {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"backfill would create more than the maximum of %d executions", maxExecutions)
+	}
+	backfill.TotalExecutions = uint32(len(backfillTimes))
+
+	created, err := m.db.ScheduleBackfillRepo().Create(ctx, backfill)
+	if err != nil {
+		logger.Debugf(ctx, "Failed to create schedule backfill with request [%+v] with err %v", request, err)
+		return nil, err
+	}
+	response := fromScheduleBackfillModel(created)
+	return &response, nil
+}
+
+func (m *ScheduleBackfillManager) ListScheduleBackfills(
+	ctx context.Context, request interfaces.ScheduleBackfillListRequest) (*interfaces.ScheduleBackfillList, error) {
+	if err := validation.ValidateEmptyStringField(request.Project, shared.Project); err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateLimit(request.Limit); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	offset, err := validation.ValidateToken(request.Token)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for ListScheduleBackfills", request.Token)
+	}
+	input := schedulerInterfaces.ListScheduleBackfillsInput{
+		Project: request.Project,
+		Domain:  request.Domain,
+		Name:    request.Name,
+		Limit:   int(request.Limit),
+		Offset:  offset,
+	}
+	if len(request.Phase) > 0 {
+		input.Phases = []schedulerModels.ScheduleBackfillPhase{request.Phase}
+	}
+	output, err := m.db.ScheduleBackfillRepo().List(ctx, input)
+	if err != nil {
+		logger.Debugf(ctx, "Failed to list schedule backfills with request [%+v] with err %v", request, err)
+		return nil, err
+	}
+	backfills := make([]interfaces.ScheduleBackfill, len(output))
+	for idx, backfill := range output {
+		backfills[idx] = fromScheduleBackfillModel(backfill)
+	}
+	var token string
+	if len(backfills) == int(request.Limit) {
+		token = strconv.Itoa(offset + len(backfills))
+	}
+	return &interfaces.ScheduleBackfillList{
+		Backfills: backfills,
+		Token:     token,
+	}, nil
+}
+
+// Backfills are only visible through the project of the launch plan they backfill.
+func (m *ScheduleBackfillManager) getBackfillModel(ctx context.Context, project string, id uint) (
+	schedulerModels.ScheduleBackfill, error) {
+	if err := validation.ValidateEmptyStringField(project, shared.Project); err != nil {
+		return schedulerModels.ScheduleBackfill{}, err
+	}
+	backfill, err := m.db.ScheduleBackfillRepo().Get(ctx, id)
+	if err != nil {
+		return schedulerModels.ScheduleBackfill{}, err
+	}
+	if backfill.Project != project {
+		return schedulerModels.ScheduleBackfill{}, errors.NewFlyteAdminErrorf(codes.NotFound,
+			"schedule backfill [%d] not found in project [%s]", id, project)
+	}
+	return backfill, nil
+}
+
+func (m *ScheduleBackfillManager) GetScheduleBackfill(ctx context.Context, project string, id uint) (
+	*interfaces.ScheduleBackfill, error) {
+	backfill, err := m.getBackfillModel(ctx, project, id)
+	if err != nil {
+		return nil, err
+	}
+	response := fromScheduleBackfillModel(backfill)
+	return &response, nil
+}
+
+func (m *ScheduleBackfillManager) CancelScheduleBackfill(ctx context.Context, project string, id uint) (
+	*interfaces.ScheduleBackfill, error) {
+	backfill, err := m.getBackfillModel(ctx, project, id)
+	if err != nil {
+		return nil, err
+	}
+	backfill.Phase = schedulerModels.ScheduleBackfillPhaseCancelled
+	cancelled, err := m.db.ScheduleBackfillRepo().Update(ctx, backfill)
+	if err != nil {
+		return nil, err
+	}
+	if !cancelled {
+		return nil, errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+			"only pending or running backfills can be cancelled, backfill [%d] has already finished", id)
+	}
+	response := fromScheduleBackfillModel(backfill)
+	return &response, nil
+}
+
+func NewScheduleBackfillManager(
+	db repositories.RepositoryInterface, config runtimeInterfaces.Configuration) interfaces.ScheduleBackfillInterface {
+	return &ScheduleBackfillManager{
+		db:     db,
+		config: config,
+	}
+}
@@ -0,0 +1,317 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	"github.com/flyteorg/flyteadmin/scheduler/calendar"
+	schedulerInterfaces "github.com/flyteorg/flyteadmin/scheduler/repositories/interfaces"
+	schedulerMocks "github.com/flyteorg/flyteadmin/scheduler/repositories/mocks"
+	schedulerModels "github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+var backfillStartTime = time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+
+func getMockBackfillConfigProvider() runtimeInterfaces.Configuration {
+	applicationConfig := &runtimeMocks.MockApplicationProvider{}
+	applicationConfig.SetSchedulerConfig(runtimeInterfaces.SchedulerConfig{
+		EventSchedulerConfig: runtimeInterfaces.EventSchedulerConfig{
+			FlyteSchedulerConfig: &runtimeInterfaces.FlyteSchedulerConfig{
+				Backfill: runtimeInterfaces.ScheduleBackfillConfig{
+					MaxExecutions:  24,
+					MaxParallelism: 5,
+				},
+				Calendars: map[string]runtimeInterfaces.ScheduleCalendarConfig{
+					"weekends": {Weekdays: []string{"Saturday", "Sunday"}},
+				},
+			},
+		},
+	})
+	return runtimeMocks.NewMockConfigurationProvider(applicationConfig, nil, nil, nil, nil, nil)
+}
+
+func setScheduledLaunchPlan(t *testing.T, repository *repositoryMocks.MockRepository, schedule *admin.Schedule) {
+	setAnnotatedScheduledLaunchPlan(t, repository, schedule, nil)
+}
+
+func setAnnotatedScheduledLaunchPlan(t *testing.T, repository *repositoryMocks.MockRepository,
+	schedule *admin.Schedule, annotations map[string]string) {
+	spec, err := proto.Marshal(&admin.LaunchPlanSpec{
+		EntityMetadata: &admin.LaunchPlanMetadata{
+			Schedule: schedule,
+		},
+		Annotations: &admin.Annotations{Values: annotations},
+	})
+	assert.NoError(t, err)
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetGetCallback(
+		func(input repoInterfaces.Identifier) (models.LaunchPlan, error) {
+			return models.LaunchPlan{
+				LaunchPlanKey: models.LaunchPlanKey{
+					Project: input.Project,
+					Domain:  input.Domain,
+					Name:    input.Name,
+					Version: input.Version,
+				},
+				Spec: spec,
+			}, nil
+		})
+}
+
+func getMockBackfillRepo(repository *repositoryMocks.MockRepository) *schedulerMocks.ScheduleBackfillRepoInterface {
+	return repository.ScheduleBackfillRepo().(*schedulerMocks.ScheduleBackfillRepoInterface)
+}
+
+func getBackfillCreateRequest() interfaces.ScheduleBackfillCreateRequest {
+	return interfaces.ScheduleBackfillCreateRequest{
+		Project:     projectValue,
+		Domain:      domainValue,
+		Name:        nameValue,
+		Version:     "version",
+		StartTime:   backfillStartTime,
+		EndTime:     backfillStartTime.Add(5 * time.Hour),
+		Parallelism: 2,
+	}
+}
+
+var hourlySchedule = &admin.Schedule{
+	ScheduleExpression: &admin.Schedule_CronSchedule{
+		CronSchedule: &admin.CronSchedule{Schedule: "0 * * * *"},
+	},
+	KickoffTimeInputArg: "kickoff_time",
+}
+
+func TestCreateScheduleBackfill(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	setScheduledLaunchPlan(t, repository, hourlySchedule)
+	getMockBackfillRepo(repository).OnCreateMatch(mock.Anything, schedulerModels.ScheduleBackfill{
+		Project:             projectValue,
+		Domain:              domainValue,
+		Name:                nameValue,
+		Version:             "version",
+		CronExpression:      "0 * * * *",
+		KickoffTimeInputArg: "kickoff_time",
+		StartTime:           backfillStartTime,
+		EndTime:             backfillStartTime.Add(5 * time.Hour),
+		Parallelism:         2,
+		Order:               schedulerModels.ScheduleBackfillOrderAscending,
+		Phase:               schedulerModels.ScheduleBackfillPhasePending,
+		TotalExecutions:     5,
+	}).Return(schedulerModels.ScheduleBackfill{
+		ID:              7,
+		Project:         projectValue,
+		Phase:           schedulerModels.ScheduleBackfillPhasePending,
+		TotalExecutions: 5,
+	}, nil)
+
+	manager := NewScheduleBackfillManager(repository, getMockBackfillConfigProvider())
+	backfill, err := manager.CreateScheduleBackfill(context.Background(), getBackfillCreateRequest())
+	assert.NoError(t, err)
+	assert.Equal(t, uint(7), backfill.ID)
+	assert.Equal(t, uint32(5), backfill.TotalExecutions)
+	assert.Equal(t, schedulerModels.ScheduleBackfillPhasePending, backfill.Phase)
+}
+
+func TestCreateScheduleBackfill_Timezone(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	setScheduledLaunchPlan(t, repository, &admin.Schedule{
+		ScheduleExpression: &admin.Schedule_CronSchedule{
+			CronSchedule: &admin.CronSchedule{Schedule: "CRON_TZ=Asia/Kolkata 30 5 * * *"},
+		},
+	})
+	getMockBackfillRepo(repository).OnCreateMatch(mock.Anything, mock.MatchedBy(
+		func(backfill schedulerModels.ScheduleBackfill) bool {
+			// 05:30 in Kolkata is midnight UTC, which falls in the backfilled range once.
+			return backfill.CronExpression == "30 5 * * *" && backfill.Timezone == "Asia/Kolkata" &&
+				backfill.TotalExecutions == 1
+		})).Return(schedulerModels.ScheduleBackfill{ID: 8}, nil)
+
+	manager := NewScheduleBackfillManager(repository, getMockBackfillConfigProvider())
+	backfill, err := manager.CreateScheduleBackfill(context.Background(), getBackfillCreateRequest())
+	assert.NoError(t, err)
+	assert.Equal(t, uint(8), backfill.ID)
+}
+
+func TestCreateScheduleBackfill_ExclusionCalendars(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	setAnnotatedScheduledLaunchPlan(t, repository, hourlySchedule, map[string]string{
+		calendar.ExclusionCalendarsAnnotation: "weekends",
+	})
+	getMockBackfillRepo(repository).OnCreateMatch(mock.Anything, mock.MatchedBy(
+		func(backfill schedulerModels.ScheduleBackfill) bool {
+			// Only the hours of the Friday evening and the Monday midnight aren't on the weekend.
+			return backfill.ExclusionCalendars == "weekends" && backfill.TotalExecutions == 5
+		})).Return(schedulerModels.ScheduleBackfill{ID: 9}, nil)
+
+	manager := NewScheduleBackfillManager(repository, getMockBackfillConfigProvider())
+	request := getBackfillCreateRequest()
+	request.StartTime = backfillStartTime.Add(19 * time.Hour)
+	request.EndTime = backfillStartTime.Add(72 * time.Hour)
+	backfill, err := manager.CreateScheduleBackfill(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(9), backfill.ID)
+}
+
+func TestCreateScheduleBackfill_CatchupPolicy(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	setScheduledLaunchPlan(t, repository, hourlySchedule)
+	getMockBackfillRepo(repository).OnCreateMatch(mock.Anything, mock.MatchedBy(
+		func(backfill schedulerModels.ScheduleBackfill) bool {
+			return backfill.CatchupPolicy == "max-2" && backfill.TotalExecutions == 2
+		})).Return(schedulerModels.ScheduleBackfill{ID: 9, CatchupPolicy: "max-2", TotalExecutions: 2}, nil)
+
+	manager := NewScheduleBackfillManager(repository, getMockBackfillConfigProvider())
+	request := getBackfillCreateRequest()
+	// Only the two most recent of the 48 schedule times are fired, which is within the maximum of 24 executions.
+	request.EndTime = request.StartTime.Add(48 * time.Hour)
+	request.CatchupPolicy = "max-2"
+	backfill, err := manager.CreateScheduleBackfill(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, "max-2", backfill.CatchupPolicy)
+	assert.Equal(t, uint32(2), backfill.TotalExecutions)
+}
+
+func TestCreateScheduleBackfill_InvalidRequest(t *testing.T) {
+	testCases := []struct {
+		name     string
+		schedule *admin.Schedule
+		update   func(request *interfaces.ScheduleBackfillCreateRequest)
+	}{
+		{
+			name:     "end before start",
+			schedule: hourlySchedule,
+			update: func(request *interfaces.ScheduleBackfillCreateRequest) {
+				request.EndTime = request.StartTime.Add(-time.Hour)
+			},
+		},
+		{
+			name:     "parallelism above the maximum",
+			schedule: hourlySchedule,
+			update: func(request *interfaces.ScheduleBackfillCreateRequest) {
+				request.Parallelism = 6
+			},
+		},
+		{
+			name:     "unknown order",
+			schedule: hourlySchedule,
+			update: func(request *interfaces.ScheduleBackfillCreateRequest) {
+				request.Order = "RANDOM"
+			},
+		},
+		{
+			name:     "too many executions",
+			schedule: hourlySchedule,
+			update: func(request *interfaces.ScheduleBackfillCreateRequest) {
+				request.EndTime = request.StartTime.Add(25 * time.Hour)
+			},
+		},
+		{
+			name: "no schedule times in range",
+			schedule: &admin.Schedule{
+				ScheduleExpression: &admin.Schedule_CronSchedule{
+					CronSchedule: &admin.CronSchedule{Schedule: "0 0 1 1 *"},
+				},
+			},
+			update: func(request *interfaces.ScheduleBackfillCreateRequest) {},
+		},
+		{
+			name:     "invalid catch-up policy",
+			schedule: hourlySchedule,
+			update: func(request *interfaces.ScheduleBackfillCreateRequest) {
+				request.CatchupPolicy = "oldest-only"
+			},
+		},
+		{
+			name:     "catch-up policy which fires nothing",
+			schedule: hourlySchedule,
+			update: func(request *interfaces.ScheduleBackfillCreateRequest) {
+				request.CatchupPolicy = "none"
+			},
+		},
+		{
+			name:   "launch plan without a schedule",
+			update: func(request *interfaces.ScheduleBackfillCreateRequest) {},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+			setScheduledLaunchPlan(t, repository, tc.schedule)
+			manager := NewScheduleBackfillManager(repository, getMockBackfillConfigProvider())
+			request := getBackfillCreateRequest()
+			tc.update(&request)
+			_, err := manager.CreateScheduleBackfill(context.Background(), request)
+			assert.Equal(t, codes.InvalidArgument, err.(adminErrors.FlyteAdminError).Code())
+			getMockBackfillRepo(repository).AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestListScheduleBackfills(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	getMockBackfillRepo(repository).OnListMatch(mock.Anything, schedulerInterfaces.ListScheduleBackfillsInput{
+		Project: projectValue,
+		Domain:  domainValue,
+		Phases:  []schedulerModels.ScheduleBackfillPhase{schedulerModels.ScheduleBackfillPhaseRunning},
+		Limit:   1,
+		Offset:  2,
+	}).Return([]schedulerModels.ScheduleBackfill{{ID: 3, Project: projectValue}}, nil)
+
+	manager := NewScheduleBackfillManager(repository, getMockBackfillConfigProvider())
+	backfills, err := manager.ListScheduleBackfills(context.Background(), interfaces.ScheduleBackfillListRequest{
+		Project: projectValue,
+		Domain:  domainValue,
+		Phase:   schedulerModels.ScheduleBackfillPhaseRunning,
+		Limit:   1,
+		Token:   "2",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, backfills.Backfills, 1)
+	assert.Equal(t, uint(3), backfills.Backfills[0].ID)
+	assert.Equal(t, "3", backfills.Token)
+}
+
+func TestGetScheduleBackfill_OtherProject(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	getMockBackfillRepo(repository).OnGetMatch(mock.Anything, uint(1)).Return(
+		schedulerModels.ScheduleBackfill{ID: 1, Project: "other"}, nil)
+
+	manager := NewScheduleBackfillManager(repository, getMockBackfillConfigProvider())
+	_, err := manager.GetScheduleBackfill(context.Background(), projectValue, 1)
+	assert.Equal(t, codes.NotFound, err.(adminErrors.FlyteAdminError).Code())
+}
+
+func TestCancelScheduleBackfill(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	backfillRepo := getMockBackfillRepo(repository)
+	backfillRepo.OnGetMatch(mock.Anything, uint(1)).Return(schedulerModels.ScheduleBackfill{
+		ID:      1,
+		Project: projectValue,
+		Phase:   schedulerModels.ScheduleBackfillPhaseRunning,
+	}, nil)
+	backfillRepo.OnUpdateMatch(mock.Anything, mock.MatchedBy(func(backfill schedulerModels.ScheduleBackfill) bool {
+		return backfill.Phase == schedulerModels.ScheduleBackfillPhaseCancelled
+	})).Return(true, nil).Once()
+	backfillRepo.OnUpdateMatch(mock.Anything, mock.Anything).Return(false, nil)
+
+	manager := NewScheduleBackfillManager(repository, getMockBackfillConfigProvider())
+	backfill, err := manager.CancelScheduleBackfill(context.Background(), projectValue, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, schedulerModels.ScheduleBackfillPhaseCancelled, backfill.Phase)
+
+	// The backfill finished before it could be cancelled.
+	_, err = manager.CancelScheduleBackfill(context.Background(), projectValue, 1)
+	assert.Equal(t, codes.FailedPrecondition, err.(adminErrors.FlyteAdminError).Code())
+}
@@ -0,0 +1,129 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteadmin/scheduler/calendar"
+	schedulerCore "github.com/flyteorg/flyteadmin/scheduler/core"
+	schedulerModels "github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/contextutils"
+	"google.golang.org/grpc/codes"
+)
+
+const defaultSchedulePreviewLimit = 10
+const maxSchedulePreviewLimit = 1000
+
+type SchedulePreviewManager struct {
+	db     repositories.RepositoryInterface
+	config runtimeInterfaces.Configuration
+}
+
+func validateSchedulePreviewRequest(request *interfaces.SchedulePreviewRequest) error {
+	fieldValues := []struct {
+		field string
+		value string
+	}{
+		{field: shared.Project, value: request.Project},
+		{field: shared.Domain, value: request.Domain},
+		{field: shared.Name, value: request.Name},
+		{field: shared.Version, value: request.Version},
+	}
+	for _, fieldValue := range fieldValues {
+		if err := validation.ValidateEmptyStringField(fieldValue.value, fieldValue.field); err != nil {
+			return err
+		}
+	}
+	if request.StartTime.IsZero() {
+		request.StartTime = time.Now()
+	}
+	if !request.EndTime.IsZero() && !request.StartTime.Before(request.EndTime) {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"preview start time [%v] must be before its end time [%v]", request.StartTime, request.EndTime)
+	}
+	if request.Limit == 0 {
+		request.Limit = defaultSchedulePreviewLimit
+		if !request.EndTime.IsZero() {
+			request.Limit = maxSchedulePreviewLimit
+		}
+	}
+	if request.Limit > maxSchedulePreviewLimit {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"preview limit [%d] exceeds the maximum of %d", request.Limit, maxSchedulePreviewLimit)
+	}
+	return nil
+}
+
+// The preview converts the schedule and computes its times exactly like the native scheduler does once the launch
+// plan is activated.
+func (m *SchedulePreviewManager) PreviewSchedule(
+	ctx context.Context, request interfaces.SchedulePreviewRequest) (*interfaces.SchedulePreview, error) {
+	if err := validateSchedulePreviewRequest(&request); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	identifier := core.Identifier{
+		ResourceType: core.ResourceType_LAUNCH_PLAN,
+		Project:      request.Project,
+		Domain:       request.Domain,
+		Name:         request.Name,
+		Version:      request.Version,
+	}
+	launchPlan, err := util.GetLaunchPlan(ctx, m.db, identifier)
+	if err != nil {
+		return nil, err
+	}
+	schedule := launchPlan.GetSpec().GetEntityMetadata().GetSchedule()
+	if schedule == nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"launch plan [%s] has no schedule to preview", request.Name)
+	}
+	entity, err := schedulerCore.NewSchedulableEntity(schedulerModels.SchedulableEntityKey{
+		Project: request.Project,
+		Domain:  request.Domain,
+		Name:    request.Name,
+		Version: request.Version,
+	}, *schedule, launchPlan.GetSpec().GetAnnotations().GetValues()[schedulerCore.CatchupPolicyAnnotation])
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"launch plan [%s] has a schedule which the native scheduler doesn't support: %v", request.Name, err)
+	}
+	entity.ExclusionCalendars = launchPlan.GetSpec().GetAnnotations().GetValues()[calendar.ExclusionCalendarsAnnotation]
+	calendars, err := calendar.Load(m.config.ApplicationConfiguration())
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "invalid calendar configuration: %v", err)
+	}
+	scheduledTimes, err := schedulerCore.GetNextScheduledTimes(
+		entity, request.StartTime, request.EndTime, int(request.Limit), calendars)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"failed to compute the schedule times of launch plan [%s] with err: %v", request.Name, err)
+	}
+	fireTimes := make([]interfaces.ScheduleFireTime, len(scheduledTimes))
+	for idx, scheduledTime := range scheduledTimes {
+		fireTimes[idx] = interfaces.ScheduleFireTime{
+			ScheduledTime: scheduledTime,
+			Inputs:        schedulerCore.GetKickoffTimeInputs(entity, scheduledTime),
+		}
+	}
+	return &interfaces.SchedulePreview{
+		LaunchPlan: &identifier,
+		FireTimes:  fireTimes,
+	}, nil
+}
+
+func NewSchedulePreviewManager(
+	db repositories.RepositoryInterface, config runtimeInterfaces.Configuration) interfaces.SchedulePreviewInterface {
+	return &SchedulePreviewManager{
+		db:     db,
+		config: config,
+	}
+}
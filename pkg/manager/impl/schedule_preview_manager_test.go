@@ -0,0 +1,147 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/scheduler/calendar"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func getSchedulePreviewRequest() interfaces.SchedulePreviewRequest {
+	return interfaces.SchedulePreviewRequest{
+		Project:   projectValue,
+		Domain:    domainValue,
+		Name:      nameValue,
+		Version:   "version",
+		StartTime: backfillStartTime,
+	}
+}
+
+func TestPreviewSchedule(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	setScheduledLaunchPlan(t, repository, hourlySchedule)
+	manager := NewSchedulePreviewManager(repository, getMockBackfillConfigProvider())
+
+	request := getSchedulePreviewRequest()
+	request.Limit = 2
+	preview, err := manager.PreviewSchedule(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, nameValue, preview.LaunchPlan.Name)
+	assert.Equal(t, []interfaces.ScheduleFireTime{
+		{
+			ScheduledTime: backfillStartTime.Add(time.Hour),
+			Inputs:        map[string]time.Time{"kickoff_time": backfillStartTime.Add(time.Hour)},
+		},
+		{
+			ScheduledTime: backfillStartTime.Add(2 * time.Hour),
+			Inputs:        map[string]time.Time{"kickoff_time": backfillStartTime.Add(2 * time.Hour)},
+		},
+	}, preview.FireTimes)
+
+	// Previews all fire times in a window.
+	request = getSchedulePreviewRequest()
+	request.EndTime = backfillStartTime.Add(24 * time.Hour)
+	preview, err = manager.PreviewSchedule(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Len(t, preview.FireTimes, 23)
+
+	// Previews the default number of fire times.
+	preview, err = manager.PreviewSchedule(context.Background(), getSchedulePreviewRequest())
+	assert.NoError(t, err)
+	assert.Len(t, preview.FireTimes, defaultSchedulePreviewLimit)
+}
+
+func TestPreviewSchedule_FixedRate(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	setScheduledLaunchPlan(t, repository, &admin.Schedule{
+		ScheduleExpression: &admin.Schedule_Rate{
+			Rate: &admin.FixedRate{Value: 30, Unit: admin.FixedRateUnit_MINUTE},
+		},
+	})
+	manager := NewSchedulePreviewManager(repository, getMockBackfillConfigProvider())
+
+	request := getSchedulePreviewRequest()
+	request.Limit = 1
+	preview, err := manager.PreviewSchedule(context.Background(), request)
+	assert.NoError(t, err)
+	// Fixed rate schedules don't bind the kickoff time.
+	assert.Equal(t, []interfaces.ScheduleFireTime{
+		{ScheduledTime: backfillStartTime.Add(30 * time.Minute)},
+	}, preview.FireTimes)
+}
+
+func TestPreviewSchedule_ExclusionCalendars(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	setAnnotatedScheduledLaunchPlan(t, repository, hourlySchedule, map[string]string{
+		calendar.ExclusionCalendarsAnnotation: "weekends",
+	})
+	manager := NewSchedulePreviewManager(repository, getMockBackfillConfigProvider())
+
+	// The preview starts on a Friday and skips the weekend after it.
+	request := getSchedulePreviewRequest()
+	request.Limit = 24
+	preview, err := manager.PreviewSchedule(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Len(t, preview.FireTimes, 24)
+	assert.Equal(t, backfillStartTime.Add(23*time.Hour), preview.FireTimes[22].ScheduledTime)
+	assert.Equal(t, backfillStartTime.Add(72*time.Hour), preview.FireTimes[23].ScheduledTime)
+}
+
+func TestPreviewSchedule_InvalidRequest(t *testing.T) {
+	testCases := []struct {
+		name     string
+		schedule *admin.Schedule
+		update   func(request *interfaces.SchedulePreviewRequest)
+	}{
+		{
+			name:     "missing version",
+			schedule: hourlySchedule,
+			update: func(request *interfaces.SchedulePreviewRequest) {
+				request.Version = ""
+			},
+		},
+		{
+			name:     "end before start",
+			schedule: hourlySchedule,
+			update: func(request *interfaces.SchedulePreviewRequest) {
+				request.EndTime = request.StartTime.Add(-time.Hour)
+			},
+		},
+		{
+			name:     "limit above the maximum",
+			schedule: hourlySchedule,
+			update: func(request *interfaces.SchedulePreviewRequest) {
+				request.Limit = maxSchedulePreviewLimit + 1
+			},
+		},
+		{
+			name: "schedule unsupported by the native scheduler",
+			schedule: &admin.Schedule{
+				ScheduleExpression: &admin.Schedule_CronExpression{CronExpression: "0 9 * * ? *"},
+			},
+			update: func(request *interfaces.SchedulePreviewRequest) {},
+		},
+		{
+			name:   "launch plan without a schedule",
+			update: func(request *interfaces.SchedulePreviewRequest) {},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+			setScheduledLaunchPlan(t, repository, tc.schedule)
+			manager := NewSchedulePreviewManager(repository, getMockBackfillConfigProvider())
+			request := getSchedulePreviewRequest()
+			tc.update(&request)
+			_, err := manager.PreviewSchedule(context.Background(), request)
+			assert.Equal(t, codes.InvalidArgument, err.(adminErrors.FlyteAdminError).Code())
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package impl
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	schedulerInterfaces "github.com/flyteorg/flyteadmin/scheduler/repositories/interfaces"
+	schedulerModels "github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/contextutils"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+)
+
+var scheduleRunOutcomes = map[string]bool{
+	schedulerModels.ScheduleRunOutcomeFired:           true,
+	schedulerModels.ScheduleRunOutcomeSkippedInactive: true,
+	schedulerModels.ScheduleRunOutcomeSkippedExcluded: true,
+	schedulerModels.ScheduleRunOutcomeAlreadyExists:   true,
+	schedulerModels.ScheduleRunOutcomeFailed:          true,
+}
+
+type ScheduleRunManager struct {
+	db repositories.RepositoryInterface
+}
+
+func fromScheduleRunModel(run schedulerModels.ScheduleRun) interfaces.ScheduleRun {
+	scheduleRun := interfaces.ScheduleRun{
+		LaunchPlan: &core.Identifier{
+			ResourceType: core.ResourceType_LAUNCH_PLAN,
+			Project:      run.Project,
+			Domain:       run.Domain,
+			Name:         run.Name,
+			Version:      run.Version,
+		},
+		ScheduledTime: run.ScheduledTime,
+		FiredAt:       run.FiredAt,
+		Outcome:       run.Outcome,
+		Attempts:      run.Attempts,
+		Error:         run.Error,
+	}
+	if len(run.ExecutionName) > 0 {
+		scheduleRun.Execution = &core.WorkflowExecutionIdentifier{
+			Project: run.Project,
+			Domain:  run.Domain,
+			Name:    run.ExecutionName,
+		}
+	}
+	return scheduleRun
+}
+
+func (m *ScheduleRunManager) ListScheduleRuns(
+	ctx context.Context, request interfaces.ScheduleRunListRequest) (*interfaces.ScheduleRunList, error) {
+	fieldValues := []struct {
+		field string
+		value string
+	}{
+		{field: shared.Project, value: request.Project},
+		{field: shared.Domain, value: request.Domain},
+		{field: shared.Name, value: request.Name},
+	}
+	for _, fieldValue := range fieldValues {
+		if err := validation.ValidateEmptyStringField(fieldValue.value, fieldValue.field); err != nil {
+			return nil, err
+		}
+	}
+	if err := validation.ValidateLimit(request.Limit); err != nil {
+		return nil, err
+	}
+	if len(request.Outcome) > 0 && !scheduleRunOutcomes[request.Outcome] {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid schedule run outcome [%s], must be one of FIRED, SKIPPED_INACTIVE, SKIPPED_EXCLUDED, "+
+				"ALREADY_EXISTS or FAILED", request.Outcome)
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	offset, err := validation.ValidateToken(request.Token)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for ListScheduleRuns", request.Token)
+	}
+	input := schedulerInterfaces.ListScheduleRunsInput{
+		Project: request.Project,
+		Domain:  request.Domain,
+		Name:    request.Name,
+		Version: request.Version,
+		Limit:   int(request.Limit),
+		Offset:  offset,
+	}
+	if len(request.Outcome) > 0 {
+		input.Outcomes = []schedulerModels.ScheduleRunOutcome{request.Outcome}
+	}
+	output, err := m.db.ScheduleRunRepo().List(ctx, input)
+	if err != nil {
+		logger.Debugf(ctx, "Failed to list schedule runs with request [%+v] with err %v", request, err)
+		return nil, err
+	}
+	runs := make([]interfaces.ScheduleRun, len(output))
+	for idx, run := range output {
+		runs[idx] = fromScheduleRunModel(run)
+	}
+	var token string
+	if len(runs) == int(request.Limit) {
+		token = strconv.Itoa(offset + len(runs))
+	}
+	return &interfaces.ScheduleRunList{
+		Runs:  runs,
+		Token: token,
+	}, nil
+}
+
+func NewScheduleRunManager(db repositories.RepositoryInterface) interfaces.ScheduleRunInterface {
+	return &ScheduleRunManager{
+		db: db,
+	}
+}
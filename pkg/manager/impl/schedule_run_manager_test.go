@@ -0,0 +1,148 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	schedulerInterfaces "github.com/flyteorg/flyteadmin/scheduler/repositories/interfaces"
+	schedulerMocks "github.com/flyteorg/flyteadmin/scheduler/repositories/mocks"
+	schedulerModels "github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestListScheduleRuns(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	repository.ScheduleRunRepo().(*schedulerMocks.ScheduleRunRepoInterface).OnListMatch(mock.Anything,
+		schedulerInterfaces.ListScheduleRunsInput{
+			Project:  projectValue,
+			Domain:   domainValue,
+			Name:     nameValue,
+			Version:  "version",
+			Outcomes: []schedulerModels.ScheduleRunOutcome{schedulerModels.ScheduleRunOutcomeFailed},
+			Limit:    2,
+			Offset:   2,
+		}).Return([]schedulerModels.ScheduleRun{
+		{
+			Project:       projectValue,
+			Domain:        domainValue,
+			Name:          nameValue,
+			Version:       "version",
+			ScheduledTime: backfillStartTime,
+			ExecutionName: "fexecution",
+			Outcome:       schedulerModels.ScheduleRunOutcomeFailed,
+			Attempts:      30,
+			Error:         "admin unavailable",
+		},
+		{
+			Project: projectValue,
+			Domain:  domainValue,
+			Name:    nameValue,
+			Version: "version",
+			Outcome: schedulerModels.ScheduleRunOutcomeSkippedInactive,
+		},
+	}, nil)
+
+	manager := NewScheduleRunManager(repository)
+	runs, err := manager.ListScheduleRuns(context.Background(), interfaces.ScheduleRunListRequest{
+		Project: projectValue,
+		Domain:  domainValue,
+		Name:    nameValue,
+		Version: "version",
+		Outcome: schedulerModels.ScheduleRunOutcomeFailed,
+		Limit:   2,
+		Token:   "2",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, runs.Runs, 2)
+	assert.Equal(t, "4", runs.Token)
+	assert.Equal(t, nameValue, runs.Runs[0].LaunchPlan.Name)
+	assert.Equal(t, backfillStartTime, runs.Runs[0].ScheduledTime)
+	assert.Equal(t, &core.WorkflowExecutionIdentifier{
+		Project: projectValue,
+		Domain:  domainValue,
+		Name:    "fexecution",
+	}, runs.Runs[0].Execution)
+	assert.Equal(t, uint32(30), runs.Runs[0].Attempts)
+	assert.Equal(t, "admin unavailable", runs.Runs[0].Error)
+	assert.Nil(t, runs.Runs[1].Execution)
+}
+
+func TestListScheduleRuns_InvalidRequest(t *testing.T) {
+	testCases := []struct {
+		name    string
+		request interfaces.ScheduleRunListRequest
+	}{
+		{
+			name:    "missing name",
+			request: interfaces.ScheduleRunListRequest{Project: projectValue, Domain: domainValue, Limit: 1},
+		},
+		{
+			name:    "missing limit",
+			request: interfaces.ScheduleRunListRequest{Project: projectValue, Domain: domainValue, Name: nameValue},
+		},
+		{
+			name: "unknown outcome",
+			request: interfaces.ScheduleRunListRequest{
+				Project: projectValue, Domain: domainValue, Name: nameValue, Outcome: "SUCCEEDED", Limit: 1},
+		},
+		{
+			name: "invalid token",
+			request: interfaces.ScheduleRunListRequest{
+				Project: projectValue, Domain: domainValue, Name: nameValue, Limit: 1, Token: "next"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			manager := NewScheduleRunManager(repositoryMocks.NewMockRepository())
+			_, err := manager.ListScheduleRuns(context.Background(), tc.request)
+			assert.Equal(t, codes.InvalidArgument, err.(adminErrors.FlyteAdminError).Code())
+		})
+	}
+}
+
+func TestListScheduleRuns_Outcomes(t *testing.T) {
+	for _, outcome := range []schedulerModels.ScheduleRunOutcome{
+		schedulerModels.ScheduleRunOutcomeFired,
+		schedulerModels.ScheduleRunOutcomeSkippedInactive,
+		schedulerModels.ScheduleRunOutcomeSkippedExcluded,
+		schedulerModels.ScheduleRunOutcomeAlreadyExists,
+		schedulerModels.ScheduleRunOutcomeFailed,
+	} {
+		t.Run(outcome, func(t *testing.T) {
+			repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+			repository.ScheduleRunRepo().(*schedulerMocks.ScheduleRunRepoInterface).OnListMatch(mock.Anything,
+				schedulerInterfaces.ListScheduleRunsInput{
+					Project:  projectValue,
+					Domain:   domainValue,
+					Name:     nameValue,
+					Outcomes: []schedulerModels.ScheduleRunOutcome{outcome},
+					Limit:    1,
+				}).Return([]schedulerModels.ScheduleRun{
+				{
+					Project: projectValue,
+					Domain:  domainValue,
+					Name:    nameValue,
+					Outcome: outcome,
+				},
+			}, nil)
+
+			runs, err := NewScheduleRunManager(repository).ListScheduleRuns(context.Background(),
+				interfaces.ScheduleRunListRequest{
+					Project: projectValue,
+					Domain:  domainValue,
+					Name:    nameValue,
+					Outcome: outcome,
+					Limit:   1,
+				})
+			assert.NoError(t, err)
+			assert.Len(t, runs.Runs, 1)
+			assert.Equal(t, outcome, runs.Runs[0].Outcome)
+		})
+	}
+}
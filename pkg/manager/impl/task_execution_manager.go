@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications"
 	notificationInterfaces "github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
 	"github.com/golang/protobuf/proto"
 
@@ -44,6 +45,7 @@ type taskExecutionMetrics struct {
 	TaskExecutionInputBytes    prometheus.Summary
 	TaskExecutionOutputBytes   prometheus.Summary
 	PublishEventError          prometheus.Counter
+	PublishNotificationError   prometheus.Counter
 }
 
 type TaskExecutionManager struct {
@@ -52,6 +54,7 @@ type TaskExecutionManager struct {
 	storageClient      *storage.DataStore
 	metrics            taskExecutionMetrics
 	urlData            dataInterfaces.RemoteURLInterface
+	eventPublisher     notificationInterfaces.Publisher
 	notificationClient notificationInterfaces.Publisher
 }
 
@@ -124,6 +127,37 @@ func (m *TaskExecutionManager) updateTaskExecutionModelState(
 	return *existingTaskExecution, nil
 }
 
+// publishRetriesExhaustedNotifications fires taskRetriesExhausted notification triggers when a task execution fails on
+// its last attempt, that is when the retry attempt reaches the retries declared in the task metadata.
+func (m *TaskExecutionManager) publishRetriesExhaustedNotifications(
+	ctx context.Context, request *admin.TaskExecutionEventRequest) {
+	if request.Event.Phase != core.TaskExecution_FAILED {
+		return
+	}
+	notificationsConfig := m.config.ApplicationConfiguration().GetNotificationsConfig()
+	if !hasNotificationTriggers(*notificationsConfig, runtimeInterfaces.NotificationTriggerTypeTaskRetriesExhausted) {
+		return
+	}
+	task, err := util.GetTask(ctx, m.db, *request.Event.TaskId)
+	if err != nil {
+		logger.Warnf(ctx, "failed to fetch task [%+v] to evaluate notification triggers with err: %v",
+			request.Event.TaskId, err)
+		return
+	}
+	retries := task.GetClosure().GetCompiledTask().GetTemplate().GetMetadata().GetRetries().GetRetries()
+	if request.Event.RetryAttempt < retries {
+		return
+	}
+	publishTriggerNotifications(ctx, m.db, *notificationsConfig, m.notificationClient,
+		m.metrics.PublishNotificationError, runtimeInterfaces.NotificationTriggerTypeTaskRetriesExhausted,
+		request.Event.ParentNodeExecutionId.ExecutionId, notifications.TriggerEvent{
+			NodeID:       request.Event.ParentNodeExecutionId.NodeId,
+			TaskID:       request.Event.TaskId,
+			RetryAttempt: request.Event.RetryAttempt,
+			Error:        request.Event.GetError(),
+		})
+}
+
 func (m *TaskExecutionManager) CreateTaskExecutionEvent(ctx context.Context, request admin.TaskExecutionEventRequest) (
 	*admin.TaskExecutionEventResponse, error) {
 	if err := validation.ValidateTaskExecutionRequest(request, m.config.ApplicationConfiguration().GetRemoteDataConfig().MaxSizeInBytes); err != nil {
@@ -157,6 +191,7 @@ func (m *TaskExecutionManager) CreateTaskExecutionEvent(ctx context.Context, req
 		if err != nil {
 			return nil, err
 		}
+		m.publishRetriesExhaustedNotifications(ctx, &request)
 
 		return &admin.TaskExecutionEventResponse{}, nil
 	}
@@ -185,6 +220,8 @@ func (m *TaskExecutionManager) CreateTaskExecutionEvent(ctx context.Context, req
 		return nil, err
 	}
 
+	m.publishRetriesExhaustedNotifications(ctx, &request)
+
 	if request.Event.Phase == core.TaskExecution_RUNNING && request.Event.PhaseVersion == 0 {
 		m.metrics.ActiveTaskExecutions.Inc()
 	} else if common.IsTaskExecutionTerminal(request.Event.Phase) && request.Event.PhaseVersion == 0 {
@@ -195,7 +232,7 @@ func (m *TaskExecutionManager) CreateTaskExecutionEvent(ctx context.Context, req
 		}
 	}
 
-	if err = m.notificationClient.Publish(ctx, proto.MessageName(&request), &request); err != nil {
+	if err = m.eventPublisher.Publish(ctx, proto.MessageName(&request), &request); err != nil {
 		m.metrics.PublishEventError.Inc()
 		logger.Infof(ctx, "error publishing event [%+v] with err: [%v]", request.RequestId, err)
 	}
@@ -326,7 +363,8 @@ func (m *TaskExecutionManager) GetTaskExecutionData(
 	return response, nil
 }
 
-func NewTaskExecutionManager(db repositories.RepositoryInterface, config runtimeInterfaces.Configuration, storageClient *storage.DataStore, scope promutils.Scope, urlData dataInterfaces.RemoteURLInterface, publisher notificationInterfaces.Publisher) interfaces.TaskExecutionInterface {
+func NewTaskExecutionManager(db repositories.RepositoryInterface, config runtimeInterfaces.Configuration, storageClient *storage.DataStore, scope promutils.Scope, urlData dataInterfaces.RemoteURLInterface, publisher notificationInterfaces.Publisher,
+	notificationPublisher notificationInterfaces.Publisher) interfaces.TaskExecutionInterface {
 	metrics := taskExecutionMetrics{
 		Scope: scope,
 		ActiveTaskExecutions: scope.MustNewGauge("active_executions",
@@ -349,6 +387,8 @@ func NewTaskExecutionManager(db repositories.RepositoryInterface, config runtime
 			"size in bytes of serialized node execution outputs"),
 		PublishEventError: scope.MustNewCounter("publish_event_error",
 			"overall count of publish event errors when invoking publish()"),
+		PublishNotificationError: scope.MustNewCounter("publish_notification_error",
+			"overall count of publish notification errors when invoking publish()"),
 	}
 	return &TaskExecutionManager{
 		db:                 db,
@@ -356,6 +396,7 @@ func NewTaskExecutionManager(db repositories.RepositoryInterface, config runtime
 		storageClient:      storageClient,
 		metrics:            metrics,
 		urlData:            urlData,
-		notificationClient: publisher,
+		eventPublisher:     publisher,
+		notificationClient: notificationPublisher,
 	}
 }
@@ -7,7 +7,10 @@ import (
 	"testing"
 	"time"
 
+	notificationMocks "github.com/flyteorg/flyteadmin/pkg/async/notifications/mocks"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
 	"github.com/flyteorg/flytestdlib/storage"
 
 	"github.com/flyteorg/flyteadmin/pkg/common"
@@ -175,7 +178,7 @@ func TestCreateTaskEvent(t *testing.T) {
 			}, input)
 			return nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.True(t, getTaskCalled)
 	assert.True(t, createTaskCalled)
@@ -288,7 +291,7 @@ func TestCreateTaskEvent_Update(t *testing.T) {
 		OutputUri: expectedOutputResult.OutputUri,
 	}
 
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, nil)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.True(t, getTaskCalled)
 	assert.True(t, updateTaskCalled)
@@ -307,7 +310,7 @@ func TestCreateTaskEvent_MissingExecution(t *testing.T) {
 		ctx context.Context, input interfaces.NodeExecutionResource) (bool, error) {
 		return false, expectedErr
 	}
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.EqualError(t, err, "Failed to get existing node execution id: [node_id:\"node-id\""+
 		" execution_id:<project:\"project\" domain:\"domain\" name:\"name\" > ] "+
@@ -318,7 +321,7 @@ func TestCreateTaskEvent_MissingExecution(t *testing.T) {
 		ctx context.Context, input interfaces.NodeExecutionResource) (bool, error) {
 		return false, nil
 	}
-	taskExecManager = NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager = NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil)
 	resp, err = taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.EqualError(t, err, "failed to get existing node execution id: [node_id:\"node-id\""+
 		" execution_id:<project:\"project\" domain:\"domain\" name:\"name\" > ]")
@@ -337,7 +340,7 @@ func TestCreateTaskEvent_CreateDatabaseError(t *testing.T) {
 		func(ctx context.Context, input models.TaskExecution) error {
 			return expectedErr
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.EqualError(t, err, expectedErr.Error())
 	assert.Nil(t, resp)
@@ -377,7 +380,7 @@ func TestCreateTaskEvent_UpdateDatabaseError(t *testing.T) {
 		func(ctx context.Context, execution models.TaskExecution) error {
 			return expectedErr
 		})
-	nodeExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	nodeExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil)
 	resp, err := nodeExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.EqualError(t, err, expectedErr.Error())
 	assert.Nil(t, resp)
@@ -410,7 +413,7 @@ func TestCreateTaskEvent_UpdateTerminalEventError(t *testing.T) {
 			}, nil
 		})
 	taskEventRequest.Event.Phase = core.TaskExecution_RUNNING
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 
 	assert.Nil(t, resp)
@@ -477,7 +480,7 @@ func TestCreateTaskEvent_PhaseVersionChange(t *testing.T) {
 	taskEventRequest.Event.PhaseVersion = uint32(1)
 	taskEventRequest.Event.OccurredAt = taskEventUpdatedAtProto
 
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, nil)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.True(t, getTaskCalled)
 	assert.True(t, updateTaskCalled)
@@ -485,6 +488,138 @@ func TestCreateTaskEvent_PhaseVersionChange(t *testing.T) {
 	assert.NotNil(t, resp)
 }
 
+func TestCreateTaskEvent_RetriesExhaustedNotification(t *testing.T) {
+	for name, test := range map[string]struct {
+		triggerProject string
+		retryAttempt   uint32
+		published      bool
+	}{
+		"last attempt":         {triggerProject: "project", retryAttempt: 2, published: true},
+		"retries left":         {triggerProject: "project", retryAttempt: 1},
+		"non-matching trigger": {triggerProject: "other", retryAttempt: 2},
+	} {
+		t.Run(name, func(t *testing.T) {
+			repository := repositoryMocks.NewMockRepository()
+			addGetExecutionWithClosureCallback(t, repository)
+			addGetNodeExecutionCallback(repository)
+			taskClosure, err := proto.Marshal(&admin.TaskClosure{
+				CompiledTask: &core.CompiledTask{
+					Template: &core.TaskTemplate{
+						Id: sampleTaskID,
+						Metadata: &core.TaskMetadata{
+							Retries: &core.RetryStrategy{Retries: 2},
+						},
+					},
+				},
+			})
+			assert.NoError(t, err)
+			repository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetGetCallback(
+				func(input interfaces.Identifier) (models.Task, error) {
+					return models.Task{
+						TaskKey: models.TaskKey{
+							Project: sampleTaskID.Project,
+							Domain:  sampleTaskID.Domain,
+							Name:    sampleTaskID.Name,
+							Version: sampleTaskID.Version,
+						},
+						Closure: taskClosure,
+					}, nil
+				})
+			repository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetGetCallback(
+				func(ctx context.Context, input interfaces.GetTaskExecutionInput) (models.TaskExecution, error) {
+					return models.TaskExecution{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "foo")
+				})
+			configProvider := getMockExecutionsConfigProvider()
+			configProvider.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetNotificationsConfig(
+				runtimeInterfaces.NotificationsConfig{
+					Triggers: []runtimeInterfaces.NotificationTrigger{
+						{
+							Type:            runtimeInterfaces.NotificationTriggerTypeTaskRetriesExhausted,
+							Project:         test.triggerProject,
+							RecipientsEmail: []string{"oncall@example.com"},
+						},
+					},
+				})
+			var published []*admin.EmailMessage
+			notificationPublisher := notificationMocks.MockPublisher{}
+			notificationPublisher.SetPublishCallback(func(ctx context.Context, notificationType string, msg proto.Message) error {
+				assert.Equal(t, "flyteidl.admin.EmailNotification", notificationType)
+				published = append(published, msg.(*admin.EmailMessage))
+				return nil
+			})
+
+			failedRequest := admin.TaskExecutionEventRequest{
+				RequestId: "request id",
+				Event: &event.TaskExecutionEvent{
+					ProducerId:            "propeller",
+					TaskId:                sampleTaskID,
+					ParentNodeExecutionId: sampleNodeExecID,
+					OccurredAt:            sampleTaskEventOccurredAt,
+					Phase:                 core.TaskExecution_FAILED,
+					RetryAttempt:          test.retryAttempt,
+					InputUri:              "input uri",
+					OutputResult: &event.TaskExecutionEvent_Error{
+						Error: &core.ExecutionError{
+							Message: "oom",
+						},
+					},
+				},
+			}
+			taskExecManager := NewTaskExecutionManager(repository, configProvider,
+				getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL,
+				&mockPublisher, &notificationPublisher)
+			_, err = taskExecManager.CreateTaskExecutionEvent(context.Background(), failedRequest)
+			assert.Nil(t, err)
+			if !test.published {
+				assert.Empty(t, published)
+				return
+			}
+			assert.Len(t, published, 1)
+			assert.Equal(t, []string{"oncall@example.com"}, published[0].RecipientsEmail)
+			assert.Equal(t, "Task task-id in execution name exhausted its retries", published[0].SubjectLine)
+			assert.Equal(t, "Task task-id in execution project/domain/name failed on retry attempt 2 and will not be "+
+				"retried. The execution failed with error: [oom].", published[0].Body)
+		})
+	}
+}
+
+func TestCreateTaskEvent_RetriesExhaustedNotification_NoTriggers(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	addGetExecutionWithClosureCallback(t, repository)
+	addGetNodeExecutionCallback(repository)
+	repository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetGetCallback(
+		func(input interfaces.Identifier) (models.Task, error) {
+			assert.Fail(t, "tasks shouldn't be fetched without any notification triggers")
+			return models.Task{}, nil
+		})
+	repository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetTaskExecutionInput) (models.TaskExecution, error) {
+			return models.TaskExecution{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "foo")
+		})
+	notificationPublisher := notificationMocks.MockPublisher{}
+	notificationPublisher.SetPublishCallback(func(ctx context.Context, notificationType string, msg proto.Message) error {
+		assert.Fail(t, "no notification should be published")
+		return nil
+	})
+
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(),
+		getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL,
+		&mockPublisher, &notificationPublisher)
+	_, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), admin.TaskExecutionEventRequest{
+		RequestId: "request id",
+		Event: &event.TaskExecutionEvent{
+			ProducerId:            "propeller",
+			TaskId:                sampleTaskID,
+			ParentNodeExecutionId: sampleNodeExecID,
+			OccurredAt:            sampleTaskEventOccurredAt,
+			Phase:                 core.TaskExecution_FAILED,
+			RetryAttempt:          2,
+			InputUri:              "input uri",
+		},
+	})
+	assert.Nil(t, err)
+}
+
 func TestGetTaskExecution(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
 	addGetWorkflowExecutionCallback(repository)
@@ -543,7 +678,7 @@ func TestGetTaskExecution(t *testing.T) {
 				},
 			}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil)
 	taskExecution, err := taskExecManager.GetTaskExecution(context.Background(), admin.TaskExecutionGetRequest{
 		Id: &core.TaskExecutionIdentifier{
 			TaskId:          sampleTaskID,
@@ -593,7 +728,7 @@ func TestGetTaskExecution_TransformerError(t *testing.T) {
 				Closure:   []byte("i'm an invalid task closure"),
 			}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil)
 	taskExecution, err := taskExecManager.GetTaskExecution(context.Background(), admin.TaskExecutionGetRequest{
 		Id: &core.TaskExecutionIdentifier{
 			TaskId:          sampleTaskID,
@@ -703,7 +838,7 @@ func TestListTaskExecutions(t *testing.T) {
 				},
 			}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil)
 	taskExecutions, err := taskExecManager.ListTaskExecutions(context.Background(), admin.TaskExecutionListRequest{
 		NodeExecutionId: &core.NodeExecutionIdentifier{
 			NodeId: "nodey b",
@@ -774,7 +909,7 @@ func TestListTaskExecutions_NoFilters(t *testing.T) {
 			listTaskCalled = true
 			return interfaces.TaskExecutionCollectionOutput{}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil)
 	_, err := taskExecManager.ListTaskExecutions(context.Background(), admin.TaskExecutionListRequest{
 		Token: "1",
 		Limit: 99,
@@ -792,7 +927,7 @@ func TestListTaskExecutions_NoLimit(t *testing.T) {
 			getTaskCalled = true
 			return interfaces.TaskExecutionCollectionOutput{}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil)
 	_, err := taskExecManager.ListTaskExecutions(context.Background(), admin.TaskExecutionListRequest{
 		Limit: 0,
 	})
@@ -823,7 +958,7 @@ func TestListTaskExecutions_NothingToReturn(t *testing.T) {
 			listTasksCalled = true
 			return interfaces.TaskCollectionOutput{}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil)
 	_, err := taskExecManager.ListTaskExecutions(context.Background(), admin.TaskExecutionListRequest{
 		NodeExecutionId: &core.NodeExecutionIdentifier{
 			ExecutionId: &core.WorkflowExecutionIdentifier{
@@ -925,7 +1060,7 @@ func TestGetTaskExecutionData(t *testing.T) {
 		}
 		return fmt.Errorf("unexpected call to find value in storage [%v]", reference.String())
 	}
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), mockStorage, mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), mockStorage, mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil)
 	dataResponse, err := taskExecManager.GetTaskExecutionData(context.Background(), admin.TaskExecutionGetDataRequest{
 		Id: &core.TaskExecutionIdentifier{
 			TaskId:          sampleTaskID,
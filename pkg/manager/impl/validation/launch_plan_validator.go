@@ -2,12 +2,17 @@ package validation
 
 import (
 	"context"
+	"time"
 
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications"
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
 	"github.com/flyteorg/flyteadmin/pkg/repositories"
 	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteadmin/scheduler/calendar"
+	schedulerCore "github.com/flyteorg/flyteadmin/scheduler/core"
+	schedulerModels "github.com/flyteorg/flyteadmin/scheduler/repositories/models"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flytepropeller/pkg/compiler/validators"
@@ -44,7 +49,22 @@ func ValidateLaunchPlan(ctx context.Context,
 	if err != nil {
 		return err
 	}
-	if err := validateSchedule(request, expectedInputs); err != nil {
+	if err := validateSchedule(request, expectedInputs, config); err != nil {
+		return err
+	}
+	if err := validateExclusionCalendars(request, config); err != nil {
+		return err
+	}
+	if err := validateTrigger(request, expectedInputs); err != nil {
+		return err
+	}
+	if err := validateStorageTrigger(request, expectedInputs); err != nil {
+		return err
+	}
+	if err := validateNotificationTriggers(request, config); err != nil {
+		return err
+	}
+	if err := validateWebhooks(request, config); err != nil {
 		return err
 	}
 	// Augment default inputs with the unbound workflow inputs.
@@ -57,8 +77,16 @@ func ValidateLaunchPlan(ctx context.Context,
 	return nil
 }
 
-func validateSchedule(request admin.LaunchPlanCreateRequest, expectedInputs *core.ParameterMap) error {
+func validateSchedule(request admin.LaunchPlanCreateRequest, expectedInputs *core.ParameterMap,
+	config runtimeInterfaces.ApplicationConfiguration) error {
 	schedule := request.GetSpec().GetEntityMetadata().GetSchedule()
+	// Other schedulers, e.g. AWS CloudWatch, take their own cron syntax and don't support catch-up policies.
+	nativeScheduler := config.GetSchedulerConfig().EventSchedulerConfig.GetScheme() == common.Local
+	if nativeScheduler && (schedule.GetCronSchedule() != nil || schedule.GetRate() != nil) {
+		if err := validateScheduleFires(request, schedule); err != nil {
+			return err
+		}
+	}
 	if schedule.GetCronExpression() != "" || schedule.GetRate() != nil {
 		for key, value := range expectedInputs.Parameters {
 			if value.GetRequired() && key != schedule.GetKickoffTimeInputArg() {
@@ -82,6 +110,125 @@ func validateSchedule(request admin.LaunchPlanCreateRequest, expectedInputs *cor
 	return nil
 }
 
+// Rejects schedules which the native scheduler can't parse or would never fire.
+func validateScheduleFires(request admin.LaunchPlanCreateRequest, schedule *admin.Schedule) error {
+	catchupPolicy := request.GetSpec().GetAnnotations().GetValues()[schedulerCore.CatchupPolicyAnnotation]
+	entity, err := schedulerCore.NewSchedulableEntity(schedulerModels.SchedulableEntityKey{}, *schedule, catchupPolicy)
+	if err != nil {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid schedule: %v", err)
+	}
+	scheduledTimes, err := schedulerCore.GetNextScheduledTimes(entity, time.Now(), time.Time{}, 1, nil)
+	if err != nil {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid schedule: %v", err)
+	}
+	if len(scheduledTimes) == 0 {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid schedule: it never fires")
+	}
+	return nil
+}
+
+// Rejects references to calendars which aren't configured.
+func validateExclusionCalendars(request admin.LaunchPlanCreateRequest,
+	config runtimeInterfaces.ApplicationConfiguration) error {
+	names := request.GetSpec().GetAnnotations().GetValues()[calendar.ExclusionCalendarsAnnotation]
+	if len(names) == 0 {
+		return nil
+	}
+	calendars, err := calendar.Load(config)
+	if err != nil {
+		return errors.NewFlyteAdminErrorf(codes.Internal, "invalid calendar configuration: %v", err)
+	}
+	if err = calendars.Validate(names); err != nil {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid exclusion calendars: %v", err)
+	}
+	return nil
+}
+
+// Validates the trigger declared by the launch plan annotations, if any. Triggered executions are only given the inputs
+// bound to upstream outputs, so these must be free inputs and no other input may be required.
+func validateTrigger(request admin.LaunchPlanCreateRequest, expectedInputs *core.ParameterMap) error {
+	trigger, err := common.ParseLaunchPlanTrigger(*request.Id, request.GetSpec().GetAnnotations().GetValues())
+	if err != nil {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid trigger: %v", err)
+	}
+	if trigger == nil {
+		return nil
+	}
+	if trigger.Upstream.Project == request.Id.Project && trigger.Upstream.Domain == request.Id.Domain &&
+		trigger.Upstream.Name == request.Id.Name {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid trigger: a launch plan can't trigger itself")
+	}
+	for input := range trigger.Inputs {
+		if _, ok := expectedInputs.Parameters[input]; !ok {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"invalid trigger: input [%v] is not free or does not exist", input)
+		}
+	}
+	for key, value := range expectedInputs.Parameters {
+		if _, ok := trigger.Inputs[key]; value.GetRequired() && !ok {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"Cannot create a launch plan with a trigger if there is an unbound required input. [%v] is required", key)
+		}
+	}
+	return nil
+}
+
+// Validates the notification triggers declared by the launch plan annotations, if any. longRunning thresholds are bound
+// by the longest threshold the long running checker scans for.
+func validateNotificationTriggers(request admin.LaunchPlanCreateRequest,
+	config runtimeInterfaces.ApplicationConfiguration) error {
+	triggers, err := notifications.ParseLaunchPlanNotificationTriggers(*request.Id, request.GetSpec().GetAnnotations().GetValues())
+	if err != nil {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid notification trigger: %v", err)
+	}
+	if len(triggers) > 0 && !config.GetNotificationsConfig().LaunchPlanTriggersEnabled {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid notification trigger: launch plan notification triggers are not enabled")
+	}
+	for _, trigger := range triggers {
+		if trigger.Type != runtimeInterfaces.NotificationTriggerTypeLongRunning {
+			continue
+		}
+		maxThreshold := config.GetNotificationsConfig().LongRunningChecker.MaxLaunchPlanThreshold.Duration
+		if trigger.RunningThreshold.Duration > maxThreshold {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"invalid notification trigger: long running threshold [%v] exceeds the maximum of [%v]",
+				trigger.RunningThreshold.Duration, maxThreshold)
+		}
+	}
+	return nil
+}
+
+// Validates the webhook declared by the launch plan annotations, if any.
+func validateWebhooks(request admin.LaunchPlanCreateRequest, config runtimeInterfaces.ApplicationConfiguration) error {
+	if _, err := notifications.ParseLaunchPlanWebhooks(*request.Id, request.GetSpec().GetAnnotations().GetValues(),
+		config.GetNotificationsConfig().LaunchPlanWebhooks); err != nil {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid webhook: %v", err)
+	}
+	return nil
+}
+
+// Validates the storage trigger declared by the launch plan annotations, if any. Executions launched for new objects
+// are only given the object, so no other input may be required.
+func validateStorageTrigger(request admin.LaunchPlanCreateRequest, expectedInputs *core.ParameterMap) error {
+	trigger, err := schedulerCore.NewStorageTrigger(schedulerModels.SchedulableEntityKey{},
+		request.GetSpec().GetAnnotations().GetValues(), expectedInputs)
+	if err != nil {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid storage trigger: %v", err)
+	}
+	if trigger == nil {
+		return nil
+	}
+	for key, value := range expectedInputs.Parameters {
+		if value.GetRequired() && key != trigger.InputName {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"Cannot create a launch plan with a storage trigger if there is an unbound required input. [%v] is required",
+				key)
+		}
+	}
+	return nil
+}
+
 func checkAndFetchExpectedInputForLaunchPlan(
 	workflowVariableMap *core.VariableMap, fixedInputs *core.LiteralMap, defaultInputs *core.ParameterMap) (*core.ParameterMap, error) {
 	expectedInputMap := map[string]*core.Parameter{}
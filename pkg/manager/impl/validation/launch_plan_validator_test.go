@@ -3,18 +3,37 @@ package validation
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 
 	"github.com/flyteorg/flyteidl/clients/go/coreutils"
 
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	flyteConfig "github.com/flyteorg/flytestdlib/config"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
 )
 
 var lpApplicationConfig = testutils.GetApplicationConfigWithDefaultDomains()
 
+var nativeSchedulerConfig = getNativeSchedulerConfig()
+
+func getNativeSchedulerConfig() runtimeInterfaces.ApplicationConfiguration {
+	config := &runtimeMocks.MockApplicationProvider{}
+	config.SetSchedulerConfig(runtimeInterfaces.SchedulerConfig{
+		EventSchedulerConfig: runtimeInterfaces.EventSchedulerConfig{
+			Scheme: common.Local,
+		},
+	})
+	return config
+}
+
 func getWorkflowInterface() *core.TypedInterface {
 	return testutils.GetSampleWorkflowSpecForTest().Template.Interface
 }
@@ -290,7 +309,7 @@ func TestValidateSchedule_NoSchedule(t *testing.T) {
 			},
 		},
 	}
-	err := validateSchedule(request, inputMap)
+	err := validateSchedule(request, inputMap, lpApplicationConfig)
 	assert.Nil(t, err)
 }
 
@@ -309,7 +328,7 @@ func TestValidateSchedule_ArgNotFixed(t *testing.T) {
 		},
 	}
 
-	err := validateSchedule(request, inputMap)
+	err := validateSchedule(request, inputMap, lpApplicationConfig)
 	assert.NotNil(t, err)
 }
 
@@ -320,7 +339,7 @@ func TestValidateSchedule_KickoffTimeArgDoesNotExist(t *testing.T) {
 	}
 	request.Spec.EntityMetadata.Schedule.KickoffTimeInputArg = "Does not exist"
 
-	err := validateSchedule(request, inputMap)
+	err := validateSchedule(request, inputMap, lpApplicationConfig)
 	assert.NotNil(t, err)
 }
 
@@ -340,7 +359,7 @@ func TestValidateSchedule_KickoffTimeArgPointsAtWrongType(t *testing.T) {
 	}
 	request.Spec.EntityMetadata.Schedule.KickoffTimeInputArg = "foo"
 
-	err := validateSchedule(request, inputMap)
+	err := validateSchedule(request, inputMap, lpApplicationConfig)
 	assert.NotNil(t, err)
 }
 
@@ -359,7 +378,7 @@ func TestValidateSchedule_NoRequired(t *testing.T) {
 		},
 	}
 
-	err := validateSchedule(request, inputMap)
+	err := validateSchedule(request, inputMap, lpApplicationConfig)
 	assert.Nil(t, err)
 }
 
@@ -379,6 +398,240 @@ func TestValidateSchedule_KickoffTimeBound(t *testing.T) {
 	}
 	request.Spec.EntityMetadata.Schedule.KickoffTimeInputArg = "foo"
 
-	err := validateSchedule(request, inputMap)
+	err := validateSchedule(request, inputMap, lpApplicationConfig)
 	assert.Nil(t, err)
 }
+
+func TestValidateSchedule_NeverFires(t *testing.T) {
+	for _, schedule := range []*admin.Schedule{
+		{
+			ScheduleExpression: &admin.Schedule_CronSchedule{
+				CronSchedule: &admin.CronSchedule{Schedule: "0 0 30 2 *"},
+			},
+		},
+		{
+			ScheduleExpression: &admin.Schedule_CronSchedule{
+				CronSchedule: &admin.CronSchedule{Schedule: "every morning"},
+			},
+		},
+		{
+			ScheduleExpression: &admin.Schedule_Rate{
+				Rate: &admin.FixedRate{Value: 0, Unit: admin.FixedRateUnit_MINUTE},
+			},
+		},
+	} {
+		request := testutils.GetLaunchPlanRequest()
+		request.Spec.EntityMetadata = &admin.LaunchPlanMetadata{Schedule: schedule}
+		err := validateSchedule(request, &core.ParameterMap{}, nativeSchedulerConfig)
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+	}
+}
+
+func TestValidateSchedule_CronSchedule(t *testing.T) {
+	request := testutils.GetLaunchPlanRequest()
+	request.Spec.EntityMetadata = &admin.LaunchPlanMetadata{
+		Schedule: &admin.Schedule{
+			ScheduleExpression: &admin.Schedule_CronSchedule{
+				CronSchedule: &admin.CronSchedule{Schedule: "CRON_TZ=Europe/Berlin 0 9 * * 1-5"},
+			},
+		},
+	}
+	assert.Nil(t, validateSchedule(request, &core.ParameterMap{}, nativeSchedulerConfig))
+
+	request.Spec.Annotations = &admin.Annotations{
+		Values: map[string]string{"flyte.org/catchup-policy": "everything"},
+	}
+	assert.NotNil(t, validateSchedule(request, &core.ParameterMap{}, nativeSchedulerConfig))
+}
+
+func TestValidateSchedule_AWSScheduler(t *testing.T) {
+	request := testutils.GetLaunchPlanRequest()
+	request.Spec.EntityMetadata = &admin.LaunchPlanMetadata{
+		Schedule: &admin.Schedule{
+			ScheduleExpression: &admin.Schedule_CronSchedule{
+				CronSchedule: &admin.CronSchedule{Schedule: "0 10 * * ? *"},
+			},
+		},
+	}
+	// CloudWatch cron expressions aren't parsed by the native scheduler.
+	assert.NotNil(t, validateSchedule(request, &core.ParameterMap{}, nativeSchedulerConfig))
+
+	config := &runtimeMocks.MockApplicationProvider{}
+	config.SetSchedulerConfig(runtimeInterfaces.SchedulerConfig{
+		EventSchedulerConfig: runtimeInterfaces.EventSchedulerConfig{
+			Scheme: common.AWS,
+		},
+	})
+	assert.Nil(t, validateSchedule(request, &core.ParameterMap{}, config))
+}
+
+func TestValidateExclusionCalendars(t *testing.T) {
+	config := &runtimeMocks.MockApplicationProvider{}
+	config.SetSchedulerConfig(runtimeInterfaces.SchedulerConfig{
+		EventSchedulerConfig: runtimeInterfaces.EventSchedulerConfig{
+			FlyteSchedulerConfig: &runtimeInterfaces.FlyteSchedulerConfig{
+				Calendars: map[string]runtimeInterfaces.ScheduleCalendarConfig{
+					"nyse": {AnnualDates: []string{"12-25"}},
+				},
+			},
+		},
+	})
+	request := testutils.GetLaunchPlanRequest()
+	assert.Nil(t, validateExclusionCalendars(request, config))
+
+	request.Spec.Annotations = &admin.Annotations{
+		Values: map[string]string{"flyte.org/exclusion-calendars": "nyse"},
+	}
+	assert.Nil(t, validateExclusionCalendars(request, config))
+
+	request.Spec.Annotations.Values["flyte.org/exclusion-calendars"] = "nyse,lse"
+	err := validateExclusionCalendars(request, config)
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+}
+
+func TestValidateNotificationTriggers(t *testing.T) {
+	config := &runtimeMocks.MockApplicationProvider{}
+	notificationsConfig := runtimeInterfaces.NotificationsConfig{
+		LaunchPlanTriggersEnabled: true,
+		LongRunningChecker: runtimeInterfaces.LongRunningCheckerConfig{
+			MaxLaunchPlanThreshold: flyteConfig.Duration{Duration: 24 * time.Hour},
+		},
+	}
+	config.SetNotificationsConfig(notificationsConfig)
+	request := testutils.GetLaunchPlanRequest()
+	request.Spec.Annotations = &admin.Annotations{
+		Values: map[string]string{
+			"flyte.org/notify-on-node-failure":           "oncall@example.com",
+			"flyte.org/notify-on-long-running":           "oncall@example.com",
+			"flyte.org/notify-on-long-running-threshold": "2h",
+		},
+	}
+	assert.Nil(t, validateNotificationTriggers(request, config))
+
+	request.Spec.Annotations.Values["flyte.org/notify-on-long-running-threshold"] = "48h"
+	err := validateNotificationTriggers(request, config)
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+
+	delete(request.Spec.Annotations.Values, "flyte.org/notify-on-long-running-threshold")
+	err = validateNotificationTriggers(request, config)
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+
+	// Launch plans can't declare triggers unless these are enabled.
+	request.Spec.Annotations.Values = map[string]string{
+		"flyte.org/notify-on-node-failure": "oncall@example.com",
+	}
+	assert.Nil(t, validateNotificationTriggers(request, config))
+	notificationsConfig.LaunchPlanTriggersEnabled = false
+	config.SetNotificationsConfig(notificationsConfig)
+	err = validateNotificationTriggers(request, config)
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+}
+
+func TestValidateWebhooks(t *testing.T) {
+	config := &runtimeMocks.MockApplicationProvider{}
+	config.SetNotificationsConfig(runtimeInterfaces.NotificationsConfig{
+		LaunchPlanWebhooks: runtimeInterfaces.LaunchPlanWebhooksConfig{
+			AllowedURLPrefixes: []string{"https://hooks.example.com/"},
+		},
+	})
+	request := testutils.GetLaunchPlanRequest()
+	assert.Nil(t, validateWebhooks(request, config))
+
+	request.Spec.Annotations = &admin.Annotations{
+		Values: map[string]string{
+			"flyte.org/webhook-url":    "https://hooks.example.com/flyte",
+			"flyte.org/webhook-phases": "SUCCEEDED,FAILED",
+		},
+	}
+	assert.Nil(t, validateWebhooks(request, config))
+
+	request.Spec.Annotations.Values["flyte.org/webhook-url"] = "https://internal.example.com/flyte"
+	err := validateWebhooks(request, config)
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+
+	delete(request.Spec.Annotations.Values, "flyte.org/webhook-url")
+	err = validateWebhooks(request, config)
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+}
+
+func TestValidateTrigger(t *testing.T) {
+	inputMap := &core.ParameterMap{
+		Parameters: map[string]*core.Parameter{
+			"report": {
+				Var: &core.Variable{
+					Type: &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_STRING}},
+				},
+				Behavior: &core.Parameter_Required{Required: true},
+			},
+		},
+	}
+	request := testutils.GetLaunchPlanRequest()
+	request.Spec.Annotations = &admin.Annotations{
+		Values: map[string]string{
+			"flyte.org/trigger-on-launch-plan": "upstream",
+			"flyte.org/trigger-inputs":         "report=summary",
+		},
+	}
+	assert.Nil(t, validateTrigger(request, inputMap))
+
+	for _, annotations := range []map[string]string{
+		// The required input isn't bound.
+		{"flyte.org/trigger-on-launch-plan": "upstream"},
+		// The bound input doesn't exist.
+		{"flyte.org/trigger-on-launch-plan": "upstream", "flyte.org/trigger-inputs": "report=summary,rows=count"},
+		{"flyte.org/trigger-on-launch-plan": request.Id.Name, "flyte.org/trigger-inputs": "report=summary"},
+		{"flyte.org/trigger-on-launch-plan": "upstream", "flyte.org/trigger-on-phases": "QUEUED"},
+	} {
+		request.Spec.Annotations = &admin.Annotations{Values: annotations}
+		err := validateTrigger(request, inputMap)
+		assert.NotNil(t, err, annotations)
+		assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+	}
+}
+
+func TestValidateStorageTrigger(t *testing.T) {
+	inputMap := &core.ParameterMap{
+		Parameters: map[string]*core.Parameter{
+			"path": {
+				Var: &core.Variable{
+					Type: &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_STRING}},
+				},
+				Behavior: &core.Parameter_Required{Required: true},
+			},
+		},
+	}
+	request := testutils.GetLaunchPlanRequest()
+	request.Spec.Annotations = &admin.Annotations{
+		Values: map[string]string{
+			"flyte.org/storage-trigger-prefix": "s3://bucket/landing/",
+			"flyte.org/storage-trigger-input":  "path",
+		},
+	}
+	assert.Nil(t, validateStorageTrigger(request, inputMap))
+
+	inputMap.Parameters["rows"] = &core.Parameter{
+		Var: &core.Variable{
+			Type: &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_INTEGER}},
+		},
+		Behavior: &core.Parameter_Required{Required: true},
+	}
+	for _, annotations := range []map[string]string{
+		// The required rows input isn't bound.
+		{"flyte.org/storage-trigger-prefix": "s3://bucket/landing/", "flyte.org/storage-trigger-input": "path"},
+		// The bound input isn't a string or a blob.
+		{"flyte.org/storage-trigger-prefix": "s3://bucket/landing/", "flyte.org/storage-trigger-input": "rows"},
+		{"flyte.org/storage-trigger-input": "path"},
+	} {
+		request.Spec.Annotations = &admin.Annotations{Values: annotations}
+		err := validateStorageTrigger(request, inputMap)
+		assert.NotNil(t, err, annotations)
+		assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+	}
+}
@@ -0,0 +1,78 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
+)
+
+type ExecutionClusterList struct {
+	// Whether the clusters are probed. Without probes, all enabled clusters are reported as healthy.
+	HealthChecksEnabled bool                             `json:"healthChecksEnabled"`
+	Clusters            []executioncluster.ClusterHealth `json:"clusters"`
+}
+
+// How admin authenticates to a registered cluster. The paths are those at which the secrets holding the credentials
+// are mounted in the admin pods.
+type ExecutionClusterAuth struct {
+	Type      string `json:"type"`
+	TokenPath string `json:"tokenPath"`
+	CertPath  string `json:"certPath"`
+}
+
+// An execution cluster registered in the database, and the execution cluster labels it's assigned to.
+type ExecutionClusterRegistration struct {
+	Name     string               `json:"name"`
+	Endpoint string               `json:"endpoint"`
+	Auth     ExecutionClusterAuth `json:"auth"`
+	Enabled  bool                 `json:"enabled"`
+	Draining bool                 `json:"draining"`
+	// Optional number of concurrent executions the cluster is sized for.
+	Capacity int `json:"capacity,omitempty"`
+	// Weights of the cluster by the labels it's assigned to.
+	Labels map[string]float32 `json:"labels,omitempty"`
+}
+
+// Request to change a registered cluster. Fields which aren't set are left unchanged.
+type ExecutionClusterUpdateRequest struct {
+	Name     string                `json:"-"`
+	Endpoint *string               `json:"endpoint"`
+	Auth     *ExecutionClusterAuth `json:"auth"`
+	Enabled  *bool                 `json:"enabled"`
+	Draining *bool                 `json:"draining"`
+	Capacity *int                  `json:"capacity"`
+}
+
+// Request to assign a registered cluster to a label, or to reweight it within the label.
+type ExecutionClusterLabelRequest struct {
+	Cluster string  `json:"-"`
+	Label   string  `json:"-"`
+	Weight  float32 `json:"weight"`
+}
+
+// Reports whether a cluster has finished draining. A draining cluster is assigned no new executions, and is safe to
+// take out of service once none of the executions assigned to it are left running.
+type ExecutionClusterDrainStatus struct {
+	Cluster  string `json:"cluster"`
+	Draining bool   `json:"draining"`
+	// The executions assigned to the cluster which haven't reached a terminal phase.
+	NonTerminalExecutions int64 `json:"nonTerminalExecutions"`
+	// Whether the cluster is draining and none of its executions are left running.
+	Drained bool `json:"drained"`
+}
+
+// Interface for inspecting and managing the clusters executions are assigned to. Clusters can only be managed when
+// they are registered in the database, rather than configured.
+type ExecutionClusterInterface interface {
+	ListExecutionClusters(ctx context.Context) (*ExecutionClusterList, error)
+	RegisterExecutionCluster(ctx context.Context, request ExecutionClusterRegistration) (
+		*ExecutionClusterRegistration, error)
+	GetExecutionClusterRegistration(ctx context.Context, name string) (*ExecutionClusterRegistration, error)
+	// Enables, disables, drains or otherwise changes a registered cluster.
+	UpdateExecutionCluster(ctx context.Context, request ExecutionClusterUpdateRequest) (
+		*ExecutionClusterRegistration, error)
+	SetExecutionClusterLabel(ctx context.Context, request ExecutionClusterLabelRequest) (
+		*ExecutionClusterRegistration, error)
+	RemoveExecutionClusterLabel(ctx context.Context, cluster, label string) (*ExecutionClusterRegistration, error)
+	GetExecutionClusterDrainStatus(ctx context.Context, cluster string) (*ExecutionClusterDrainStatus, error)
+}
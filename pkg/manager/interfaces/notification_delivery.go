@@ -0,0 +1,50 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Request to list the notification deliveries of a project.
+type NotificationDeliveryListRequest struct {
+	Project string
+	// Optional, restricts the results to deliveries of executions in this domain.
+	Domain string
+	// Optional, restricts the results to deliveries in this status, e.g. DEAD_LETTER.
+	Status string
+	Limit  uint32
+	Token  string
+}
+
+// Describes the delivery of a single notification.
+type NotificationDelivery struct {
+	ID          uint                              `json:"id"`
+	ExecutionID *core.WorkflowExecutionIdentifier `json:"executionId,omitempty"`
+	Channel     string                            `json:"channel"`
+	Recipients  []string                          `json:"recipients"`
+	Status      string                            `json:"status"`
+	Attempts    uint32                            `json:"attempts"`
+	LastError   string                            `json:"lastError,omitempty"`
+	CreatedAt   time.Time                         `json:"createdAt"`
+	UpdatedAt   time.Time                         `json:"updatedAt"`
+	// The delivered email. Only populated when fetching an individual email delivery.
+	Email *admin.EmailMessage `json:"email,omitempty"`
+}
+
+type NotificationDeliveryList struct {
+	Deliveries []NotificationDelivery `json:"deliveries"`
+	// Pass this token in a subsequent request to fetch the next page of results. Empty when there are no more results.
+	Token string `json:"token,omitempty"`
+}
+
+// Interface for inspecting the notification delivery log and re-driving dead-lettered notifications.
+type NotificationDeliveryInterface interface {
+	ListNotificationDeliveries(ctx context.Context, request NotificationDeliveryListRequest) (
+		*NotificationDeliveryList, error)
+	GetNotificationDelivery(ctx context.Context, project string, id uint) (*NotificationDelivery, error)
+	// Publishes a dead-lettered notification again and resets its delivery attempts.
+	RedriveNotificationDelivery(ctx context.Context, project string, id uint) (*NotificationDelivery, error)
+}
@@ -0,0 +1,76 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Request to backfill the schedule of a launch plan over the time range [StartTime, EndTime).
+type ScheduleBackfillCreateRequest struct {
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+	// Name and version of the launch plan whose schedule is backfilled.
+	Name      string    `json:"name"`
+	Version   string    `json:"version"`
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+	// Optional, the number of executions created concurrently. Defaults to one.
+	Parallelism uint32 `json:"parallelism"`
+	// Optional, ASCENDING fires the oldest schedule times first and DESCENDING the most recent ones. Defaults to
+	// ASCENDING.
+	Order string `json:"order"`
+	// Optional, the catch-up policy which selects the schedule times in the range that are fired, e.g. latest-only or
+	// max-5. Defaults to the policy set on the launch plan with the flyte.org/catchup-policy annotation, or all.
+	CatchupPolicy string `json:"catchupPolicy"`
+}
+
+// Request to list the schedule backfills of a project.
+type ScheduleBackfillListRequest struct {
+	Project string
+	// Optional, restricts the results to backfills in this domain.
+	Domain string
+	// Optional, restricts the results to backfills of launch plans with this name.
+	Name string
+	// Optional, restricts the results to backfills in this phase, e.g. RUNNING.
+	Phase string
+	Limit uint32
+	Token string
+}
+
+// Describes a schedule backfill and its progress.
+type ScheduleBackfill struct {
+	ID          uint             `json:"id"`
+	LaunchPlan  *core.Identifier `json:"launchPlan"`
+	StartTime   time.Time        `json:"startTime"`
+	EndTime     time.Time        `json:"endTime"`
+	Parallelism uint32           `json:"parallelism"`
+	Order       string           `json:"order"`
+	// Empty when all the schedule times in the range are fired.
+	CatchupPolicy string `json:"catchupPolicy,omitempty"`
+	Phase         string `json:"phase"`
+	// Number of executions the backfill creates and the number created so far.
+	TotalExecutions     uint32 `json:"totalExecutions"`
+	CompletedExecutions uint32 `json:"completedExecutions"`
+	// The last schedule time fired, in the backfill order. Unset until the first batch of executions is created.
+	LastFiredTime *time.Time `json:"lastFiredTime,omitempty"`
+	Error         string     `json:"error,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	UpdatedAt     time.Time  `json:"updatedAt"`
+}
+
+type ScheduleBackfillList struct {
+	Backfills []ScheduleBackfill `json:"backfills"`
+	// Pass this token in a subsequent request to fetch the next page of results. Empty when there are no more results.
+	Token string `json:"token,omitempty"`
+}
+
+// Interface for backfilling launch plan schedules. Backfills are run by the native scheduler.
+type ScheduleBackfillInterface interface {
+	CreateScheduleBackfill(ctx context.Context, request ScheduleBackfillCreateRequest) (*ScheduleBackfill, error)
+	ListScheduleBackfills(ctx context.Context, request ScheduleBackfillListRequest) (*ScheduleBackfillList, error)
+	GetScheduleBackfill(ctx context.Context, project string, id uint) (*ScheduleBackfill, error)
+	// Stops a pending or running backfill. Executions which were already created are left as is.
+	CancelScheduleBackfill(ctx context.Context, project string, id uint) (*ScheduleBackfill, error)
+}
@@ -0,0 +1,40 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Request to preview the times at which the native scheduler fires the schedule of a launch plan.
+type SchedulePreviewRequest struct {
+	Project string
+	Domain  string
+	Name    string
+	Version string
+	// Optional, the time to preview from. Defaults to now. Fixed rate schedules are previewed as if they were
+	// activated at this time.
+	StartTime time.Time
+	// Optional, previews the fire times before this time rather than the next Limit ones.
+	EndTime time.Time
+	// Optional, the maximum number of fire times. Defaults to 10 without an end time.
+	Limit uint32
+}
+
+// A time at which the schedule fires an execution.
+type ScheduleFireTime struct {
+	ScheduledTime time.Time `json:"scheduledTime"`
+	// Inputs bound to the scheduled time, i.e. the kickoff time argument of cron schedules.
+	Inputs map[string]time.Time `json:"inputs,omitempty"`
+}
+
+type SchedulePreview struct {
+	LaunchPlan *core.Identifier   `json:"launchPlan"`
+	FireTimes  []ScheduleFireTime `json:"fireTimes"`
+}
+
+// Interface for previewing launch plan schedules as they are run by the native scheduler.
+type SchedulePreviewInterface interface {
+	PreviewSchedule(ctx context.Context, request SchedulePreviewRequest) (*SchedulePreview, error)
+}
@@ -0,0 +1,46 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Request to list the times the native scheduler fired the schedule of a launch plan.
+type ScheduleRunListRequest struct {
+	Project string
+	Domain  string
+	Name    string
+	// Optional, restricts the results to runs of this launch plan version.
+	Version string
+	// Optional, restricts the results to runs with this outcome, e.g. FAILED.
+	Outcome string
+	Limit   uint32
+	Token   string
+}
+
+// Describes a single time the native scheduler fired a schedule and what came of it.
+type ScheduleRun struct {
+	LaunchPlan    *core.Identifier `json:"launchPlan"`
+	ScheduledTime time.Time        `json:"scheduledTime"`
+	FiredAt       time.Time        `json:"firedAt"`
+	// The execution created for the scheduled time. Unset when the schedule was inactive or the time excluded.
+	Execution *core.WorkflowExecutionIdentifier `json:"execution,omitempty"`
+	// One of FIRED, SKIPPED_INACTIVE, SKIPPED_EXCLUDED, ALREADY_EXISTS or FAILED.
+	Outcome  string `json:"outcome"`
+	Attempts uint32 `json:"attempts"`
+	Error    string `json:"error,omitempty"`
+}
+
+type ScheduleRunList struct {
+	// Most recently scheduled runs first.
+	Runs []ScheduleRun `json:"runs"`
+	// Pass this token in a subsequent request to fetch the next page of results. Empty when there are no more results.
+	Token string `json:"token,omitempty"`
+}
+
+// Interface for inspecting the history of launch plan schedules run by the native scheduler.
+type ScheduleRunInterface interface {
+	ListScheduleRuns(ctx context.Context, request ScheduleRunListRequest) (*ScheduleRunList, error)
+}
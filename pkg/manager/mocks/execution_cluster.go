@@ -0,0 +1,116 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+type ListExecutionClustersFunc func(ctx context.Context) (*interfaces.ExecutionClusterList, error)
+
+type MockExecutionClusterManager struct {
+	listExecutionClustersFunc           ListExecutionClustersFunc
+	registerExecutionClusterFunc        RegisterExecutionClusterFunc
+	getExecutionClusterRegistrationFunc GetExecutionClusterRegistrationFunc
+	updateExecutionClusterFunc          UpdateExecutionClusterFunc
+	setExecutionClusterLabelFunc        SetExecutionClusterLabelFunc
+	removeExecutionClusterLabelFunc     RemoveExecutionClusterLabelFunc
+	getExecutionClusterDrainStatusFunc  GetExecutionClusterDrainStatusFunc
+}
+
+func (m *MockExecutionClusterManager) SetListCallback(listFunc ListExecutionClustersFunc) {
+	m.listExecutionClustersFunc = listFunc
+}
+
+func (m *MockExecutionClusterManager) ListExecutionClusters(ctx context.Context) (
+	*interfaces.ExecutionClusterList, error) {
+	if m.listExecutionClustersFunc != nil {
+		return m.listExecutionClustersFunc(ctx)
+	}
+	return nil, nil
+}
+
+type RegisterExecutionClusterFunc func(ctx context.Context, request interfaces.ExecutionClusterRegistration) (
+	*interfaces.ExecutionClusterRegistration, error)
+type GetExecutionClusterRegistrationFunc func(ctx context.Context, name string) (
+	*interfaces.ExecutionClusterRegistration, error)
+type UpdateExecutionClusterFunc func(ctx context.Context, request interfaces.ExecutionClusterUpdateRequest) (
+	*interfaces.ExecutionClusterRegistration, error)
+type SetExecutionClusterLabelFunc func(ctx context.Context, request interfaces.ExecutionClusterLabelRequest) (
+	*interfaces.ExecutionClusterRegistration, error)
+type RemoveExecutionClusterLabelFunc func(ctx context.Context, cluster, label string) (
+	*interfaces.ExecutionClusterRegistration, error)
+type GetExecutionClusterDrainStatusFunc func(ctx context.Context, cluster string) (
+	*interfaces.ExecutionClusterDrainStatus, error)
+
+func (m *MockExecutionClusterManager) SetRegisterCallback(registerFunc RegisterExecutionClusterFunc) {
+	m.registerExecutionClusterFunc = registerFunc
+}
+
+func (m *MockExecutionClusterManager) RegisterExecutionCluster(
+	ctx context.Context, request interfaces.ExecutionClusterRegistration) (*interfaces.ExecutionClusterRegistration, error) {
+	if m.registerExecutionClusterFunc != nil {
+		return m.registerExecutionClusterFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockExecutionClusterManager) SetGetCallback(getFunc GetExecutionClusterRegistrationFunc) {
+	m.getExecutionClusterRegistrationFunc = getFunc
+}
+
+func (m *MockExecutionClusterManager) GetExecutionClusterRegistration(ctx context.Context, name string) (
+	*interfaces.ExecutionClusterRegistration, error) {
+	if m.getExecutionClusterRegistrationFunc != nil {
+		return m.getExecutionClusterRegistrationFunc(ctx, name)
+	}
+	return nil, nil
+}
+
+func (m *MockExecutionClusterManager) SetUpdateCallback(updateFunc UpdateExecutionClusterFunc) {
+	m.updateExecutionClusterFunc = updateFunc
+}
+
+func (m *MockExecutionClusterManager) UpdateExecutionCluster(
+	ctx context.Context, request interfaces.ExecutionClusterUpdateRequest) (*interfaces.ExecutionClusterRegistration, error) {
+	if m.updateExecutionClusterFunc != nil {
+		return m.updateExecutionClusterFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockExecutionClusterManager) SetSetLabelCallback(setLabelFunc SetExecutionClusterLabelFunc) {
+	m.setExecutionClusterLabelFunc = setLabelFunc
+}
+
+func (m *MockExecutionClusterManager) SetExecutionClusterLabel(
+	ctx context.Context, request interfaces.ExecutionClusterLabelRequest) (*interfaces.ExecutionClusterRegistration, error) {
+	if m.setExecutionClusterLabelFunc != nil {
+		return m.setExecutionClusterLabelFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockExecutionClusterManager) SetRemoveLabelCallback(removeLabelFunc RemoveExecutionClusterLabelFunc) {
+	m.removeExecutionClusterLabelFunc = removeLabelFunc
+}
+
+func (m *MockExecutionClusterManager) RemoveExecutionClusterLabel(ctx context.Context, cluster, label string) (
+	*interfaces.ExecutionClusterRegistration, error) {
+	if m.removeExecutionClusterLabelFunc != nil {
+		return m.removeExecutionClusterLabelFunc(ctx, cluster, label)
+	}
+	return nil, nil
+}
+
+func (m *MockExecutionClusterManager) SetGetDrainStatusCallback(getDrainStatusFunc GetExecutionClusterDrainStatusFunc) {
+	m.getExecutionClusterDrainStatusFunc = getDrainStatusFunc
+}
+
+func (m *MockExecutionClusterManager) GetExecutionClusterDrainStatus(ctx context.Context, cluster string) (
+	*interfaces.ExecutionClusterDrainStatus, error) {
+	if m.getExecutionClusterDrainStatusFunc != nil {
+		return m.getExecutionClusterDrainStatusFunc(ctx, cluster)
+	}
+	return nil, nil
+}
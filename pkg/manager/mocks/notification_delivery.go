@@ -0,0 +1,53 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+type ListNotificationDeliveriesFunc func(
+	ctx context.Context, request interfaces.NotificationDeliveryListRequest) (*interfaces.NotificationDeliveryList, error)
+type NotificationDeliveryFunc func(ctx context.Context, project string, id uint) (*interfaces.NotificationDelivery, error)
+
+type MockNotificationDeliveryManager struct {
+	listNotificationDeliveriesFunc  ListNotificationDeliveriesFunc
+	getNotificationDeliveryFunc     NotificationDeliveryFunc
+	redriveNotificationDeliveryFunc NotificationDeliveryFunc
+}
+
+func (m *MockNotificationDeliveryManager) SetListCallback(listFunc ListNotificationDeliveriesFunc) {
+	m.listNotificationDeliveriesFunc = listFunc
+}
+
+func (m *MockNotificationDeliveryManager) ListNotificationDeliveries(
+	ctx context.Context, request interfaces.NotificationDeliveryListRequest) (*interfaces.NotificationDeliveryList, error) {
+	if m.listNotificationDeliveriesFunc != nil {
+		return m.listNotificationDeliveriesFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockNotificationDeliveryManager) SetGetCallback(getFunc NotificationDeliveryFunc) {
+	m.getNotificationDeliveryFunc = getFunc
+}
+
+func (m *MockNotificationDeliveryManager) GetNotificationDelivery(
+	ctx context.Context, project string, id uint) (*interfaces.NotificationDelivery, error) {
+	if m.getNotificationDeliveryFunc != nil {
+		return m.getNotificationDeliveryFunc(ctx, project, id)
+	}
+	return nil, nil
+}
+
+func (m *MockNotificationDeliveryManager) SetRedriveCallback(redriveFunc NotificationDeliveryFunc) {
+	m.redriveNotificationDeliveryFunc = redriveFunc
+}
+
+func (m *MockNotificationDeliveryManager) RedriveNotificationDelivery(
+	ctx context.Context, project string, id uint) (*interfaces.NotificationDelivery, error) {
+	if m.redriveNotificationDeliveryFunc != nil {
+		return m.redriveNotificationDeliveryFunc(ctx, project, id)
+	}
+	return nil, nil
+}
@@ -0,0 +1,68 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+type CreateScheduleBackfillFunc func(
+	ctx context.Context, request interfaces.ScheduleBackfillCreateRequest) (*interfaces.ScheduleBackfill, error)
+type ListScheduleBackfillsFunc func(
+	ctx context.Context, request interfaces.ScheduleBackfillListRequest) (*interfaces.ScheduleBackfillList, error)
+type ScheduleBackfillFunc func(ctx context.Context, project string, id uint) (*interfaces.ScheduleBackfill, error)
+
+type MockScheduleBackfillManager struct {
+	createScheduleBackfillFunc CreateScheduleBackfillFunc
+	listScheduleBackfillsFunc  ListScheduleBackfillsFunc
+	getScheduleBackfillFunc    ScheduleBackfillFunc
+	cancelScheduleBackfillFunc ScheduleBackfillFunc
+}
+
+func (m *MockScheduleBackfillManager) SetCreateCallback(createFunc CreateScheduleBackfillFunc) {
+	m.createScheduleBackfillFunc = createFunc
+}
+
+func (m *MockScheduleBackfillManager) CreateScheduleBackfill(
+	ctx context.Context, request interfaces.ScheduleBackfillCreateRequest) (*interfaces.ScheduleBackfill, error) {
+	if m.createScheduleBackfillFunc != nil {
+		return m.createScheduleBackfillFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockScheduleBackfillManager) SetListCallback(listFunc ListScheduleBackfillsFunc) {
+	m.listScheduleBackfillsFunc = listFunc
+}
+
+func (m *MockScheduleBackfillManager) ListScheduleBackfills(
+	ctx context.Context, request interfaces.ScheduleBackfillListRequest) (*interfaces.ScheduleBackfillList, error) {
+	if m.listScheduleBackfillsFunc != nil {
+		return m.listScheduleBackfillsFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockScheduleBackfillManager) SetGetCallback(getFunc ScheduleBackfillFunc) {
+	m.getScheduleBackfillFunc = getFunc
+}
+
+func (m *MockScheduleBackfillManager) GetScheduleBackfill(
+	ctx context.Context, project string, id uint) (*interfaces.ScheduleBackfill, error) {
+	if m.getScheduleBackfillFunc != nil {
+		return m.getScheduleBackfillFunc(ctx, project, id)
+	}
+	return nil, nil
+}
+
+func (m *MockScheduleBackfillManager) SetCancelCallback(cancelFunc ScheduleBackfillFunc) {
+	m.cancelScheduleBackfillFunc = cancelFunc
+}
+
+func (m *MockScheduleBackfillManager) CancelScheduleBackfill(
+	ctx context.Context, project string, id uint) (*interfaces.ScheduleBackfill, error) {
+	if m.cancelScheduleBackfillFunc != nil {
+		return m.cancelScheduleBackfillFunc(ctx, project, id)
+	}
+	return nil, nil
+}
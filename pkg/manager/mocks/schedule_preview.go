@@ -0,0 +1,26 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+type PreviewScheduleFunc func(
+	ctx context.Context, request interfaces.SchedulePreviewRequest) (*interfaces.SchedulePreview, error)
+
+type MockSchedulePreviewManager struct {
+	previewScheduleFunc PreviewScheduleFunc
+}
+
+func (m *MockSchedulePreviewManager) SetPreviewCallback(previewFunc PreviewScheduleFunc) {
+	m.previewScheduleFunc = previewFunc
+}
+
+func (m *MockSchedulePreviewManager) PreviewSchedule(
+	ctx context.Context, request interfaces.SchedulePreviewRequest) (*interfaces.SchedulePreview, error) {
+	if m.previewScheduleFunc != nil {
+		return m.previewScheduleFunc(ctx, request)
+	}
+	return nil, nil
+}
@@ -0,0 +1,26 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+type ListScheduleRunsFunc func(
+	ctx context.Context, request interfaces.ScheduleRunListRequest) (*interfaces.ScheduleRunList, error)
+
+type MockScheduleRunManager struct {
+	listScheduleRunsFunc ListScheduleRunsFunc
+}
+
+func (m *MockScheduleRunManager) SetListCallback(listFunc ListScheduleRunsFunc) {
+	m.listScheduleRunsFunc = listFunc
+}
+
+func (m *MockScheduleRunManager) ListScheduleRuns(
+	ctx context.Context, request interfaces.ScheduleRunListRequest) (*interfaces.ScheduleRunList, error) {
+	if m.listScheduleRunsFunc != nil {
+		return m.listScheduleRunsFunc(ctx, request)
+	}
+	return nil, nil
+}
@@ -326,4 +326,204 @@ var Migrations = []*gormigrate.Migration{
 			return tx.DropTable("schedulable_entities_snapshot").Error
 		},
 	},
+
+	{
+		ID: "2021-10-01-notification_queue",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.NotificationQueueItem{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("notification_queue").Error
+		},
+	},
+
+	{
+		ID: "2021-10-08-notification_deliveries",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.NotificationDelivery{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("notification_deliveries").Error
+		},
+	},
+
+	{
+		ID: "2021-10-15-scheduler_leases",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&schedulerModels.SchedulerLease{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("scheduler_leases").Error
+		},
+	},
+
+	{
+		ID: "2021-10-22-schedule_backfills",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&schedulerModels.ScheduleBackfill{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("schedule_backfills").Error
+		},
+	},
+	// Add the timezone in which cron schedules are evaluated.
+	{
+		ID: "2021-10-29-schedule_timezones",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&schedulerModels.SchedulableEntity{}, &schedulerModels.ScheduleBackfill{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Model(&schedulerModels.SchedulableEntity{}).DropColumn("timezone").Error; err != nil {
+				return err
+			}
+			return tx.Model(&schedulerModels.ScheduleBackfill{}).DropColumn("timezone").Error
+		},
+	},
+	// Add the policy deciding which missed schedule times are fired.
+	{
+		ID: "2021-11-05-schedule_catchup_policies",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&schedulerModels.SchedulableEntity{}, &schedulerModels.ScheduleBackfill{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Model(&schedulerModels.SchedulableEntity{}).DropColumn("catchup_policy").Error; err != nil {
+				return err
+			}
+			return tx.Model(&schedulerModels.ScheduleBackfill{}).DropColumn("catchup_policy").Error
+		},
+	},
+
+	{
+		ID: "2021-11-12-schedule_runs",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&schedulerModels.ScheduleRun{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("schedule_runs").Error
+		},
+	},
+
+	{
+		ID: "2021-11-19-schedule_last_fire",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&schedulerModels.ScheduleLastFire{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("schedule_last_fire").Error
+		},
+	},
+
+	{
+		ID: "2021-11-26-launch_plan_triggers",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.LaunchPlanTrigger{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("launch_plan_triggers").Error
+		},
+	},
+
+	{
+		ID: "2021-12-03-storage_triggers",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&schedulerModels.StorageTrigger{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("storage_triggers").Error
+		},
+	},
+
+	// Add the calendars whose days the schedules don't fire on.
+	{
+		ID: "2021-12-10-schedule_exclusion_calendars",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&schedulerModels.SchedulableEntity{}, &schedulerModels.ScheduleBackfill{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Model(&schedulerModels.SchedulableEntity{}).DropColumn("exclusion_calendars").Error; err != nil {
+				return err
+			}
+			return tx.Model(&schedulerModels.ScheduleBackfill{}).DropColumn("exclusion_calendars").Error
+		},
+	},
+
+	{
+		ID: "2021-12-17-execution_clusters",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.ExecutionCluster{}, &models.ExecutionClusterLabel{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.DropTable("execution_cluster_labels").Error; err != nil {
+				return err
+			}
+			return tx.DropTable("execution_clusters").Error
+		},
+	},
+
+	// Add the workflow executor executions were routed to.
+	{
+		ID: "2021-12-24-execution_executors",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.Execution{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Model(&models.Execution{}).DropColumn("executor").Error
+		},
+	},
+
+	{
+		ID: "2021-12-31-long_running_notifications",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.LongRunningNotification{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("long_running_notifications").Error
+		},
+	},
+
+	// Add the objects storage triggers launched for, to launch once for objects which show up late.
+	{
+		ID: "2022-01-07-storage_trigger_objects",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&schedulerModels.StorageTriggerObject{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("storage_trigger_objects").Error
+		},
+	},
+
+	// Look up notification deliveries by the delivery ID carried in the message rather than by the message digest.
+	{
+		ID: "2022-01-14-notification_delivery_ids",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&models.NotificationDelivery{}).Error; err != nil {
+				return err
+			}
+			return tx.Exec("ALTER TABLE notification_deliveries DROP COLUMN IF EXISTS message_digest").Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			// The digests of the existing deliveries aren't restored.
+			err := tx.Exec("ALTER TABLE notification_deliveries ADD COLUMN IF NOT EXISTS message_digest text").Error
+			if err != nil {
+				return err
+			}
+			err = tx.Exec("CREATE INDEX IF NOT EXISTS notification_deliveries_message_digest_idx " +
+				"ON notification_deliveries (message_digest)").Error
+			if err != nil {
+				return err
+			}
+			return tx.Model(&models.NotificationDelivery{}).DropColumn("delivery_id").Error
+		},
+	},
+
+	// Add the last schedule time fired by backfills, which they are resumed from.
+	{
+		ID: "2022-01-21-schedule_backfill_last_fired_times",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&schedulerModels.ScheduleBackfill{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Model(&schedulerModels.ScheduleBackfill{}).DropColumn("last_fired_time").Error
+		},
+	},
 }
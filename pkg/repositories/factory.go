@@ -27,16 +27,27 @@ type RepositoryInterface interface {
 	TaskRepo() interfaces.TaskRepoInterface
 	WorkflowRepo() interfaces.WorkflowRepoInterface
 	LaunchPlanRepo() interfaces.LaunchPlanRepoInterface
+	LaunchPlanTriggerRepo() interfaces.LaunchPlanTriggerRepoInterface
 	ExecutionRepo() interfaces.ExecutionRepoInterface
 	ExecutionEventRepo() interfaces.ExecutionEventRepoInterface
+	ExecutionClusterRepo() interfaces.ExecutionClusterRepoInterface
 	ProjectRepo() interfaces.ProjectRepoInterface
 	ResourceRepo() interfaces.ResourceRepoInterface
 	NodeExecutionRepo() interfaces.NodeExecutionRepoInterface
 	NodeExecutionEventRepo() interfaces.NodeExecutionEventRepoInterface
 	TaskExecutionRepo() interfaces.TaskExecutionRepoInterface
 	NamedEntityRepo() interfaces.NamedEntityRepoInterface
+	NotificationQueueRepo() interfaces.NotificationQueueRepoInterface
+	NotificationDeliveryRepo() interfaces.NotificationDeliveryRepoInterface
+	LongRunningNotificationRepo() interfaces.LongRunningNotificationRepoInterface
 	SchedulableEntityRepo() schedulerInterfaces.SchedulableEntityRepoInterface
 	ScheduleEntitiesSnapshotRepo() schedulerInterfaces.ScheduleEntitiesSnapShotRepoInterface
+	SchedulerLeaseRepo() schedulerInterfaces.SchedulerLeaseRepoInterface
+	ScheduleBackfillRepo() schedulerInterfaces.ScheduleBackfillRepoInterface
+	ScheduleRunRepo() schedulerInterfaces.ScheduleRunRepoInterface
+	ScheduleLastFireRepo() schedulerInterfaces.ScheduleLastFireRepoInterface
+	StorageTriggerRepo() schedulerInterfaces.StorageTriggerRepoInterface
+	ScheduleUpdateRepo() schedulerInterfaces.ScheduleUpdateRepoInterface
 }
 
 func GetRepository(repoType RepoConfig, dbConfig config.DbConfig, scope promutils.Scope) RepositoryInterface {
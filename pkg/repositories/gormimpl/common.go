@@ -18,6 +18,8 @@ const Description = "description"
 const ResourceType = "resource_type"
 const State = "state"
 const ID = "id"
+const Phase = "phase"
+const Cluster = "cluster"
 
 const executionTableName = "executions"
 const namedEntityMetadataTableName = "named_entity_metadata"
@@ -32,15 +34,16 @@ const filters = "filters"
 var identifierGroupBy = fmt.Sprintf("%s, %s, %s", Project, Domain, Name)
 
 var entityToTableName = map[common.Entity]string{
-	common.Execution:           "executions",
-	common.LaunchPlan:          "launch_plans",
-	common.NodeExecution:       "node_executions",
-	common.NodeExecutionEvent:  "node_execution_events",
-	common.Task:                "tasks",
-	common.TaskExecution:       "task_executions",
-	common.Workflow:            "workflows",
-	common.NamedEntity:         "entities",
-	common.NamedEntityMetadata: "named_entity_metadata",
+	common.Execution:            "executions",
+	common.LaunchPlan:           "launch_plans",
+	common.NodeExecution:        "node_executions",
+	common.NodeExecutionEvent:   "node_execution_events",
+	common.Task:                 "tasks",
+	common.TaskExecution:        "task_executions",
+	common.Workflow:             "workflows",
+	common.NamedEntity:          "entities",
+	common.NamedEntityMetadata:  "named_entity_metadata",
+	common.NotificationDelivery: "notification_deliveries",
 }
 
 var innerJoinNodeExecToNodeEvents = fmt.Sprintf(
@@ -0,0 +1,143 @@
+package gormimpl
+
+import (
+	"context"
+	"time"
+
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+)
+
+const setExecutionClusterLabelQuery = `INSERT INTO execution_cluster_labels (created_at, updated_at, label, cluster, weight)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT (label, cluster) DO UPDATE SET updated_at = EXCLUDED.updated_at, weight = EXCLUDED.weight`
+
+// Implementation of ExecutionClusterRepoInterface.
+type ExecutionClusterRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *ExecutionClusterRepo) Create(ctx context.Context, input models.ExecutionCluster,
+	labels []models.ExecutionClusterLabel) error {
+	timer := r.metrics.CreateDuration.Start()
+	defer timer.Stop()
+	tx := r.db.Begin()
+	if err := tx.Create(&input).Error; err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	now := time.Now()
+	for _, label := range labels {
+		if err := tx.Exec(setExecutionClusterLabelQuery, now, now, label.Label, input.Name, label.Weight).Error; err != nil {
+			tx.Rollback()
+			return r.errorTransformer.ToFlyteAdminError(err)
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
+func (r *ExecutionClusterRepo) Update(ctx context.Context, input models.ExecutionCluster) error {
+	timer := r.metrics.UpdateDuration.Start()
+	// Updates with a map rather than the model so that disabling and undraining, which set zero values, are saved.
+	tx := r.db.Model(&models.ExecutionCluster{}).Where(&models.ExecutionCluster{Name: input.Name}).Updates(
+		map[string]interface{}{
+			"endpoint":   input.Endpoint,
+			"auth_type":  input.AuthType,
+			"token_path": input.TokenPath,
+			"cert_path":  input.CertPath,
+			"enabled":    input.Enabled,
+			"draining":   input.Draining,
+			"capacity":   input.Capacity,
+			"updated_at": time.Now(),
+		})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "execution cluster [%s] not found", input.Name)
+	}
+	return nil
+}
+
+func (r *ExecutionClusterRepo) Get(ctx context.Context, name string) (models.ExecutionCluster, error) {
+	var cluster models.ExecutionCluster
+	timer := r.metrics.GetDuration.Start()
+	tx := r.db.Where(&models.ExecutionCluster{Name: name}).Take(&cluster)
+	timer.Stop()
+	if tx.Error != nil {
+		if tx.RecordNotFound() {
+			return models.ExecutionCluster{}, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound,
+				"execution cluster [%s] not found", name)
+		}
+		return models.ExecutionCluster{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return cluster, nil
+}
+
+func (r *ExecutionClusterRepo) List(ctx context.Context) ([]models.ExecutionCluster, error) {
+	var clusters []models.ExecutionCluster
+	timer := r.metrics.ListDuration.Start()
+	tx := r.db.Order(ID).Find(&clusters)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return clusters, nil
+}
+
+func (r *ExecutionClusterRepo) SetLabel(ctx context.Context, input models.ExecutionClusterLabel) error {
+	now := time.Now()
+	timer := r.metrics.UpdateDuration.Start()
+	tx := r.db.Exec(setExecutionClusterLabelQuery, now, now, input.Label, input.Cluster, input.Weight)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *ExecutionClusterRepo) DeleteLabel(ctx context.Context, label, cluster string) error {
+	timer := r.metrics.DeleteDuration.Start()
+	tx := r.db.Where(&models.ExecutionClusterLabel{
+		Label:   label,
+		Cluster: cluster,
+	}).Delete(&models.ExecutionClusterLabel{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *ExecutionClusterRepo) ListLabels(ctx context.Context) ([]models.ExecutionClusterLabel, error) {
+	var labels []models.ExecutionClusterLabel
+	timer := r.metrics.ListDuration.Start()
+	tx := r.db.Order(ID).Find(&labels)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return labels, nil
+}
+
+// Returns an instance of ExecutionClusterRepoInterface
+func NewExecutionClusterRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.ExecutionClusterRepoInterface {
+	metrics := newMetrics(scope)
+	return &ExecutionClusterRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
@@ -0,0 +1,142 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+const executionClusterName = "cluster"
+
+func TestCreateExecutionCluster(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`INSERT INTO "execution_clusters" ("created_at","updated_at","name","endpoint","auth_type",` +
+		`"token_path","cert_path","enabled","draining","capacity")`)
+	labelQuery := GlobalMock.NewMock()
+	labelQuery.WithQuery(`INSERT INTO execution_cluster_labels (created_at, updated_at, label, cluster, weight)`)
+	repo := NewExecutionClusterRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	err := repo.Create(context.Background(), models.ExecutionCluster{
+		Name:     executionClusterName,
+		Endpoint: "endpoint",
+		Enabled:  true,
+	}, []models.ExecutionClusterLabel{{Label: "gpu", Weight: 0.5}})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+	assert.True(t, labelQuery.Triggered)
+}
+
+func TestCreateExecutionCluster_LabelError(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`INSERT INTO "execution_clusters"`)
+	GlobalMock.NewMock().WithQuery(`INSERT INTO execution_cluster_labels`).WithExecException()
+	repo := NewExecutionClusterRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	err := repo.Create(context.Background(), models.ExecutionCluster{
+		Name:     executionClusterName,
+		Endpoint: "endpoint",
+	}, []models.ExecutionClusterLabel{{Label: "gpu", Weight: 0.5}})
+	assert.Error(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestUpdateExecutionCluster(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`UPDATE "execution_clusters" SET "auth_type" = ?, "capacity" = ?, "cert_path" = ?, ` +
+		`"draining" = ?, "enabled" = ?, "endpoint" = ?, "token_path" = ?, "updated_at" = ?  ` +
+		`WHERE ("execution_clusters"."name" = ?)`).WithRowsNum(1)
+	repo := NewExecutionClusterRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	err := repo.Update(context.Background(), models.ExecutionCluster{Name: executionClusterName})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestUpdateExecutionCluster_NotFound(t *testing.T) {
+	mocket.Catcher.Reset()
+	repo := NewExecutionClusterRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	err := repo.Update(context.Background(), models.ExecutionCluster{Name: executionClusterName})
+	assert.Error(t, err)
+	assert.Equal(t, codes.NotFound, err.(adminErrors.FlyteAdminError).Code())
+}
+
+func TestGetExecutionCluster(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(
+		`SELECT * FROM "execution_clusters"  WHERE ("execution_clusters"."name" = cluster) LIMIT 1`).WithReply(
+		[]map[string]interface{}{
+			{"name": executionClusterName, "endpoint": "endpoint", "enabled": true, "draining": true},
+		})
+	repo := NewExecutionClusterRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	cluster, err := repo.Get(context.Background(), executionClusterName)
+	assert.NoError(t, err)
+	assert.Equal(t, "endpoint", cluster.Endpoint)
+	assert.True(t, cluster.Draining)
+}
+
+func TestGetExecutionCluster_NotFound(t *testing.T) {
+	mocket.Catcher.Reset()
+	repo := NewExecutionClusterRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	_, err := repo.Get(context.Background(), executionClusterName)
+	assert.Error(t, err)
+	assert.Equal(t, codes.NotFound, err.(adminErrors.FlyteAdminError).Code())
+}
+
+func TestListExecutionClusters(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "execution_clusters"   ORDER BY "id"`).WithReply(
+		[]map[string]interface{}{
+			{"name": "a"},
+			{"name": "b"},
+		})
+	repo := NewExecutionClusterRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	clusters, err := repo.List(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, clusters, 2)
+	assert.Equal(t, "b", clusters[1].Name)
+}
+
+func TestSetExecutionClusterLabel(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`ON CONFLICT (label, cluster) DO UPDATE SET updated_at = EXCLUDED.updated_at, weight = EXCLUDED.weight`)
+	repo := NewExecutionClusterRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	err := repo.SetLabel(context.Background(), models.ExecutionClusterLabel{
+		Label:   "gpu",
+		Cluster: executionClusterName,
+		Weight:  0.5,
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestDeleteExecutionClusterLabel(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`DELETE FROM "execution_cluster_labels"  WHERE ("execution_cluster_labels"."label" = ?) AND ` +
+		`("execution_cluster_labels"."cluster" = ?)`)
+	repo := NewExecutionClusterRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	err := repo.DeleteLabel(context.Background(), "gpu", executionClusterName)
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestListExecutionClusterLabels(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "execution_cluster_labels"   ORDER BY "id"`).WithReply(
+		[]map[string]interface{}{
+			{"label": "gpu", "cluster": "a", "weight": 0.5},
+		})
+	repo := NewExecutionClusterRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	labels, err := repo.ListLabels(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, labels, 1)
+	assert.Equal(t, "a", labels[0].Cluster)
+}
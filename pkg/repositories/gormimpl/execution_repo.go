@@ -128,6 +128,31 @@ func (r *ExecutionRepo) Exists(ctx context.Context, input interfaces.Identifier)
 	return !tx.RecordNotFound(), nil
 }
 
+type clusterExecutionCount struct {
+	Cluster string
+	Count   int64
+}
+
+func (r *ExecutionRepo) CountNonTerminalByCluster(ctx context.Context) (map[string]int64, error) {
+	terminalPhases := make([]string, 0)
+	for _, phase := range common.GetTerminalExecutionPhases() {
+		terminalPhases = append(terminalPhases, phase.String())
+	}
+	var counts []clusterExecutionCount
+	timer := r.metrics.CountDuration.Start()
+	tx := r.db.Model(&models.Execution{}).Select(fmt.Sprintf("%s, COUNT(*) AS count", Cluster)).Where(
+		fmt.Sprintf("%s NOT IN (?)", Phase), terminalPhases).Group(Cluster).Scan(&counts)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	countsByCluster := make(map[string]int64, len(counts))
+	for _, count := range counts {
+		countsByCluster[count.Cluster] = count.Count
+	}
+	return countsByCluster, nil
+}
+
 // Returns an instance of ExecutionRepoInterface
 func NewExecutionRepo(
 	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.ExecutionRepoInterface {
@@ -370,3 +370,18 @@ func TestExecutionExists(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, exists)
 }
+
+func TestCountNonTerminalExecutionsByCluster(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT cluster, COUNT(*) AS count FROM "executions"  WHERE ` +
+		`"executions"."deleted_at" IS NULL AND ((phase NOT IN (SUCCEEDED,FAILED,ABORTED,TIMED_OUT))) ` +
+		`GROUP BY cluster`).WithReply(
+		[]map[string]interface{}{
+			{"cluster": "cluster1", "count": 3},
+			{"cluster": "cluster2", "count": 1},
+		})
+	counts, err := executionRepo.CountNonTerminalByCluster(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{"cluster1": 3, "cluster2": 1}, counts)
+}
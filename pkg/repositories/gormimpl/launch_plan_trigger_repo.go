@@ -0,0 +1,105 @@
+package gormimpl
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+)
+
+const launchPlanTriggerEntity = "launch plan trigger"
+
+const setLaunchPlanTriggerQuery = `INSERT INTO launch_plan_triggers (created_at, updated_at, project, domain, name,
+version, upstream_project, upstream_domain, upstream_name, phases, inputs)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (project, domain, name) DO UPDATE SET updated_at = EXCLUDED.updated_at, version = EXCLUDED.version,
+upstream_project = EXCLUDED.upstream_project, upstream_domain = EXCLUDED.upstream_domain,
+upstream_name = EXCLUDED.upstream_name, phases = EXCLUDED.phases, inputs = EXCLUDED.inputs`
+
+// Implementation of LaunchPlanTriggerRepoInterface.
+type LaunchPlanTriggerRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *LaunchPlanTriggerRepo) Set(ctx context.Context, input models.LaunchPlanTrigger) error {
+	now := time.Now()
+	timer := r.metrics.UpdateDuration.Start()
+	tx := r.db.Exec(setLaunchPlanTriggerQuery, now, now, input.Project, input.Domain, input.Name, input.Version,
+		input.UpstreamProject, input.UpstreamDomain, input.UpstreamName, input.Phases, input.Inputs)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *LaunchPlanTriggerRepo) Get(ctx context.Context, input interfaces.Identifier) (models.LaunchPlanTrigger, error) {
+	var trigger models.LaunchPlanTrigger
+	timer := r.metrics.GetDuration.Start()
+	tx := r.db.Where(&models.LaunchPlanTrigger{
+		Project: input.Project,
+		Domain:  input.Domain,
+		Name:    input.Name,
+	}).Take(&trigger)
+	timer.Stop()
+	if tx.Error != nil {
+		if tx.RecordNotFound() {
+			return models.LaunchPlanTrigger{}, errors.GetMissingEntityError(launchPlanTriggerEntity, &core.Identifier{
+				Project: input.Project,
+				Domain:  input.Domain,
+				Name:    input.Name,
+			})
+		}
+		return models.LaunchPlanTrigger{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return trigger, nil
+}
+
+func (r *LaunchPlanTriggerRepo) Delete(ctx context.Context, input interfaces.Identifier) error {
+	timer := r.metrics.DeleteDuration.Start()
+	tx := r.db.Where(&models.LaunchPlanTrigger{
+		Project: input.Project,
+		Domain:  input.Domain,
+		Name:    input.Name,
+		Version: input.Version,
+	}).Delete(&models.LaunchPlanTrigger{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *LaunchPlanTriggerRepo) ListByUpstream(ctx context.Context, input interfaces.Identifier) (
+	[]models.LaunchPlanTrigger, error) {
+	var triggers []models.LaunchPlanTrigger
+	timer := r.metrics.ListDuration.Start()
+	tx := r.db.Where(&models.LaunchPlanTrigger{
+		UpstreamProject: input.Project,
+		UpstreamDomain:  input.Domain,
+		UpstreamName:    input.Name,
+	}).Order("id").Find(&triggers)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return triggers, nil
+}
+
+// Returns an instance of LaunchPlanTriggerRepoInterface
+func NewLaunchPlanTriggerRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.LaunchPlanTriggerRepoInterface {
+	metrics := newMetrics(scope)
+	return &LaunchPlanTriggerRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
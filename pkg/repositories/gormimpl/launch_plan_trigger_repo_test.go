@@ -0,0 +1,88 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestSetLaunchPlanTrigger(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`INSERT INTO launch_plan_triggers (created_at, updated_at, project, domain, name,`)
+	repo := NewLaunchPlanTriggerRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	err := repo.Set(context.Background(), models.LaunchPlanTrigger{
+		Project:         project,
+		Domain:          domain,
+		Name:            name,
+		Version:         version,
+		UpstreamProject: project,
+		UpstreamDomain:  domain,
+		UpstreamName:    "upstream",
+		Phases:          "SUCCEEDED",
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestGetLaunchPlanTrigger(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(
+		`SELECT * FROM "launch_plan_triggers"  WHERE ("launch_plan_triggers"."project" = project) AND ` +
+			`("launch_plan_triggers"."domain" = domain) AND ("launch_plan_triggers"."name" = name) LIMIT 1`).WithReply(
+		[]map[string]interface{}{
+			{"project": project, "domain": domain, "name": name, "version": version, "upstream_name": "upstream"},
+		})
+	repo := NewLaunchPlanTriggerRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	trigger, err := repo.Get(context.Background(), interfaces.Identifier{Project: project, Domain: domain, Name: name})
+	assert.NoError(t, err)
+	assert.Equal(t, version, trigger.Version)
+	assert.Equal(t, "upstream", trigger.UpstreamName)
+}
+
+func TestGetLaunchPlanTrigger_NotFound(t *testing.T) {
+	mocket.Catcher.Reset()
+	repo := NewLaunchPlanTriggerRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	_, err := repo.Get(context.Background(), interfaces.Identifier{Project: project, Domain: domain, Name: name})
+	assert.Error(t, err)
+	assert.Equal(t, codes.NotFound, err.(adminErrors.FlyteAdminError).Code())
+}
+
+func TestDeleteLaunchPlanTrigger(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`DELETE FROM "launch_plan_triggers"  WHERE ("launch_plan_triggers"."project" = ?) AND ` +
+		`("launch_plan_triggers"."domain" = ?) AND ("launch_plan_triggers"."name" = ?) AND ` +
+		`("launch_plan_triggers"."version" = ?)`)
+	repo := NewLaunchPlanTriggerRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	err := repo.Delete(context.Background(), interfaces.Identifier{
+		Project: project, Domain: domain, Name: name, Version: version})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestListLaunchPlanTriggersByUpstream(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(
+		`SELECT * FROM "launch_plan_triggers"  WHERE ("launch_plan_triggers"."upstream_project" = project) AND ` +
+			`("launch_plan_triggers"."upstream_domain" = domain) AND ` +
+			`("launch_plan_triggers"."upstream_name" = upstream) ORDER BY "id"`).WithReply(
+		[]map[string]interface{}{
+			{"project": project, "domain": domain, "name": "first", "upstream_name": "upstream"},
+			{"project": project, "domain": domain, "name": "second", "upstream_name": "upstream"},
+		})
+	repo := NewLaunchPlanTriggerRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	triggers, err := repo.ListByUpstream(context.Background(), interfaces.Identifier{
+		Project: project, Domain: domain, Name: "upstream"})
+	assert.NoError(t, err)
+	assert.Len(t, triggers, 2)
+	assert.Equal(t, "second", triggers[1].Name)
+}
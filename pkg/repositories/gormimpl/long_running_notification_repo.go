@@ -0,0 +1,83 @@
+package gormimpl
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+)
+
+// Implementation of LongRunningNotificationRepoInterface.
+type LongRunningNotificationRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *LongRunningNotificationRepo) Create(ctx context.Context, input models.LongRunningNotification) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := r.db.Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *LongRunningNotificationRepo) Delete(ctx context.Context, input models.LongRunningNotification) error {
+	timer := r.metrics.DeleteDuration.Start()
+	tx := r.db.Where(&models.LongRunningNotification{
+		ExecutionKey: input.ExecutionKey,
+		TriggerKey:   input.TriggerKey,
+	}).Delete(&models.LongRunningNotification{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+// Pages by the creation time and ID of the executions rather than by offset, so that executions which leave the
+// filtered phases or are handled between pages don't shift the following pages.
+func (r *LongRunningNotificationRepo) ListUnhandledExecutions(ctx context.Context,
+	input interfaces.ListUnhandledExecutionsInput) ([]models.Execution, error) {
+	query := r.db.Model(&models.Execution{}).
+		Where("phase IN (?) AND execution_created_at < ?", input.Phases, input.CreatedBefore)
+	if len(input.Project) > 0 {
+		query = query.Where("execution_project = ?", input.Project)
+	}
+	if len(input.Domain) > 0 {
+		query = query.Where("execution_domain = ?", input.Domain)
+	}
+	if input.After != nil {
+		query = query.Where("(execution_created_at, id) > (?, ?)", input.After.CreatedAt, input.After.ID)
+	}
+	query = query.Where("NOT EXISTS (SELECT 1 FROM long_running_notifications WHERE "+
+		"long_running_notifications.execution_project = executions.execution_project AND "+
+		"long_running_notifications.execution_domain = executions.execution_domain AND "+
+		"long_running_notifications.execution_name = executions.execution_name AND "+
+		"long_running_notifications.trigger_key = ?)", input.TriggerKey)
+
+	var executions []models.Execution
+	timer := r.metrics.ListDuration.Start()
+	tx := query.Order("execution_created_at, id").Limit(input.Limit).Find(&executions)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return executions, nil
+}
+
+// Returns an instance of LongRunningNotificationRepoInterface
+func NewLongRunningNotificationRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer,
+	scope promutils.Scope) interfaces.LongRunningNotificationRepoInterface {
+	metrics := newMetrics(scope)
+	return &LongRunningNotificationRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
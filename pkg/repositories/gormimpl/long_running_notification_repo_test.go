@@ -0,0 +1,67 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateLongRunningNotification(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`INSERT INTO "long_running_notifications" ("execution_project","execution_domain",` +
+		`"execution_name","trigger_key","created_at") VALUES (?,?,?,?,?)`)
+	repo := NewLongRunningNotificationRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	err := repo.Create(context.Background(), models.LongRunningNotification{
+		ExecutionKey: models.ExecutionKey{Project: "project", Domain: "domain", Name: "name"},
+		TriggerKey:   "trigger",
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestDeleteLongRunningNotification(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`DELETE FROM "long_running_notifications"  WHERE ` +
+		`("long_running_notifications"."execution_project" = ?) AND ` +
+		`("long_running_notifications"."execution_domain" = ?) AND ` +
+		`("long_running_notifications"."execution_name" = ?) AND ` +
+		`("long_running_notifications"."trigger_key" = ?)`)
+	repo := NewLongRunningNotificationRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	err := repo.Delete(context.Background(), models.LongRunningNotification{
+		ExecutionKey: models.ExecutionKey{Project: "project", Domain: "domain", Name: "name"},
+		TriggerKey:   "trigger",
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestListUnhandledExecutions(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`AND (execution_project = project) AND ((execution_created_at, id) > (`).
+		WithReply([]map[string]interface{}{
+			{"id": 2, "execution_project": "project", "execution_domain": "domain", "execution_name": "name"},
+		})
+	repo := NewLongRunningNotificationRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	executions, err := repo.ListUnhandledExecutions(context.Background(), interfaces.ListUnhandledExecutionsInput{
+		TriggerKey:    "trigger",
+		Phases:        []string{"RUNNING"},
+		CreatedBefore: time.Now(),
+		Project:       "project",
+		After:         &interfaces.ExecutionCursor{CreatedAt: time.Now(), ID: 1},
+		Limit:         10,
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+	assert.Len(t, executions, 1)
+	assert.Equal(t, "name", executions[0].Name)
+}
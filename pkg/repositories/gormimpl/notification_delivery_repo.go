@@ -0,0 +1,117 @@
+package gormimpl
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+)
+
+const notificationDeliveryEntity = "notification delivery"
+
+// Implementation of NotificationDeliveryRepoInterface.
+type NotificationDeliveryRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *NotificationDeliveryRepo) Create(ctx context.Context, input models.NotificationDelivery) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := r.db.Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *NotificationDeliveryRepo) Get(ctx context.Context, id uint) (models.NotificationDelivery, error) {
+	var delivery models.NotificationDelivery
+	timer := r.metrics.GetDuration.Start()
+	tx := r.db.Where("id = ?", id).Take(&delivery)
+	timer.Stop()
+	if tx.Error != nil {
+		if tx.RecordNotFound() {
+			return models.NotificationDelivery{}, errors.GetMissingEntityByIDError(notificationDeliveryEntity)
+		}
+		return models.NotificationDelivery{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return delivery, nil
+}
+
+func (r *NotificationDeliveryRepo) GetByDeliveryID(ctx context.Context, deliveryID string) (
+	models.NotificationDelivery, error) {
+	var delivery models.NotificationDelivery
+	timer := r.metrics.GetDuration.Start()
+	tx := r.db.Where("delivery_id = ?", deliveryID).Take(&delivery)
+	timer.Stop()
+	if tx.Error != nil {
+		if tx.RecordNotFound() {
+			return models.NotificationDelivery{}, errors.GetMissingEntityByIDError(notificationDeliveryEntity)
+		}
+		return models.NotificationDelivery{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return delivery, nil
+}
+
+func (r *NotificationDeliveryRepo) Update(ctx context.Context, input models.NotificationDelivery) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := r.db.Model(&models.NotificationDelivery{}).Where("id = ?", input.ID).Updates(map[string]interface{}{
+		"status":     input.Status,
+		"attempts":   input.Attempts,
+		"last_error": input.LastError,
+	})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *NotificationDeliveryRepo) List(ctx context.Context, input interfaces.ListResourceInput) (
+	interfaces.NotificationDeliveryCollectionOutput, error) {
+	// First validate input.
+	if err := ValidateListInput(input); err != nil {
+		return interfaces.NotificationDeliveryCollectionOutput{}, err
+	}
+	var deliveries []models.NotificationDelivery
+	tx := r.db.Limit(input.Limit).Offset(input.Offset)
+
+	// Apply filters
+	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
+	if err != nil {
+		return interfaces.NotificationDeliveryCollectionOutput{}, err
+	}
+
+	// Apply sort ordering.
+	if input.SortParameter != nil {
+		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	} else {
+		tx = tx.Order("id desc")
+	}
+
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Find(&deliveries)
+	timer.Stop()
+	if tx.Error != nil {
+		return interfaces.NotificationDeliveryCollectionOutput{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return interfaces.NotificationDeliveryCollectionOutput{
+		Deliveries: deliveries,
+	}, nil
+}
+
+// Returns an instance of NotificationDeliveryRepoInterface
+func NewNotificationDeliveryRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.NotificationDeliveryRepoInterface {
+	metrics := newMetrics(scope)
+	return &NotificationDeliveryRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
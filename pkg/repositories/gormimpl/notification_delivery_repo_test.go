@@ -0,0 +1,116 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCreateNotificationDelivery(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`INSERT INTO "notification_deliveries" ("created_at","updated_at","execution_project",` +
+		`"execution_domain","execution_name","channel","recipients","message","delivery_id","status","attempts",` +
+		`"last_error") VALUES (?,?,?,?,?,?,?,?,?,?,?,?)`)
+	repo := NewNotificationDeliveryRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	err := repo.Create(context.Background(), models.NotificationDelivery{
+		ExecutionKey: models.ExecutionKey{
+			Project: project,
+			Domain:  domain,
+			Name:    name,
+		},
+		Channel:    "email",
+		Recipients: "a@example.com,b@example.com",
+		Message:    []byte("message"),
+		DeliveryID: "delivery-id",
+		Status:     models.NotificationDeliveryStatusPending,
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestGetNotificationDelivery(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "notification_deliveries"  WHERE (id = 1) LIMIT 1`).WithReply(
+		[]map[string]interface{}{
+			{"id": 1, "execution_project": project, "status": "DEAD_LETTER", "attempts": 5},
+		})
+	repo := NewNotificationDeliveryRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	delivery, err := repo.Get(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), delivery.ID)
+	assert.Equal(t, project, delivery.Project)
+	assert.Equal(t, models.NotificationDeliveryStatusDeadLetter, delivery.Status)
+	assert.Equal(t, uint32(5), delivery.Attempts)
+}
+
+func TestGetNotificationDelivery_NotFound(t *testing.T) {
+	mocket.Catcher.Reset()
+	repo := NewNotificationDeliveryRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	_, err := repo.Get(context.Background(), 1)
+	assert.Error(t, err)
+	assert.Equal(t, codes.NotFound, err.(adminErrors.FlyteAdminError).Code())
+}
+
+func TestGetNotificationDeliveryByDeliveryID(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`SELECT * FROM "notification_deliveries"  WHERE (delivery_id = delivery-id) LIMIT 1`).WithReply(
+		[]map[string]interface{}{
+			{"id": 2, "delivery_id": "delivery-id", "status": "FAILED"},
+		})
+	repo := NewNotificationDeliveryRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	delivery, err := repo.GetByDeliveryID(context.Background(), "delivery-id")
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+	assert.Equal(t, uint(2), delivery.ID)
+}
+
+func TestUpdateNotificationDelivery(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`UPDATE "notification_deliveries" SET "attempts" = ?, "last_error" = ?, "status" = ?, ` +
+		`"updated_at" = ?  WHERE (id = ?)`)
+	repo := NewNotificationDeliveryRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	err := repo.Update(context.Background(), models.NotificationDelivery{
+		ID:        1,
+		Status:    models.NotificationDeliveryStatusFailed,
+		Attempts:  1,
+		LastError: "foo",
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestListNotificationDeliveries(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`SELECT * FROM "notification_deliveries"  WHERE (execution_project = project) AND ` +
+		`(status = DEAD_LETTER) ORDER BY id desc LIMIT 20 OFFSET 0`).WithReply([]map[string]interface{}{
+		{"id": 2, "execution_project": project, "status": "DEAD_LETTER"},
+		{"id": 1, "execution_project": project, "status": "DEAD_LETTER"},
+	})
+	repo := NewNotificationDeliveryRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	projectFilter, err := common.NewSingleValueFilter(common.NotificationDelivery, common.Equal, "execution_project", project)
+	assert.NoError(t, err)
+	statusFilter, err := common.NewSingleValueFilter(common.NotificationDelivery, common.Equal, "status",
+		models.NotificationDeliveryStatusDeadLetter)
+	assert.NoError(t, err)
+	output, err := repo.List(context.Background(), interfaces.ListResourceInput{
+		Limit:         20,
+		InlineFilters: []common.InlineFilter{projectFilter, statusFilter},
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+	assert.Len(t, output.Deliveries, 2)
+	assert.Equal(t, uint(2), output.Deliveries[0].ID)
+}
@@ -0,0 +1,109 @@
+package gormimpl
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+)
+
+// Implementation of NotificationQueueRepoInterface.
+type NotificationQueueRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *NotificationQueueRepo) Create(ctx context.Context, input models.NotificationQueueItem) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := r.db.Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+// Selecting and updating the claimed rows happens in a single transaction. The rows are locked with
+// FOR UPDATE SKIP LOCKED so that concurrent processors each claim a disjoint set of notifications.
+func (r *NotificationQueueRepo) Claim(ctx context.Context, input interfaces.ClaimNotificationsInput) (
+	[]models.NotificationQueueItem, error) {
+	timer := r.metrics.UpdateDuration.Start()
+	defer timer.Stop()
+	tx := r.db.Begin()
+
+	var items []models.NotificationQueueItem
+	query := tx.Set("gorm:query_option", "FOR UPDATE SKIP LOCKED").
+		Where("status = ? AND visible_at <= ?", models.NotificationQueueItemStatusPending, input.Now)
+	if len(input.NotificationTypes) > 0 {
+		query = query.Where("notification_type IN (?)", input.NotificationTypes)
+	}
+	query = query.Order("id").Limit(input.Limit).Find(&items)
+	if err := query.Error; err != nil {
+		tx.Rollback()
+		return nil, r.errorTransformer.ToFlyteAdminError(err)
+	}
+	if len(items) == 0 {
+		if err := tx.Commit().Error; err != nil {
+			return nil, r.errorTransformer.ToFlyteAdminError(err)
+		}
+		return items, nil
+	}
+
+	ids := make([]uint, len(items))
+	visibleAt := input.Now.Add(input.VisibilityTimeout)
+	for idx := range items {
+		ids[idx] = items[idx].ID
+		items[idx].Attempts++
+		items[idx].VisibleAt = visibleAt
+	}
+	update := tx.Model(&models.NotificationQueueItem{}).Where("id IN (?)", ids).UpdateColumns(map[string]interface{}{
+		"attempts":   gorm.Expr("attempts + 1"),
+		"visible_at": visibleAt,
+	})
+	if err := update.Error; err != nil {
+		tx.Rollback()
+		return nil, r.errorTransformer.ToFlyteAdminError(err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return items, nil
+}
+
+func (r *NotificationQueueRepo) UpdateStatus(ctx context.Context, input models.NotificationQueueItem) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := r.db.Model(&models.NotificationQueueItem{}).Where("id = ?", input.ID).Updates(map[string]interface{}{
+		"status":     input.Status,
+		"last_error": input.LastError,
+	})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *NotificationQueueRepo) Delete(ctx context.Context, id uint) error {
+	timer := r.metrics.DeleteDuration.Start()
+	tx := r.db.Where("id = ?", id).Delete(&models.NotificationQueueItem{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+// Returns an instance of NotificationQueueRepoInterface
+func NewNotificationQueueRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.NotificationQueueRepoInterface {
+	metrics := newMetrics(scope)
+	return &NotificationQueueRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
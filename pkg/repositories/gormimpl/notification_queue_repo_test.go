@@ -0,0 +1,116 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateNotificationQueueItem(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`INSERT INTO "notification_queue" ("created_at","updated_at","notification_type","message",` +
+		`"status","visible_at","attempts","last_error") VALUES (?,?,?,?,?,?,?,?)`)
+	repo := NewNotificationQueueRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	err := repo.Create(context.Background(), models.NotificationQueueItem{
+		NotificationType: "email",
+		Message:          []byte("message"),
+		Status:           models.NotificationQueueItemStatusPending,
+		VisibleAt:        time.Now(),
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestClaimNotificationQueueItems(t *testing.T) {
+	now := time.Now()
+	GlobalMock := mocket.Catcher.Reset()
+	selectQuery := GlobalMock.NewMock()
+	selectQuery.WithQuery(`ORDER BY "id" LIMIT 10 FOR UPDATE SKIP LOCKED`).WithReply([]map[string]interface{}{
+		{"id": 1, "status": "PENDING", "attempts": 0},
+		{"id": 2, "status": "PENDING", "attempts": 2},
+	})
+	updateQuery := GlobalMock.NewMock()
+	updateQuery.WithQuery(`UPDATE "notification_queue" SET "attempts" = attempts + 1, "visible_at" = ?  ` +
+		`WHERE (id IN (?,?))`)
+
+	repo := NewNotificationQueueRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	items, err := repo.Claim(context.Background(), interfaces.ClaimNotificationsInput{
+		Now:               now,
+		VisibilityTimeout: time.Minute,
+		Limit:             10,
+	})
+	assert.NoError(t, err)
+	assert.True(t, selectQuery.Triggered)
+	assert.True(t, updateQuery.Triggered)
+	assert.Len(t, items, 2)
+	assert.Equal(t, uint32(1), items[0].Attempts)
+	assert.Equal(t, uint32(3), items[1].Attempts)
+	assert.Equal(t, now.Add(time.Minute), items[1].VisibleAt)
+}
+
+func TestClaimNotificationQueueItems_NotificationTypes(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	selectQuery := GlobalMock.NewMock()
+	selectQuery.WithQuery(`AND (notification_type IN (email,webhook)) ORDER BY "id" LIMIT 10 FOR UPDATE SKIP LOCKED`).WithReply(
+		[]map[string]interface{}{
+			{"id": 1, "status": "PENDING", "notification_type": "email"},
+		})
+
+	repo := NewNotificationQueueRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	items, err := repo.Claim(context.Background(), interfaces.ClaimNotificationsInput{
+		Now:               time.Now(),
+		Limit:             10,
+		NotificationTypes: []string{"email", "webhook"},
+	})
+	assert.NoError(t, err)
+	assert.True(t, selectQuery.Triggered)
+	assert.Len(t, items, 1)
+}
+
+func TestClaimNotificationQueueItems_Empty(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	updateQuery := GlobalMock.NewMock()
+	updateQuery.WithQuery(`UPDATE "notification_queue"`)
+
+	repo := NewNotificationQueueRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	items, err := repo.Claim(context.Background(), interfaces.ClaimNotificationsInput{
+		Now:   time.Now(),
+		Limit: 10,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, items)
+	assert.False(t, updateQuery.Triggered)
+}
+
+func TestUpdateNotificationQueueItemStatus(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`UPDATE "notification_queue" SET "last_error" = ?, "status" = ?, "updated_at" = ?  WHERE (id = ?)`)
+
+	repo := NewNotificationQueueRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	err := repo.UpdateStatus(context.Background(), models.NotificationQueueItem{
+		ID:        1,
+		Status:    models.NotificationQueueItemStatusDeadLetter,
+		LastError: "foo",
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestDeleteNotificationQueueItem(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`DELETE FROM "notification_queue"  WHERE (id = ?)`)
+
+	repo := NewNotificationQueueRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	assert.NoError(t, repo.Delete(context.Background(), 1))
+	assert.True(t, query.Triggered)
+}
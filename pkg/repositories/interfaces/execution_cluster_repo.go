@@ -0,0 +1,26 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+// Defines the interface for interacting with the execution clusters registered in the database.
+type ExecutionClusterRepoInterface interface {
+	// Registers a cluster along with its label assignments in a single transaction. Fails if a cluster with the same
+	// name exists.
+	Create(ctx context.Context, input models.ExecutionCluster, labels []models.ExecutionClusterLabel) error
+	// Replaces the endpoint, credentials and state of the cluster with the same name.
+	Update(ctx context.Context, input models.ExecutionCluster) error
+	// Returns the cluster with the given name.
+	Get(ctx context.Context, name string) (models.ExecutionCluster, error)
+	// Returns all the registered clusters in the order they were registered.
+	List(ctx context.Context) ([]models.ExecutionCluster, error)
+	// Assigns the cluster to the label with the given weight, or updates the weight of an existing assignment.
+	SetLabel(ctx context.Context, input models.ExecutionClusterLabel) error
+	// Removes the cluster from the label, if it's assigned to it.
+	DeleteLabel(ctx context.Context, label, cluster string) error
+	// Returns all the label assignments in the order they were made.
+	ListLabels(ctx context.Context) ([]models.ExecutionClusterLabel, error)
+}
@@ -18,6 +18,8 @@ type ExecutionRepoInterface interface {
 	List(ctx context.Context, input ListResourceInput) (ExecutionCollectionOutput, error)
 	// Returns a matching execution if it exists.
 	Exists(ctx context.Context, input Identifier) (bool, error)
+	// Returns the number of executions in a non-terminal phase by the cluster they were assigned to.
+	CountNonTerminalByCluster(ctx context.Context) (map[string]int64, error)
 }
 
 // Response format for a query on workflows.
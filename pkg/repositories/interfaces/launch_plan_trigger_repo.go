@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+// Defines the interface for interacting with launch plan triggers.
+type LaunchPlanTriggerRepoInterface interface {
+	// Creates the trigger of a launch plan, or replaces the trigger of another version of the launch plan.
+	Set(ctx context.Context, input models.LaunchPlanTrigger) error
+	// Returns the trigger of the launch plan identified by project, domain and name.
+	Get(ctx context.Context, input Identifier) (models.LaunchPlanTrigger, error)
+	// Deletes the trigger of the identified launch plan version, if it has one.
+	Delete(ctx context.Context, input Identifier) error
+	// Returns the triggers fired by executions of the launch plan identified by project, domain and name.
+	ListByUpstream(ctx context.Context, input Identifier) ([]models.LaunchPlanTrigger, error)
+}
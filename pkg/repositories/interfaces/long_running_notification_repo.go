@@ -0,0 +1,40 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=LongRunningNotificationRepoInterface -output=../mocks -case=underscore
+
+// Defines the interface for interacting with the records of handled longRunning notification triggers.
+type LongRunningNotificationRepoInterface interface {
+	// Records that a trigger was handled for an execution. Recording the same trigger for the same execution twice
+	// returns an AlreadyExists error, so that only one caller publishes its notification.
+	Create(ctx context.Context, input models.LongRunningNotification) error
+	// Removes the record of a trigger, e.g. because publishing its notification failed.
+	Delete(ctx context.Context, input models.LongRunningNotification) error
+	// Returns the executions in the input phases for which the input trigger wasn't handled yet, ordered by their
+	// creation time and ID.
+	ListUnhandledExecutions(ctx context.Context, input ListUnhandledExecutionsInput) ([]models.Execution, error)
+}
+
+// The position of the last execution of the previous page, which the next page starts after.
+type ExecutionCursor struct {
+	CreatedAt time.Time
+	ID        uint
+}
+
+type ListUnhandledExecutionsInput struct {
+	TriggerKey    string
+	Phases        []string
+	CreatedBefore time.Time
+	// Optionally restricts the executions to a project and a domain.
+	Project string
+	Domain  string
+	// Unset for the first page.
+	After *ExecutionCursor
+	Limit int
+}
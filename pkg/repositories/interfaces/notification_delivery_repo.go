@@ -0,0 +1,27 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=NotificationDeliveryRepoInterface -output=../mocks -case=underscore
+
+// Defines the interface for interacting with the notification delivery log.
+type NotificationDeliveryRepoInterface interface {
+	// Inserts a notification delivery into the database store.
+	Create(ctx context.Context, input models.NotificationDelivery) error
+	// Returns a matching notification delivery.
+	Get(ctx context.Context, id uint) (models.NotificationDelivery, error)
+	// Returns the delivery with the given delivery ID, which is carried in the published notification message.
+	GetByDeliveryID(ctx context.Context, deliveryID string) (models.NotificationDelivery, error)
+	// Updates the status, attempts and last error of an existing delivery.
+	Update(ctx context.Context, input models.NotificationDelivery) error
+	// Returns notification deliveries matching the input filters, most recent first.
+	List(ctx context.Context, input ListResourceInput) (NotificationDeliveryCollectionOutput, error)
+}
+
+type NotificationDeliveryCollectionOutput struct {
+	Deliveries []models.NotificationDelivery
+}
@@ -0,0 +1,32 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=NotificationQueueRepoInterface -output=../mocks -case=underscore
+
+// Defines the interface for interacting with the database-backed notifications queue.
+type NotificationQueueRepoInterface interface {
+	// Inserts a notification into the queue.
+	Create(ctx context.Context, input models.NotificationQueueItem) error
+	// Claims up to input.Limit pending notifications which are visible at input.Now. Claimed items have their attempts
+	// incremented and stay hidden from other callers for input.VisibilityTimeout. Rows locked by a concurrent claim are
+	// skipped rather than waited on, so any number of processors may consume the same queue.
+	Claim(ctx context.Context, input ClaimNotificationsInput) ([]models.NotificationQueueItem, error)
+	// Updates the status and last error of a claimed notification.
+	UpdateStatus(ctx context.Context, input models.NotificationQueueItem) error
+	// Removes a delivered notification from the queue.
+	Delete(ctx context.Context, id uint) error
+}
+
+type ClaimNotificationsInput struct {
+	Now               time.Time
+	VisibilityTimeout time.Duration
+	Limit             int
+	// When set, only items published with one of these notification types are claimed.
+	NotificationTypes []string
+}
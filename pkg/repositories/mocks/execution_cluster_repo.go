@@ -0,0 +1,111 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"google.golang.org/grpc/codes"
+)
+
+type CreateExecutionClusterFunc func(ctx context.Context, input models.ExecutionCluster,
+	labels []models.ExecutionClusterLabel) error
+type UpdateExecutionClusterFunc func(ctx context.Context, input models.ExecutionCluster) error
+type GetExecutionClusterFunc func(ctx context.Context, name string) (models.ExecutionCluster, error)
+type ListExecutionClustersFunc func(ctx context.Context) ([]models.ExecutionCluster, error)
+type SetExecutionClusterLabelFunc func(ctx context.Context, input models.ExecutionClusterLabel) error
+type DeleteExecutionClusterLabelFunc func(ctx context.Context, label, cluster string) error
+type ListExecutionClusterLabelsFunc func(ctx context.Context) ([]models.ExecutionClusterLabel, error)
+
+type MockExecutionClusterRepo struct {
+	createFunction      CreateExecutionClusterFunc
+	updateFunction      UpdateExecutionClusterFunc
+	getFunction         GetExecutionClusterFunc
+	listFunction        ListExecutionClustersFunc
+	setLabelFunction    SetExecutionClusterLabelFunc
+	deleteLabelFunction DeleteExecutionClusterLabelFunc
+	listLabelsFunction  ListExecutionClusterLabelsFunc
+}
+
+func (r *MockExecutionClusterRepo) Create(ctx context.Context, input models.ExecutionCluster,
+	labels []models.ExecutionClusterLabel) error {
+	if r.createFunction != nil {
+		return r.createFunction(ctx, input, labels)
+	}
+	return nil
+}
+
+func (r *MockExecutionClusterRepo) SetCreateCallback(createFunction CreateExecutionClusterFunc) {
+	r.createFunction = createFunction
+}
+
+func (r *MockExecutionClusterRepo) Update(ctx context.Context, input models.ExecutionCluster) error {
+	if r.updateFunction != nil {
+		return r.updateFunction(ctx, input)
+	}
+	return nil
+}
+
+func (r *MockExecutionClusterRepo) SetUpdateCallback(updateFunction UpdateExecutionClusterFunc) {
+	r.updateFunction = updateFunction
+}
+
+func (r *MockExecutionClusterRepo) Get(ctx context.Context, name string) (models.ExecutionCluster, error) {
+	if r.getFunction != nil {
+		return r.getFunction(ctx, name)
+	}
+	return models.ExecutionCluster{}, errors.NewFlyteAdminErrorf(codes.NotFound, "execution cluster [%s] not found", name)
+}
+
+func (r *MockExecutionClusterRepo) SetGetCallback(getFunction GetExecutionClusterFunc) {
+	r.getFunction = getFunction
+}
+
+func (r *MockExecutionClusterRepo) List(ctx context.Context) ([]models.ExecutionCluster, error) {
+	if r.listFunction != nil {
+		return r.listFunction(ctx)
+	}
+	return nil, nil
+}
+
+func (r *MockExecutionClusterRepo) SetListCallback(listFunction ListExecutionClustersFunc) {
+	r.listFunction = listFunction
+}
+
+func (r *MockExecutionClusterRepo) SetLabel(ctx context.Context, input models.ExecutionClusterLabel) error {
+	if r.setLabelFunction != nil {
+		return r.setLabelFunction(ctx, input)
+	}
+	return nil
+}
+
+func (r *MockExecutionClusterRepo) SetSetLabelCallback(setLabelFunction SetExecutionClusterLabelFunc) {
+	r.setLabelFunction = setLabelFunction
+}
+
+func (r *MockExecutionClusterRepo) DeleteLabel(ctx context.Context, label, cluster string) error {
+	if r.deleteLabelFunction != nil {
+		return r.deleteLabelFunction(ctx, label, cluster)
+	}
+	return nil
+}
+
+func (r *MockExecutionClusterRepo) SetDeleteLabelCallback(deleteLabelFunction DeleteExecutionClusterLabelFunc) {
+	r.deleteLabelFunction = deleteLabelFunction
+}
+
+func (r *MockExecutionClusterRepo) ListLabels(ctx context.Context) ([]models.ExecutionClusterLabel, error) {
+	if r.listLabelsFunction != nil {
+		return r.listLabelsFunction(ctx)
+	}
+	return nil, nil
+}
+
+func (r *MockExecutionClusterRepo) SetListLabelsCallback(listLabelsFunction ListExecutionClusterLabelsFunc) {
+	r.listLabelsFunction = listLabelsFunction
+}
+
+func NewMockExecutionClusterRepo() interfaces.ExecutionClusterRepoInterface {
+	return &MockExecutionClusterRepo{}
+}
@@ -19,6 +19,8 @@ type MockExecutionRepo struct {
 	getFunction    GetExecutionFunc
 	listFunction   ListExecutionFunc
 	ExistsFunction func(ctx context.Context, input interfaces.Identifier) (bool, error)
+	// Defaults to no non-terminal executions.
+	CountNonTerminalByClusterFunction func(ctx context.Context) (map[string]int64, error)
 }
 
 func (r *MockExecutionRepo) Create(ctx context.Context, input models.Execution) error {
@@ -77,6 +79,13 @@ func (r *MockExecutionRepo) Exists(ctx context.Context, input interfaces.Identif
 	return true, nil
 }
 
+func (r *MockExecutionRepo) CountNonTerminalByCluster(ctx context.Context) (map[string]int64, error) {
+	if r.CountNonTerminalByClusterFunction != nil {
+		return r.CountNonTerminalByClusterFunction(ctx)
+	}
+	return map[string]int64{}, nil
+}
+
 func NewMockExecutionRepo() interfaces.ExecutionRepoInterface {
 	return &MockExecutionRepo{}
 }
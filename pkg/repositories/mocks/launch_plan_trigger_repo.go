@@ -0,0 +1,73 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"google.golang.org/grpc/codes"
+)
+
+type SetLaunchPlanTriggerFunc func(ctx context.Context, input models.LaunchPlanTrigger) error
+type GetLaunchPlanTriggerFunc func(ctx context.Context, input interfaces.Identifier) (models.LaunchPlanTrigger, error)
+type DeleteLaunchPlanTriggerFunc func(ctx context.Context, input interfaces.Identifier) error
+type ListLaunchPlanTriggersByUpstreamFunc func(ctx context.Context, input interfaces.Identifier) (
+	[]models.LaunchPlanTrigger, error)
+
+type MockLaunchPlanTriggerRepo struct {
+	setFunction            SetLaunchPlanTriggerFunc
+	getFunction            GetLaunchPlanTriggerFunc
+	deleteFunction         DeleteLaunchPlanTriggerFunc
+	listByUpstreamFunction ListLaunchPlanTriggersByUpstreamFunc
+}
+
+func (r *MockLaunchPlanTriggerRepo) Set(ctx context.Context, input models.LaunchPlanTrigger) error {
+	if r.setFunction != nil {
+		return r.setFunction(ctx, input)
+	}
+	return nil
+}
+
+func (r *MockLaunchPlanTriggerRepo) SetSetCallback(setFunction SetLaunchPlanTriggerFunc) {
+	r.setFunction = setFunction
+}
+
+func (r *MockLaunchPlanTriggerRepo) Get(ctx context.Context, input interfaces.Identifier) (
+	models.LaunchPlanTrigger, error) {
+	if r.getFunction != nil {
+		return r.getFunction(ctx, input)
+	}
+	return models.LaunchPlanTrigger{}, errors.NewFlyteAdminErrorf(codes.NotFound, "launch plan trigger not found")
+}
+
+func (r *MockLaunchPlanTriggerRepo) SetGetCallback(getFunction GetLaunchPlanTriggerFunc) {
+	r.getFunction = getFunction
+}
+
+func (r *MockLaunchPlanTriggerRepo) Delete(ctx context.Context, input interfaces.Identifier) error {
+	if r.deleteFunction != nil {
+		return r.deleteFunction(ctx, input)
+	}
+	return nil
+}
+
+func (r *MockLaunchPlanTriggerRepo) SetDeleteCallback(deleteFunction DeleteLaunchPlanTriggerFunc) {
+	r.deleteFunction = deleteFunction
+}
+
+func (r *MockLaunchPlanTriggerRepo) ListByUpstream(ctx context.Context, input interfaces.Identifier) (
+	[]models.LaunchPlanTrigger, error) {
+	if r.listByUpstreamFunction != nil {
+		return r.listByUpstreamFunction(ctx, input)
+	}
+	return nil, nil
+}
+
+func (r *MockLaunchPlanTriggerRepo) SetListByUpstreamCallback(listByUpstreamFunction ListLaunchPlanTriggersByUpstreamFunc) {
+	r.listByUpstreamFunction = listByUpstreamFunction
+}
+
+func NewMockLaunchPlanTriggerRepo() interfaces.LaunchPlanTriggerRepoInterface {
+	return &MockLaunchPlanTriggerRepo{}
+}
@@ -11,16 +11,27 @@ type MockRepository struct {
 	taskRepo                      interfaces.TaskRepoInterface
 	workflowRepo                  interfaces.WorkflowRepoInterface
 	launchPlanRepo                interfaces.LaunchPlanRepoInterface
+	launchPlanTriggerRepo         interfaces.LaunchPlanTriggerRepoInterface
 	executionRepo                 interfaces.ExecutionRepoInterface
 	ExecutionEventRepoIface       interfaces.ExecutionEventRepoInterface
+	executionClusterRepo          interfaces.ExecutionClusterRepoInterface
 	nodeExecutionRepo             interfaces.NodeExecutionRepoInterface
 	NodeExecutionEventRepoIface   interfaces.NodeExecutionEventRepoInterface
 	projectRepo                   interfaces.ProjectRepoInterface
 	resourceRepo                  interfaces.ResourceRepoInterface
 	taskExecutionRepo             interfaces.TaskExecutionRepoInterface
 	namedEntityRepo               interfaces.NamedEntityRepoInterface
+	NotificationQueueRepoIface    interfaces.NotificationQueueRepoInterface
+	NotificationDeliveryRepoIface interfaces.NotificationDeliveryRepoInterface
+	LongRunningNotificationIface  interfaces.LongRunningNotificationRepoInterface
 	schedulableEntityRepo         sIface.SchedulableEntityRepoInterface
 	schedulableEntitySnapshotRepo sIface.ScheduleEntitiesSnapShotRepoInterface
+	schedulerLeaseRepo            sIface.SchedulerLeaseRepoInterface
+	scheduleBackfillRepo          sIface.ScheduleBackfillRepoInterface
+	scheduleRunRepo               sIface.ScheduleRunRepoInterface
+	scheduleLastFireRepo          sIface.ScheduleLastFireRepoInterface
+	storageTriggerRepo            sIface.StorageTriggerRepoInterface
+	scheduleUpdateRepo            sIface.ScheduleUpdateRepoInterface
 }
 
 func (r *MockRepository) SchedulableEntityRepo() sIface.SchedulableEntityRepoInterface {
@@ -31,6 +42,30 @@ func (r *MockRepository) ScheduleEntitiesSnapshotRepo() sIface.ScheduleEntitiesS
 	return r.schedulableEntitySnapshotRepo
 }
 
+func (r *MockRepository) SchedulerLeaseRepo() sIface.SchedulerLeaseRepoInterface {
+	return r.schedulerLeaseRepo
+}
+
+func (r *MockRepository) ScheduleBackfillRepo() sIface.ScheduleBackfillRepoInterface {
+	return r.scheduleBackfillRepo
+}
+
+func (r *MockRepository) ScheduleRunRepo() sIface.ScheduleRunRepoInterface {
+	return r.scheduleRunRepo
+}
+
+func (r *MockRepository) ScheduleLastFireRepo() sIface.ScheduleLastFireRepoInterface {
+	return r.scheduleLastFireRepo
+}
+
+func (r *MockRepository) StorageTriggerRepo() sIface.StorageTriggerRepoInterface {
+	return r.storageTriggerRepo
+}
+
+func (r *MockRepository) ScheduleUpdateRepo() sIface.ScheduleUpdateRepoInterface {
+	return r.scheduleUpdateRepo
+}
+
 func (r *MockRepository) TaskRepo() interfaces.TaskRepoInterface {
 	return r.taskRepo
 }
@@ -43,6 +78,14 @@ func (r *MockRepository) LaunchPlanRepo() interfaces.LaunchPlanRepoInterface {
 	return r.launchPlanRepo
 }
 
+func (r *MockRepository) ExecutionClusterRepo() interfaces.ExecutionClusterRepoInterface {
+	return r.executionClusterRepo
+}
+
+func (r *MockRepository) LaunchPlanTriggerRepo() interfaces.LaunchPlanTriggerRepoInterface {
+	return r.launchPlanTriggerRepo
+}
+
 func (r *MockRepository) ExecutionRepo() interfaces.ExecutionRepoInterface {
 	return r.executionRepo
 }
@@ -75,12 +118,26 @@ func (r *MockRepository) NamedEntityRepo() interfaces.NamedEntityRepoInterface {
 	return r.namedEntityRepo
 }
 
+func (r *MockRepository) NotificationQueueRepo() interfaces.NotificationQueueRepoInterface {
+	return r.NotificationQueueRepoIface
+}
+
+func (r *MockRepository) NotificationDeliveryRepo() interfaces.NotificationDeliveryRepoInterface {
+	return r.NotificationDeliveryRepoIface
+}
+
+func (r *MockRepository) LongRunningNotificationRepo() interfaces.LongRunningNotificationRepoInterface {
+	return r.LongRunningNotificationIface
+}
+
 func NewMockRepository() repositories.RepositoryInterface {
 	return &MockRepository{
 		taskRepo:                      NewMockTaskRepo(),
 		workflowRepo:                  NewMockWorkflowRepo(),
 		launchPlanRepo:                NewMockLaunchPlanRepo(),
+		launchPlanTriggerRepo:         NewMockLaunchPlanTriggerRepo(),
 		executionRepo:                 NewMockExecutionRepo(),
+		executionClusterRepo:          NewMockExecutionClusterRepo(),
 		nodeExecutionRepo:             NewMockNodeExecutionRepo(),
 		projectRepo:                   NewMockProjectRepo(),
 		resourceRepo:                  NewMockResourceRepo(),
@@ -88,7 +145,16 @@ func NewMockRepository() repositories.RepositoryInterface {
 		namedEntityRepo:               NewMockNamedEntityRepo(),
 		ExecutionEventRepoIface:       &ExecutionEventRepoInterface{},
 		NodeExecutionEventRepoIface:   &NodeExecutionEventRepoInterface{},
+		NotificationQueueRepoIface:    &NotificationQueueRepoInterface{},
+		NotificationDeliveryRepoIface: &NotificationDeliveryRepoInterface{},
+		LongRunningNotificationIface:  &LongRunningNotificationRepoInterface{},
 		schedulableEntityRepo:         &sMocks.SchedulableEntityRepoInterface{},
 		schedulableEntitySnapshotRepo: &sMocks.ScheduleEntitiesSnapShotRepoInterface{},
+		schedulerLeaseRepo:            &sMocks.SchedulerLeaseRepoInterface{},
+		scheduleBackfillRepo:          &sMocks.ScheduleBackfillRepoInterface{},
+		scheduleRunRepo:               &sMocks.ScheduleRunRepoInterface{},
+		scheduleLastFireRepo:          &sMocks.ScheduleLastFireRepoInterface{},
+		storageTriggerRepo:            &sMocks.StorageTriggerRepoInterface{},
+		scheduleUpdateRepo:            &sMocks.ScheduleUpdateRepoInterface{},
 	}
 }
@@ -48,6 +48,8 @@ type Execution struct {
 	ParentNodeExecutionID uint
 	// Cluster where execution was triggered
 	Cluster string `valid:"length(0|255)"`
+	// Workflow executor the execution was routed to, empty for the executor executions are created with by default.
+	Executor string `valid:"length(0|255)"`
 	// Offloaded location of inputs LiteralMap. These are the inputs evaluated and contain applied defaults.
 	InputsURI storage.DataReference
 	// User specified inputs. This map might be incomplete and not include defaults applied
@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// An execution cluster registered through the admin API. The registered clusters replace the configured ones when the
+// cluster registry is stored in the database.
+type ExecutionCluster struct {
+	ID        uint `gorm:"AUTO_INCREMENT;column:id;primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Name      string `gorm:"unique_index" valid:"length(0|255)"`
+	Endpoint  string
+	// How admin authenticates to the cluster, and the paths at which the secrets holding its credentials are mounted.
+	AuthType  string `valid:"length(0|255)"`
+	TokenPath string
+	CertPath  string
+	Enabled   bool
+	Draining  bool
+	Capacity  int
+}
+
+// Assigns an execution cluster to an execution cluster label, weighted against the other clusters of the label.
+type ExecutionClusterLabel struct {
+	ID        uint `gorm:"AUTO_INCREMENT;column:id;primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Label     string `gorm:"unique_index:execution_cluster_labels_label_cluster_idx" valid:"length(0|255)"`
+	Cluster   string `gorm:"unique_index:execution_cluster_labels_label_cluster_idx" valid:"length(0|255)"`
+	Weight    float32
+}
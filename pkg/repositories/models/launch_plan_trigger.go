@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// Records the trigger declared by the active version of a launch plan, which creates an execution of that version
+// whenever an execution of the upstream launch plan reaches one of the trigger phases.
+type LaunchPlanTrigger struct {
+	ID        uint `gorm:"AUTO_INCREMENT;column:id;primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// The triggered launch plan. A launch plan has at most one trigger, that of its active version.
+	Project string `gorm:"unique_index:launch_plan_triggers_launch_plan_idx" valid:"length(0|255)"`
+	Domain  string `gorm:"unique_index:launch_plan_triggers_launch_plan_idx" valid:"length(0|255)"`
+	Name    string `gorm:"unique_index:launch_plan_triggers_launch_plan_idx" valid:"length(0|255)"`
+	Version string `valid:"length(0|255)"`
+	// The upstream launch plan, whose executions of any version fire the trigger.
+	UpstreamProject string `gorm:"index:launch_plan_triggers_upstream_idx" valid:"length(0|255)"`
+	UpstreamDomain  string `gorm:"index:launch_plan_triggers_upstream_idx" valid:"length(0|255)"`
+	UpstreamName    string `gorm:"index:launch_plan_triggers_upstream_idx" valid:"length(0|255)"`
+	// Comma separated terminal phases of the upstream executions which fire the trigger.
+	Phases string
+	// Comma separated input=output pairs binding upstream execution outputs to the inputs of the triggered execution.
+	Inputs string
+}
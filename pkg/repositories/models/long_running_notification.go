@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// Records that a longRunning notification trigger was handled for an execution, either because its notification was
+// published or because the trigger doesn't match the execution, so that the trigger isn't evaluated for the execution
+// again.
+type LongRunningNotification struct {
+	ExecutionKey
+	// Identifies the trigger among the configured and launch plan triggers.
+	TriggerKey string `gorm:"primary_key" valid:"length(0|255)"`
+	CreatedAt  time.Time
+}
@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+type NotificationDeliveryStatus = string
+
+const (
+	// Published and waiting to be processed, either for the first time or after a re-drive.
+	NotificationDeliveryStatusPending   NotificationDeliveryStatus = "PENDING"
+	NotificationDeliveryStatusDelivered NotificationDeliveryStatus = "DELIVERED"
+	// The last delivery attempt failed and the notification will be retried.
+	NotificationDeliveryStatusFailed NotificationDeliveryStatus = "FAILED"
+	// The notification could not be published or delivered within the maximum number of attempts. Dead-lettered
+	// notifications are only retried when explicitly re-driven.
+	NotificationDeliveryStatusDeadLetter NotificationDeliveryStatus = "DEAD_LETTER"
+)
+
+// Records the delivery of a single notification: when it was published and the outcome of every attempt to deliver it.
+type NotificationDelivery struct {
+	ID        uint `gorm:"AUTO_INCREMENT;column:id;primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// The execution the notification was sent for. Deliveries of notifications published without an execution in
+	// context leave these empty.
+	ExecutionKey
+	// The delivery mechanism, either email or webhook.
+	Channel string `valid:"length(0|255)"`
+	// Comma separated list of recipients.
+	Recipients string
+	// Serialized notification message, e.g. flyteidl.admin.EmailMessage.
+	Message []byte
+	// Generated when the notification is published and carried in the message, used by processors to find the delivery
+	// of a consumed message.
+	DeliveryID string                     `gorm:"index:notification_deliveries_delivery_id_idx" valid:"length(0|255)"`
+	Status     NotificationDeliveryStatus `gorm:"index:notification_deliveries_status_idx" valid:"length(0|255)"`
+	Attempts   uint32
+	LastError  string
+}
@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+type NotificationQueueItemStatus = string
+
+const (
+	// Waiting to be claimed by a notifications processor, either for the first time or for a retry.
+	NotificationQueueItemStatusPending NotificationQueueItemStatus = "PENDING"
+	// Delivery was attempted the maximum number of times, or the message could not be decoded. Dead-lettered items are
+	// never claimed again.
+	NotificationQueueItemStatusDeadLetter NotificationQueueItemStatus = "DEAD_LETTER"
+)
+
+// Represents a notification enqueued by the database-backed notifications publisher.
+// Items are deleted once delivered.
+type NotificationQueueItem struct {
+	ID        uint `gorm:"AUTO_INCREMENT;column:id;primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// The key the notification was published with, e.g. flyteidl.admin.EmailNotification.
+	NotificationType string `valid:"length(0|255)"`
+	// Serialized notification message, e.g. flyteidl.admin.EmailMessage.
+	Message []byte
+	Status  NotificationQueueItemStatus `gorm:"index:notification_queue_status_visible_at_idx" valid:"length(0|255)"`
+	// Claimed items are hidden from other processors until this time has passed.
+	VisibleAt time.Time `gorm:"index:notification_queue_status_visible_at_idx"`
+	// The number of times the item was claimed for delivery.
+	Attempts  uint32
+	LastError string
+}
+
+func (NotificationQueueItem) TableName() string {
+	return "notification_queue"
+}
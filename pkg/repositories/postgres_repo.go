@@ -13,8 +13,10 @@ import (
 type PostgresRepo struct {
 	executionRepo                interfaces.ExecutionRepoInterface
 	executionEventRepo           interfaces.ExecutionEventRepoInterface
+	executionClusterRepo         interfaces.ExecutionClusterRepoInterface
 	namedEntityRepo              interfaces.NamedEntityRepoInterface
 	launchPlanRepo               interfaces.LaunchPlanRepoInterface
+	launchPlanTriggerRepo        interfaces.LaunchPlanTriggerRepoInterface
 	projectRepo                  interfaces.ProjectRepoInterface
 	nodeExecutionRepo            interfaces.NodeExecutionRepoInterface
 	nodeExecutionEventRepo       interfaces.NodeExecutionEventRepoInterface
@@ -22,8 +24,17 @@ type PostgresRepo struct {
 	taskExecutionRepo            interfaces.TaskExecutionRepoInterface
 	workflowRepo                 interfaces.WorkflowRepoInterface
 	resourceRepo                 interfaces.ResourceRepoInterface
+	notificationQueueRepo        interfaces.NotificationQueueRepoInterface
+	notificationDeliveryRepo     interfaces.NotificationDeliveryRepoInterface
+	longRunningNotificationRepo  interfaces.LongRunningNotificationRepoInterface
 	schedulableEntityRepo        schedulerInterfaces.SchedulableEntityRepoInterface
 	scheduleEntitiesSnapshotRepo schedulerInterfaces.ScheduleEntitiesSnapShotRepoInterface
+	schedulerLeaseRepo           schedulerInterfaces.SchedulerLeaseRepoInterface
+	scheduleBackfillRepo         schedulerInterfaces.ScheduleBackfillRepoInterface
+	scheduleRunRepo              schedulerInterfaces.ScheduleRunRepoInterface
+	scheduleLastFireRepo         schedulerInterfaces.ScheduleLastFireRepoInterface
+	storageTriggerRepo           schedulerInterfaces.StorageTriggerRepoInterface
+	scheduleUpdateRepo           schedulerInterfaces.ScheduleUpdateRepoInterface
 }
 
 func (p *PostgresRepo) ExecutionRepo() interfaces.ExecutionRepoInterface {
@@ -38,6 +49,14 @@ func (p *PostgresRepo) LaunchPlanRepo() interfaces.LaunchPlanRepoInterface {
 	return p.launchPlanRepo
 }
 
+func (p *PostgresRepo) ExecutionClusterRepo() interfaces.ExecutionClusterRepoInterface {
+	return p.executionClusterRepo
+}
+
+func (p *PostgresRepo) LaunchPlanTriggerRepo() interfaces.LaunchPlanTriggerRepoInterface {
+	return p.launchPlanTriggerRepo
+}
+
 func (p *PostgresRepo) NamedEntityRepo() interfaces.NamedEntityRepoInterface {
 	return p.namedEntityRepo
 }
@@ -70,6 +89,18 @@ func (p *PostgresRepo) ResourceRepo() interfaces.ResourceRepoInterface {
 	return p.resourceRepo
 }
 
+func (p *PostgresRepo) NotificationQueueRepo() interfaces.NotificationQueueRepoInterface {
+	return p.notificationQueueRepo
+}
+
+func (p *PostgresRepo) NotificationDeliveryRepo() interfaces.NotificationDeliveryRepoInterface {
+	return p.notificationDeliveryRepo
+}
+
+func (p *PostgresRepo) LongRunningNotificationRepo() interfaces.LongRunningNotificationRepoInterface {
+	return p.longRunningNotificationRepo
+}
+
 func (p *PostgresRepo) SchedulableEntityRepo() schedulerInterfaces.SchedulableEntityRepoInterface {
 	return p.schedulableEntityRepo
 }
@@ -78,11 +109,37 @@ func (p *PostgresRepo) ScheduleEntitiesSnapshotRepo() schedulerInterfaces.Schedu
 	return p.scheduleEntitiesSnapshotRepo
 }
 
+func (p *PostgresRepo) SchedulerLeaseRepo() schedulerInterfaces.SchedulerLeaseRepoInterface {
+	return p.schedulerLeaseRepo
+}
+
+func (p *PostgresRepo) ScheduleBackfillRepo() schedulerInterfaces.ScheduleBackfillRepoInterface {
+	return p.scheduleBackfillRepo
+}
+
+func (p *PostgresRepo) ScheduleRunRepo() schedulerInterfaces.ScheduleRunRepoInterface {
+	return p.scheduleRunRepo
+}
+
+func (p *PostgresRepo) ScheduleLastFireRepo() schedulerInterfaces.ScheduleLastFireRepoInterface {
+	return p.scheduleLastFireRepo
+}
+
+func (p *PostgresRepo) StorageTriggerRepo() schedulerInterfaces.StorageTriggerRepoInterface {
+	return p.storageTriggerRepo
+}
+
+func (p *PostgresRepo) ScheduleUpdateRepo() schedulerInterfaces.ScheduleUpdateRepoInterface {
+	return p.scheduleUpdateRepo
+}
+
 func NewPostgresRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) RepositoryInterface {
 	return &PostgresRepo{
 		executionRepo:                gormimpl.NewExecutionRepo(db, errorTransformer, scope.NewSubScope("executions")),
 		executionEventRepo:           gormimpl.NewExecutionEventRepo(db, errorTransformer, scope.NewSubScope("execution_events")),
+		executionClusterRepo:         gormimpl.NewExecutionClusterRepo(db, errorTransformer, scope.NewSubScope("execution_clusters")),
 		launchPlanRepo:               gormimpl.NewLaunchPlanRepo(db, errorTransformer, scope.NewSubScope("launch_plans")),
+		launchPlanTriggerRepo:        gormimpl.NewLaunchPlanTriggerRepo(db, errorTransformer, scope.NewSubScope("launch_plan_triggers")),
 		projectRepo:                  gormimpl.NewProjectRepo(db, errorTransformer, scope.NewSubScope("project")),
 		namedEntityRepo:              gormimpl.NewNamedEntityRepo(db, errorTransformer, scope.NewSubScope("named_entity")),
 		nodeExecutionRepo:            gormimpl.NewNodeExecutionRepo(db, errorTransformer, scope.NewSubScope("node_executions")),
@@ -91,7 +148,16 @@ func NewPostgresRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer, scop
 		taskExecutionRepo:            gormimpl.NewTaskExecutionRepo(db, errorTransformer, scope.NewSubScope("task_executions")),
 		workflowRepo:                 gormimpl.NewWorkflowRepo(db, errorTransformer, scope.NewSubScope("workflows")),
 		resourceRepo:                 gormimpl.NewResourceRepo(db, errorTransformer, scope.NewSubScope("resources")),
+		notificationQueueRepo:        gormimpl.NewNotificationQueueRepo(db, errorTransformer, scope.NewSubScope("notification_queue")),
+		notificationDeliveryRepo:     gormimpl.NewNotificationDeliveryRepo(db, errorTransformer, scope.NewSubScope("notification_deliveries")),
+		longRunningNotificationRepo:  gormimpl.NewLongRunningNotificationRepo(db, errorTransformer, scope.NewSubScope("long_running_notifications")),
 		schedulableEntityRepo:        schedulerGormImpl.NewSchedulableEntityRepo(db, errorTransformer, scope.NewSubScope("schedulable_entity")),
 		scheduleEntitiesSnapshotRepo: schedulerGormImpl.NewScheduleEntitiesSnapshotRepo(db, errorTransformer, scope.NewSubScope("schedule_entities_snapshot")),
+		schedulerLeaseRepo:           schedulerGormImpl.NewSchedulerLeaseRepo(db, errorTransformer, scope.NewSubScope("scheduler_lease")),
+		scheduleBackfillRepo:         schedulerGormImpl.NewScheduleBackfillRepo(db, errorTransformer, scope.NewSubScope("schedule_backfill")),
+		scheduleRunRepo:              schedulerGormImpl.NewScheduleRunRepo(db, errorTransformer, scope.NewSubScope("schedule_run")),
+		scheduleLastFireRepo:         schedulerGormImpl.NewScheduleLastFireRepo(db, errorTransformer, scope.NewSubScope("schedule_last_fire")),
+		storageTriggerRepo:           schedulerGormImpl.NewStorageTriggerRepo(db, errorTransformer, scope.NewSubScope("storage_trigger")),
+		scheduleUpdateRepo:           schedulerGormImpl.NewScheduleUpdateRepo(db, errorTransformer, scope.NewSubScope("schedule_update")),
 	}
 }
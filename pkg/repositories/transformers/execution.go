@@ -33,6 +33,7 @@ type CreateExecutionModelInput struct {
 	ParentNodeExecutionID uint
 	SourceExecutionID     uint
 	Cluster               string
+	Executor              string
 	InputsURI             storage.DataReference
 	UserInputsURI         storage.DataReference
 }
@@ -86,6 +87,7 @@ func CreateExecutionModel(input CreateExecutionModelInput) (*models.Execution, e
 		ParentNodeExecutionID: input.ParentNodeExecutionID,
 		SourceExecutionID:     input.SourceExecutionID,
 		Cluster:               input.Cluster,
+		Executor:              input.Executor,
 		InputsURI:             input.InputsURI,
 		UserInputsURI:         input.UserInputsURI,
 		User:                  requestSpec.Metadata.Principal,
@@ -15,6 +15,7 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/async/schedule"
 	"github.com/flyteorg/flyteadmin/pkg/data"
 	executionCluster "github.com/flyteorg/flyteadmin/pkg/executioncluster/impl"
+	executionClusterInterfaces "github.com/flyteorg/flyteadmin/pkg/executioncluster/interfaces"
 	manager "github.com/flyteorg/flyteadmin/pkg/manager/impl"
 	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories"
@@ -41,7 +42,17 @@ type AdminService struct {
 	ResourceManager      interfaces.ResourceInterface
 	NamedEntityManager   interfaces.NamedEntityInterface
 	VersionManager       interfaces.VersionInterface
-	Metrics              AdminMetrics
+	// Execution clusters are served over HTTP only, see RegisterHTTPHandlers.
+	ExecutionClusterManager interfaces.ExecutionClusterInterface
+	// Notification deliveries are served over HTTP only, see RegisterHTTPHandlers.
+	NotificationDeliveryManager interfaces.NotificationDeliveryInterface
+	// Schedule backfills are served over HTTP only, see RegisterHTTPHandlers.
+	ScheduleBackfillManager interfaces.ScheduleBackfillInterface
+	// Schedule previews are served over HTTP only, see RegisterHTTPHandlers.
+	SchedulePreviewManager interfaces.SchedulePreviewInterface
+	// Schedule runs are served over HTTP only, see RegisterHTTPHandlers.
+	ScheduleRunManager interfaces.ScheduleRunInterface
+	Metrics            AdminMetrics
 }
 
 // Intercepts all admin requests to handle panics during execution.
@@ -87,17 +98,26 @@ func NewAdminServer(kubeConfig, master string) *AdminService {
 	db := repositories.GetRepository(
 		repositories.POSTGRES, dbConfig, adminScope.NewSubScope("database"))
 	storeConfig := storage.GetConfig()
-	execCluster := executionCluster.GetExecutionCluster(
-		adminScope.NewSubScope("executor").NewSubScope("cluster"),
-		kubeConfig,
-		master,
-		configuration,
-		db)
 	workflowBuilder := workflowengineImpl.NewFlyteWorkflowBuilder(
 		adminScope.NewSubScope("builder").NewSubScope("flytepropeller"))
-	workflowExecutor := workflowengineImpl.NewK8sWorkflowExecutor(execCluster, workflowBuilder)
-	logger.Info(context.Background(), "Successfully created a workflow executor engine")
-	workflowengine.GetRegistry().RegisterDefault(workflowExecutor)
+	// Without Kubernetes, executions aren't created in any cluster, so none need to be reachable.
+	recordingExecutorConfig := applicationConfiguration.GetRecordingExecutorConfig()
+	withoutKubernetes := recordingExecutorConfig.Enabled && recordingExecutorConfig.WithoutKubernetes
+	var execCluster executionClusterInterfaces.ClusterInterface
+	if withoutKubernetes {
+		execCluster = executionCluster.NewNoCluster()
+	} else {
+		execCluster = executionCluster.GetExecutionCluster(
+			adminScope.NewSubScope("executor").NewSubScope("cluster"),
+			kubeConfig,
+			master,
+			configuration,
+			db)
+		workflowExecutor := workflowengineImpl.NewK8sWorkflowExecutor(execCluster, workflowBuilder,
+			configuration.ClusterConfiguration().GetFailoverConfig(), adminScope.NewSubScope("executor"))
+		logger.Info(context.Background(), "Successfully created a workflow executor engine")
+		workflowengine.GetRegistry().RegisterDefault(workflowExecutor)
+	}
 
 	dataStorageClient, err := storage.NewDataStore(storeConfig, adminScope.NewSubScope("storage"))
 	if err != nil {
@@ -105,13 +125,19 @@ func NewAdminServer(kubeConfig, master string) *AdminService {
 		panic(err)
 	}
 
-	publisher := notifications.NewNotificationsPublisher(*configuration.ApplicationConfiguration().GetNotificationsConfig(), adminScope)
-	processor := notifications.NewNotificationsProcessor(*configuration.ApplicationConfiguration().GetNotificationsConfig(), adminScope)
+	notificationsPublisher := notifications.NewNotificationsPublisher(*configuration.ApplicationConfiguration().GetNotificationsConfig(), db, adminScope)
+	publisher := notifications.NewDeliveryLogPublisher(*configuration.ApplicationConfiguration().GetNotificationsConfig(), db,
+		notificationsPublisher, adminScope)
+	processor := notifications.NewNotificationsProcessor(*configuration.ApplicationConfiguration().GetNotificationsConfig(), db, adminScope)
 	eventPublisher := notifications.NewEventsPublisher(*configuration.ApplicationConfiguration().GetExternalEventsConfig(), adminScope)
 	go func() {
 		logger.Info(context.Background(), "Started processing notifications.")
 		processor.StartProcessing()
 	}()
+	longRunningChecker := notifications.NewLongRunningChecker(db, configuration.ApplicationConfiguration(), publisher, adminScope)
+	go func() {
+		longRunningChecker.StartProcessing()
+	}()
 
 	// Configure workflow scheduler async processes.
 	schedulerConfig := configuration.ApplicationConfiguration().GetSchedulerConfig()
@@ -149,6 +175,23 @@ func NewAdminServer(kubeConfig, master string) *AdminService {
 	executionManager := manager.NewExecutionManager(db, configuration, dataStorageClient,
 		adminScope.NewSubScope("execution_manager"), adminScope.NewSubScope("user_execution_metrics"),
 		publisher, urlData, workflowManager, namedEntityManager, eventPublisher, executionEventWriter)
+	// The recording executor reports the simulated phases of executions to the execution manager, so it's registered
+	// once the manager exists. It records every execution without Kubernetes, and otherwise only the executions routed
+	// to it by the workflow executor plugin override.
+	if withoutKubernetes {
+		logger.Infof(context.Background(), "Recording executions rather than creating them in Kubernetes")
+		workflowengine.GetRegistry().RegisterDefault(workflowengineImpl.NewRecordingWorkflowExecutor(
+			workflowBuilder, recordingExecutorConfig, executionManager))
+	} else if recordingExecutorConfig.Enabled {
+		logger.Infof(context.Background(), "Registering the selectable recording workflow executor")
+		workflowengine.GetRegistry().RegisterSelectable(workflowengineImpl.NewRecordingWorkflowExecutor(
+			workflowBuilder, recordingExecutorConfig, executionManager))
+	}
+	launchPlanTriggerProcessor := manager.NewLaunchPlanTriggerProcessor(db, executionManager, dataStorageClient,
+		applicationConfiguration.GetLaunchPlanTriggerQueueConfig(), adminScope)
+	go func() {
+		launchPlanTriggerProcessor.StartProcessing()
+	}()
 	versionManager := manager.NewVersionManager()
 
 	scheduledWorkflowExecutor := workflowScheduler.GetWorkflowExecutor(executionManager, launchPlanManager)
@@ -182,11 +225,16 @@ func NewAdminServer(kubeConfig, master string) *AdminService {
 		NamedEntityManager: namedEntityManager,
 		VersionManager:     versionManager,
 		NodeExecutionManager: manager.NewNodeExecutionManager(db, configuration, applicationConfiguration.GetMetadataStoragePrefix(), dataStorageClient,
-			adminScope.NewSubScope("node_execution_manager"), urlData, eventPublisher, nodeExecutionEventWriter),
+			adminScope.NewSubScope("node_execution_manager"), urlData, eventPublisher, nodeExecutionEventWriter, publisher),
 		TaskExecutionManager: manager.NewTaskExecutionManager(db, configuration, dataStorageClient,
-			adminScope.NewSubScope("task_execution_manager"), urlData, eventPublisher),
-		ProjectManager:  manager.NewProjectManager(db, configuration),
-		ResourceManager: resources.NewResourceManager(db, configuration.ApplicationConfiguration()),
-		Metrics:         InitMetrics(adminScope),
+			adminScope.NewSubScope("task_execution_manager"), urlData, eventPublisher, publisher),
+		ProjectManager:              manager.NewProjectManager(db, configuration),
+		ResourceManager:             resources.NewResourceManager(db, configuration.ApplicationConfiguration()),
+		ExecutionClusterManager:     manager.NewExecutionClusterManager(execCluster, db, configuration),
+		NotificationDeliveryManager: manager.NewNotificationDeliveryManager(db, notificationsPublisher),
+		ScheduleBackfillManager:     manager.NewScheduleBackfillManager(db, configuration),
+		SchedulePreviewManager:      manager.NewSchedulePreviewManager(db, configuration),
+		ScheduleRunManager:          manager.NewScheduleRunManager(db),
+		Metrics:                     InitMetrics(adminScope),
 	}
 }
@@ -0,0 +1,240 @@
+package adminservice
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/audit"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/rpc/adminservice/util"
+	"google.golang.org/grpc/codes"
+)
+
+// Serves
+//
+//	GET    /api/v1/execution_clusters
+//	POST   /api/v1/execution_clusters
+//	GET    /api/v1/execution_clusters/{name}
+//	GET    /api/v1/execution_clusters/{name}/drain
+//	PATCH  /api/v1/execution_clusters/{name}
+//	PUT    /api/v1/execution_clusters/{name}/labels/{label}
+//	DELETE /api/v1/execution_clusters/{name}/labels/{label}
+//
+// with the health of the clusters executions are assigned to, and the registration of clusters stored in the database.
+const executionClustersPath = httpAPIPrefix + "execution_clusters"
+
+const labelsSegment = "labels"
+const drainSegment = "drain"
+
+func (m *AdminService) handleExecutionClusters(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	path := strings.Trim(strings.TrimPrefix(request.URL.Path, executionClustersPath), "/")
+	var segments []string
+	if len(path) > 0 {
+		segments = strings.Split(path, "/")
+	}
+	switch {
+	case len(segments) == 0 && request.Method == http.MethodGet:
+		m.listExecutionClusters(ctx, writer)
+	case len(segments) == 0 && request.Method == http.MethodPost:
+		m.registerExecutionCluster(ctx, writer, request)
+	case len(segments) == 1 && request.Method == http.MethodGet:
+		m.getExecutionClusterRegistration(ctx, writer, segments[0])
+	case len(segments) == 1 && request.Method == http.MethodPatch:
+		m.updateExecutionCluster(ctx, writer, request, segments[0])
+	case len(segments) == 2 && segments[1] == drainSegment && request.Method == http.MethodGet:
+		m.getExecutionClusterDrainStatus(ctx, writer, segments[0])
+	case len(segments) == 3 && segments[1] == labelsSegment && request.Method == http.MethodPut:
+		m.setExecutionClusterLabel(ctx, writer, request, segments[0], segments[2])
+	case len(segments) == 3 && segments[1] == labelsSegment && request.Method == http.MethodDelete:
+		m.removeExecutionClusterLabel(ctx, writer, segments[0], segments[2])
+	default:
+		writeHTTPError(ctx, writer, errors.NewFlyteAdminErrorf(codes.NotFound,
+			"no execution clusters endpoint matches %s %s", request.Method, request.URL.Path))
+	}
+}
+
+func (m *AdminService) listExecutionClusters(ctx context.Context, writer http.ResponseWriter) {
+	requestedAt := time.Now()
+	var response *interfaces.ExecutionClusterList
+	var err error
+	m.Metrics.executionClusterEndpointMetrics.list.Time(func() {
+		response, err = m.ExecutionClusterManager.ListExecutionClusters(ctx)
+	})
+	audit.NewLogBuilder().WithAuthenticatedCtx(ctx).WithRequest(
+		"ListExecutionClusters",
+		map[string]string{},
+		audit.ReadOnly,
+		requestedAt,
+	).WithResponse(time.Now(), err).Log(ctx)
+	if err != nil {
+		writeHTTPError(ctx, writer, util.TransformAndRecordError(err, &m.Metrics.executionClusterEndpointMetrics.list))
+		return
+	}
+	m.Metrics.executionClusterEndpointMetrics.list.Success()
+	writeHTTPResponse(ctx, writer, response)
+}
+
+func (m *AdminService) registerExecutionCluster(
+	ctx context.Context, writer http.ResponseWriter, request *http.Request) {
+	requestedAt := time.Now()
+	var registration interfaces.ExecutionClusterRegistration
+	var response *interfaces.ExecutionClusterRegistration
+	err := json.NewDecoder(request.Body).Decode(&registration)
+	if err != nil {
+		err = errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid execution cluster registration: %v", err)
+	} else {
+		m.Metrics.executionClusterEndpointMetrics.register.Time(func() {
+			response, err = m.ExecutionClusterManager.RegisterExecutionCluster(ctx, registration)
+		})
+	}
+	audit.NewLogBuilder().WithAuthenticatedCtx(ctx).WithRequest(
+		"RegisterExecutionCluster",
+		map[string]string{
+			audit.Name: registration.Name,
+		},
+		audit.ReadWrite,
+		requestedAt,
+	).WithResponse(time.Now(), err).Log(ctx)
+	if err != nil {
+		writeHTTPError(ctx, writer, util.TransformAndRecordError(err, &m.Metrics.executionClusterEndpointMetrics.register))
+		return
+	}
+	m.Metrics.executionClusterEndpointMetrics.register.Success()
+	writeHTTPResponse(ctx, writer, response)
+}
+
+func (m *AdminService) getExecutionClusterRegistration(ctx context.Context, writer http.ResponseWriter, name string) {
+	requestedAt := time.Now()
+	var response *interfaces.ExecutionClusterRegistration
+	var err error
+	m.Metrics.executionClusterEndpointMetrics.get.Time(func() {
+		response, err = m.ExecutionClusterManager.GetExecutionClusterRegistration(ctx, name)
+	})
+	audit.NewLogBuilder().WithAuthenticatedCtx(ctx).WithRequest(
+		"GetExecutionClusterRegistration",
+		map[string]string{
+			audit.Name: name,
+		},
+		audit.ReadOnly,
+		requestedAt,
+	).WithResponse(time.Now(), err).Log(ctx)
+	if err != nil {
+		writeHTTPError(ctx, writer, util.TransformAndRecordError(err, &m.Metrics.executionClusterEndpointMetrics.get))
+		return
+	}
+	m.Metrics.executionClusterEndpointMetrics.get.Success()
+	writeHTTPResponse(ctx, writer, response)
+}
+
+func (m *AdminService) updateExecutionCluster(
+	ctx context.Context, writer http.ResponseWriter, request *http.Request, name string) {
+	requestedAt := time.Now()
+	var updateRequest interfaces.ExecutionClusterUpdateRequest
+	var response *interfaces.ExecutionClusterRegistration
+	err := json.NewDecoder(request.Body).Decode(&updateRequest)
+	if err != nil {
+		err = errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid execution cluster update: %v", err)
+	} else {
+		updateRequest.Name = name
+		m.Metrics.executionClusterEndpointMetrics.update.Time(func() {
+			response, err = m.ExecutionClusterManager.UpdateExecutionCluster(ctx, updateRequest)
+		})
+	}
+	audit.NewLogBuilder().WithAuthenticatedCtx(ctx).WithRequest(
+		"UpdateExecutionCluster",
+		map[string]string{
+			audit.Name: name,
+		},
+		audit.ReadWrite,
+		requestedAt,
+	).WithResponse(time.Now(), err).Log(ctx)
+	if err != nil {
+		writeHTTPError(ctx, writer, util.TransformAndRecordError(err, &m.Metrics.executionClusterEndpointMetrics.update))
+		return
+	}
+	m.Metrics.executionClusterEndpointMetrics.update.Success()
+	writeHTTPResponse(ctx, writer, response)
+}
+
+func (m *AdminService) setExecutionClusterLabel(
+	ctx context.Context, writer http.ResponseWriter, request *http.Request, name, label string) {
+	requestedAt := time.Now()
+	var labelRequest interfaces.ExecutionClusterLabelRequest
+	var response *interfaces.ExecutionClusterRegistration
+	err := json.NewDecoder(request.Body).Decode(&labelRequest)
+	if err != nil {
+		err = errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid execution cluster label request: %v", err)
+	} else {
+		labelRequest.Cluster = name
+		labelRequest.Label = label
+		m.Metrics.executionClusterEndpointMetrics.setLabel.Time(func() {
+			response, err = m.ExecutionClusterManager.SetExecutionClusterLabel(ctx, labelRequest)
+		})
+	}
+	audit.NewLogBuilder().WithAuthenticatedCtx(ctx).WithRequest(
+		"SetExecutionClusterLabel",
+		map[string]string{
+			audit.Name: name,
+		},
+		audit.ReadWrite,
+		requestedAt,
+	).WithResponse(time.Now(), err).Log(ctx)
+	if err != nil {
+		writeHTTPError(ctx, writer, util.TransformAndRecordError(err, &m.Metrics.executionClusterEndpointMetrics.setLabel))
+		return
+	}
+	m.Metrics.executionClusterEndpointMetrics.setLabel.Success()
+	writeHTTPResponse(ctx, writer, response)
+}
+
+func (m *AdminService) removeExecutionClusterLabel(
+	ctx context.Context, writer http.ResponseWriter, name, label string) {
+	requestedAt := time.Now()
+	var response *interfaces.ExecutionClusterRegistration
+	var err error
+	m.Metrics.executionClusterEndpointMetrics.removeLabel.Time(func() {
+		response, err = m.ExecutionClusterManager.RemoveExecutionClusterLabel(ctx, name, label)
+	})
+	audit.NewLogBuilder().WithAuthenticatedCtx(ctx).WithRequest(
+		"RemoveExecutionClusterLabel",
+		map[string]string{
+			audit.Name: name,
+		},
+		audit.ReadWrite,
+		requestedAt,
+	).WithResponse(time.Now(), err).Log(ctx)
+	if err != nil {
+		writeHTTPError(ctx, writer, util.TransformAndRecordError(err, &m.Metrics.executionClusterEndpointMetrics.removeLabel))
+		return
+	}
+	m.Metrics.executionClusterEndpointMetrics.removeLabel.Success()
+	writeHTTPResponse(ctx, writer, response)
+}
+
+func (m *AdminService) getExecutionClusterDrainStatus(ctx context.Context, writer http.ResponseWriter, name string) {
+	requestedAt := time.Now()
+	var response *interfaces.ExecutionClusterDrainStatus
+	var err error
+	m.Metrics.executionClusterEndpointMetrics.getDrainStatus.Time(func() {
+		response, err = m.ExecutionClusterManager.GetExecutionClusterDrainStatus(ctx, name)
+	})
+	audit.NewLogBuilder().WithAuthenticatedCtx(ctx).WithRequest(
+		"GetExecutionClusterDrainStatus",
+		map[string]string{
+			audit.Name: name,
+		},
+		audit.ReadOnly,
+		requestedAt,
+	).WithResponse(time.Now(), err).Log(ctx)
+	if err != nil {
+		writeHTTPError(ctx, writer, util.TransformAndRecordError(err, &m.Metrics.executionClusterEndpointMetrics.getDrainStatus))
+		return
+	}
+	m.Metrics.executionClusterEndpointMetrics.getDrainStatus.Success()
+	writeHTTPResponse(ctx, writer, response)
+}
@@ -0,0 +1,58 @@
+package adminservice
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	authInterfaces "github.com/flyteorg/flyteadmin/auth/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+)
+
+// Endpoints without a counterpart in the flyteidl AdminService definition are served as JSON over plain HTTP, next to
+// the gRPC gateway. Errors use the same status codes and body as the gateway.
+const httpAPIPrefix = "/api/v1/"
+
+type httpErrorBody struct {
+	Error   string `json:"error"`
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+// RegisterHTTPHandlers adds the admin endpoints which are served outside of the gRPC gateway.
+func (m *AdminService) RegisterHTTPHandlers(handler authInterfaces.HandlerRegisterer) {
+	handler.HandleFunc(executionClustersPath, m.handleExecutionClusters)
+	handler.HandleFunc(executionClustersPath+"/", m.handleExecutionClusters)
+	handler.HandleFunc(notificationDeliveriesPath, m.handleNotificationDeliveries)
+	handler.HandleFunc(scheduleBackfillsPath, m.handleScheduleBackfills)
+	handler.HandleFunc(schedulePreviewsPath, m.handleSchedulePreviews)
+	handler.HandleFunc(scheduleRunsPath, m.handleScheduleRuns)
+}
+
+func writeHTTPResponse(ctx context.Context, writer http.ResponseWriter, response interface{}) {
+	writer.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		logger.Errorf(ctx, "failed to write response [%+v] with err: %v", response, err)
+	}
+}
+
+// Expects errors which were already transformed to a FlyteAdminError, e.g. by util.TransformAndRecordError.
+func writeHTTPError(ctx context.Context, writer http.ResponseWriter, err error) {
+	code := codes.Internal
+	if adminErr, ok := err.(errors.FlyteAdminError); ok {
+		code = adminErr.Code()
+	}
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(runtime.HTTPStatusFromCode(code))
+	body := httpErrorBody{
+		Error:   err.Error(),
+		Code:    int32(code),
+		Message: err.Error(),
+	}
+	if encodeErr := json.NewEncoder(writer).Encode(body); encodeErr != nil {
+		logger.Errorf(ctx, "failed to write error [%v] with err: %v", err, encodeErr)
+	}
+}
@@ -94,6 +94,47 @@ type workflowEndpointMetrics struct {
 	listIds util.RequestMetrics
 }
 
+type executionClusterEndpointMetrics struct {
+	scope promutils.Scope
+
+	list           util.RequestMetrics
+	register       util.RequestMetrics
+	get            util.RequestMetrics
+	update         util.RequestMetrics
+	setLabel       util.RequestMetrics
+	removeLabel    util.RequestMetrics
+	getDrainStatus util.RequestMetrics
+}
+
+type notificationDeliveryEndpointMetrics struct {
+	scope promutils.Scope
+
+	list    util.RequestMetrics
+	get     util.RequestMetrics
+	redrive util.RequestMetrics
+}
+
+type scheduleBackfillEndpointMetrics struct {
+	scope promutils.Scope
+
+	create util.RequestMetrics
+	list   util.RequestMetrics
+	get    util.RequestMetrics
+	cancel util.RequestMetrics
+}
+
+type schedulePreviewEndpointMetrics struct {
+	scope promutils.Scope
+
+	preview util.RequestMetrics
+}
+
+type scheduleRunEndpointMetrics struct {
+	scope promutils.Scope
+
+	list util.RequestMetrics
+}
+
 type AdminMetrics struct {
 	Scope        promutils.Scope
 	PanicCounter prometheus.Counter
@@ -110,6 +151,11 @@ type AdminMetrics struct {
 	taskEndpointMetrics                    taskEndpointMetrics
 	taskExecutionEndpointMetrics           taskExecutionEndpointMetrics
 	workflowEndpointMetrics                workflowEndpointMetrics
+	executionClusterEndpointMetrics        executionClusterEndpointMetrics
+	notificationDeliveryEndpointMetrics    notificationDeliveryEndpointMetrics
+	scheduleBackfillEndpointMetrics        scheduleBackfillEndpointMetrics
+	schedulePreviewEndpointMetrics         schedulePreviewEndpointMetrics
+	scheduleRunEndpointMetrics             scheduleRunEndpointMetrics
 }
 
 func InitMetrics(adminScope promutils.Scope) AdminMetrics {
@@ -202,5 +248,36 @@ func InitMetrics(adminScope promutils.Scope) AdminMetrics {
 			list:    util.NewRequestMetrics(adminScope, "list_workflow"),
 			listIds: util.NewRequestMetrics(adminScope, "list_workflow_ids"),
 		},
+		executionClusterEndpointMetrics: executionClusterEndpointMetrics{
+			scope:          adminScope,
+			list:           util.NewRequestMetrics(adminScope, "list_execution_clusters"),
+			register:       util.NewRequestMetrics(adminScope, "register_execution_cluster"),
+			get:            util.NewRequestMetrics(adminScope, "get_execution_cluster"),
+			update:         util.NewRequestMetrics(adminScope, "update_execution_cluster"),
+			setLabel:       util.NewRequestMetrics(adminScope, "set_execution_cluster_label"),
+			removeLabel:    util.NewRequestMetrics(adminScope, "remove_execution_cluster_label"),
+			getDrainStatus: util.NewRequestMetrics(adminScope, "get_execution_cluster_drain_status"),
+		},
+		notificationDeliveryEndpointMetrics: notificationDeliveryEndpointMetrics{
+			scope:   adminScope,
+			list:    util.NewRequestMetrics(adminScope, "list_notification_deliveries"),
+			get:     util.NewRequestMetrics(adminScope, "get_notification_delivery"),
+			redrive: util.NewRequestMetrics(adminScope, "redrive_notification_delivery"),
+		},
+		scheduleBackfillEndpointMetrics: scheduleBackfillEndpointMetrics{
+			scope:  adminScope,
+			create: util.NewRequestMetrics(adminScope, "create_schedule_backfill"),
+			list:   util.NewRequestMetrics(adminScope, "list_schedule_backfills"),
+			get:    util.NewRequestMetrics(adminScope, "get_schedule_backfill"),
+			cancel: util.NewRequestMetrics(adminScope, "cancel_schedule_backfill"),
+		},
+		schedulePreviewEndpointMetrics: schedulePreviewEndpointMetrics{
+			scope:   adminScope,
+			preview: util.NewRequestMetrics(adminScope, "preview_schedule"),
+		},
+		scheduleRunEndpointMetrics: scheduleRunEndpointMetrics{
+			scope: adminScope,
+			list:  util.NewRequestMetrics(adminScope, "list_schedule_runs"),
+		},
 	}
 }
@@ -0,0 +1,141 @@
+package adminservice
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/audit"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/rpc/adminservice/util"
+	"google.golang.org/grpc/codes"
+)
+
+// Serves
+//
+//	GET  /api/v1/notification_deliveries/{project}?domain=&status=&limit=&token=
+//	GET  /api/v1/notification_deliveries/{project}/{id}
+//	POST /api/v1/notification_deliveries/{project}/{id}/redrive
+const notificationDeliveriesPath = httpAPIPrefix + "notification_deliveries/"
+
+const redriveAction = "redrive"
+
+func parseNotificationDeliveryID(id string) (uint, error) {
+	parsed, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return 0, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid notification delivery id [%s]", id)
+	}
+	return uint(parsed), nil
+}
+
+func (m *AdminService) handleNotificationDeliveries(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(request.URL.Path, notificationDeliveriesPath), "/"), "/")
+	switch {
+	case len(segments) == 1 && len(segments[0]) > 0 && request.Method == http.MethodGet:
+		m.listNotificationDeliveries(ctx, writer, request, segments[0])
+	case len(segments) == 2 && request.Method == http.MethodGet:
+		m.getNotificationDelivery(ctx, writer, segments[0], segments[1])
+	case len(segments) == 3 && segments[2] == redriveAction && request.Method == http.MethodPost:
+		m.redriveNotificationDelivery(ctx, writer, segments[0], segments[1])
+	default:
+		writeHTTPError(ctx, writer, errors.NewFlyteAdminErrorf(codes.NotFound,
+			"no notification deliveries endpoint matches %s %s", request.Method, request.URL.Path))
+	}
+}
+
+func (m *AdminService) listNotificationDeliveries(
+	ctx context.Context, writer http.ResponseWriter, request *http.Request, project string) {
+	requestedAt := time.Now()
+	query := request.URL.Query()
+	listRequest := interfaces.NotificationDeliveryListRequest{
+		Project: project,
+		Domain:  query.Get("domain"),
+		Status:  query.Get("status"),
+		Token:   query.Get("token"),
+	}
+	var response *interfaces.NotificationDeliveryList
+	var err error
+	if limit := query.Get("limit"); len(limit) > 0 {
+		parsed, parseErr := strconv.ParseUint(limit, 10, 32)
+		if parseErr != nil {
+			err = errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid limit [%s]", limit)
+		}
+		listRequest.Limit = uint32(parsed)
+	}
+	if err == nil {
+		m.Metrics.notificationDeliveryEndpointMetrics.list.Time(func() {
+			response, err = m.NotificationDeliveryManager.ListNotificationDeliveries(ctx, listRequest)
+		})
+	}
+	audit.NewLogBuilder().WithAuthenticatedCtx(ctx).WithRequest(
+		"ListNotificationDeliveries",
+		map[string]string{
+			audit.Project: project,
+			audit.Domain:  listRequest.Domain,
+		},
+		audit.ReadOnly,
+		requestedAt,
+	).WithResponse(time.Now(), err).Log(ctx)
+	if err != nil {
+		writeHTTPError(ctx, writer, util.TransformAndRecordError(err, &m.Metrics.notificationDeliveryEndpointMetrics.list))
+		return
+	}
+	m.Metrics.notificationDeliveryEndpointMetrics.list.Success()
+	writeHTTPResponse(ctx, writer, response)
+}
+
+func (m *AdminService) getNotificationDelivery(
+	ctx context.Context, writer http.ResponseWriter, project, id string) {
+	requestedAt := time.Now()
+	deliveryID, err := parseNotificationDeliveryID(id)
+	var response *interfaces.NotificationDelivery
+	if err == nil {
+		m.Metrics.notificationDeliveryEndpointMetrics.get.Time(func() {
+			response, err = m.NotificationDeliveryManager.GetNotificationDelivery(ctx, project, deliveryID)
+		})
+	}
+	audit.NewLogBuilder().WithAuthenticatedCtx(ctx).WithRequest(
+		"GetNotificationDelivery",
+		map[string]string{
+			audit.Project: project,
+		},
+		audit.ReadOnly,
+		requestedAt,
+	).WithResponse(time.Now(), err).Log(ctx)
+	if err != nil {
+		writeHTTPError(ctx, writer, util.TransformAndRecordError(err, &m.Metrics.notificationDeliveryEndpointMetrics.get))
+		return
+	}
+	m.Metrics.notificationDeliveryEndpointMetrics.get.Success()
+	writeHTTPResponse(ctx, writer, response)
+}
+
+func (m *AdminService) redriveNotificationDelivery(
+	ctx context.Context, writer http.ResponseWriter, project, id string) {
+	requestedAt := time.Now()
+	deliveryID, err := parseNotificationDeliveryID(id)
+	var response *interfaces.NotificationDelivery
+	if err == nil {
+		m.Metrics.notificationDeliveryEndpointMetrics.redrive.Time(func() {
+			response, err = m.NotificationDeliveryManager.RedriveNotificationDelivery(ctx, project, deliveryID)
+		})
+	}
+	audit.NewLogBuilder().WithAuthenticatedCtx(ctx).WithRequest(
+		"RedriveNotificationDelivery",
+		map[string]string{
+			audit.Project: project,
+		},
+		audit.ReadWrite,
+		requestedAt,
+	).WithResponse(time.Now(), err).Log(ctx)
+	if err != nil {
+		writeHTTPError(ctx, writer, util.TransformAndRecordError(err, &m.Metrics.notificationDeliveryEndpointMetrics.redrive))
+		return
+	}
+	m.Metrics.notificationDeliveryEndpointMetrics.redrive.Success()
+	writeHTTPResponse(ctx, writer, response)
+}
@@ -0,0 +1,178 @@
+package adminservice
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/audit"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/rpc/adminservice/util"
+	"google.golang.org/grpc/codes"
+)
+
+// Serves
+//
+//	POST /api/v1/schedule_backfills/{project}
+//	GET  /api/v1/schedule_backfills/{project}?domain=&name=&phase=&limit=&token=
+//	GET  /api/v1/schedule_backfills/{project}/{id}
+//	POST /api/v1/schedule_backfills/{project}/{id}/cancel
+const scheduleBackfillsPath = httpAPIPrefix + "schedule_backfills/"
+
+const cancelAction = "cancel"
+
+func parseScheduleBackfillID(id string) (uint, error) {
+	parsed, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return 0, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid schedule backfill id [%s]", id)
+	}
+	return uint(parsed), nil
+}
+
+func (m *AdminService) handleScheduleBackfills(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(request.URL.Path, scheduleBackfillsPath), "/"), "/")
+	switch {
+	case len(segments) == 1 && len(segments[0]) > 0 && request.Method == http.MethodPost:
+		m.createScheduleBackfill(ctx, writer, request, segments[0])
+	case len(segments) == 1 && len(segments[0]) > 0 && request.Method == http.MethodGet:
+		m.listScheduleBackfills(ctx, writer, request, segments[0])
+	case len(segments) == 2 && request.Method == http.MethodGet:
+		m.getScheduleBackfill(ctx, writer, segments[0], segments[1])
+	case len(segments) == 3 && segments[2] == cancelAction && request.Method == http.MethodPost:
+		m.cancelScheduleBackfill(ctx, writer, segments[0], segments[1])
+	default:
+		writeHTTPError(ctx, writer, errors.NewFlyteAdminErrorf(codes.NotFound,
+			"no schedule backfills endpoint matches %s %s", request.Method, request.URL.Path))
+	}
+}
+
+func (m *AdminService) createScheduleBackfill(
+	ctx context.Context, writer http.ResponseWriter, request *http.Request, project string) {
+	requestedAt := time.Now()
+	var createRequest interfaces.ScheduleBackfillCreateRequest
+	var response *interfaces.ScheduleBackfill
+	err := json.NewDecoder(request.Body).Decode(&createRequest)
+	if err != nil {
+		err = errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid schedule backfill request: %v", err)
+	} else {
+		createRequest.Project = project
+		m.Metrics.scheduleBackfillEndpointMetrics.create.Time(func() {
+			response, err = m.ScheduleBackfillManager.CreateScheduleBackfill(ctx, createRequest)
+		})
+	}
+	audit.NewLogBuilder().WithAuthenticatedCtx(ctx).WithRequest(
+		"CreateScheduleBackfill",
+		map[string]string{
+			audit.Project: project,
+			audit.Domain:  createRequest.Domain,
+			audit.Name:    createRequest.Name,
+		},
+		audit.ReadWrite,
+		requestedAt,
+	).WithResponse(time.Now(), err).Log(ctx)
+	if err != nil {
+		writeHTTPError(ctx, writer, util.TransformAndRecordError(err, &m.Metrics.scheduleBackfillEndpointMetrics.create))
+		return
+	}
+	m.Metrics.scheduleBackfillEndpointMetrics.create.Success()
+	writeHTTPResponse(ctx, writer, response)
+}
+
+func (m *AdminService) listScheduleBackfills(
+	ctx context.Context, writer http.ResponseWriter, request *http.Request, project string) {
+	requestedAt := time.Now()
+	query := request.URL.Query()
+	listRequest := interfaces.ScheduleBackfillListRequest{
+		Project: project,
+		Domain:  query.Get("domain"),
+		Name:    query.Get("name"),
+		Phase:   query.Get("phase"),
+		Token:   query.Get("token"),
+	}
+	var response *interfaces.ScheduleBackfillList
+	var err error
+	if limit := query.Get("limit"); len(limit) > 0 {
+		parsed, parseErr := strconv.ParseUint(limit, 10, 32)
+		if parseErr != nil {
+			err = errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid limit [%s]", limit)
+		}
+		listRequest.Limit = uint32(parsed)
+	}
+	if err == nil {
+		m.Metrics.scheduleBackfillEndpointMetrics.list.Time(func() {
+			response, err = m.ScheduleBackfillManager.ListScheduleBackfills(ctx, listRequest)
+		})
+	}
+	audit.NewLogBuilder().WithAuthenticatedCtx(ctx).WithRequest(
+		"ListScheduleBackfills",
+		map[string]string{
+			audit.Project: project,
+			audit.Domain:  listRequest.Domain,
+		},
+		audit.ReadOnly,
+		requestedAt,
+	).WithResponse(time.Now(), err).Log(ctx)
+	if err != nil {
+		writeHTTPError(ctx, writer, util.TransformAndRecordError(err, &m.Metrics.scheduleBackfillEndpointMetrics.list))
+		return
+	}
+	m.Metrics.scheduleBackfillEndpointMetrics.list.Success()
+	writeHTTPResponse(ctx, writer, response)
+}
+
+func (m *AdminService) getScheduleBackfill(
+	ctx context.Context, writer http.ResponseWriter, project, id string) {
+	requestedAt := time.Now()
+	backfillID, err := parseScheduleBackfillID(id)
+	var response *interfaces.ScheduleBackfill
+	if err == nil {
+		m.Metrics.scheduleBackfillEndpointMetrics.get.Time(func() {
+			response, err = m.ScheduleBackfillManager.GetScheduleBackfill(ctx, project, backfillID)
+		})
+	}
+	audit.NewLogBuilder().WithAuthenticatedCtx(ctx).WithRequest(
+		"GetScheduleBackfill",
+		map[string]string{
+			audit.Project: project,
+		},
+		audit.ReadOnly,
+		requestedAt,
+	).WithResponse(time.Now(), err).Log(ctx)
+	if err != nil {
+		writeHTTPError(ctx, writer, util.TransformAndRecordError(err, &m.Metrics.scheduleBackfillEndpointMetrics.get))
+		return
+	}
+	m.Metrics.scheduleBackfillEndpointMetrics.get.Success()
+	writeHTTPResponse(ctx, writer, response)
+}
+
+func (m *AdminService) cancelScheduleBackfill(
+	ctx context.Context, writer http.ResponseWriter, project, id string) {
+	requestedAt := time.Now()
+	backfillID, err := parseScheduleBackfillID(id)
+	var response *interfaces.ScheduleBackfill
+	if err == nil {
+		m.Metrics.scheduleBackfillEndpointMetrics.cancel.Time(func() {
+			response, err = m.ScheduleBackfillManager.CancelScheduleBackfill(ctx, project, backfillID)
+		})
+	}
+	audit.NewLogBuilder().WithAuthenticatedCtx(ctx).WithRequest(
+		"CancelScheduleBackfill",
+		map[string]string{
+			audit.Project: project,
+		},
+		audit.ReadWrite,
+		requestedAt,
+	).WithResponse(time.Now(), err).Log(ctx)
+	if err != nil {
+		writeHTTPError(ctx, writer, util.TransformAndRecordError(err, &m.Metrics.scheduleBackfillEndpointMetrics.cancel))
+		return
+	}
+	m.Metrics.scheduleBackfillEndpointMetrics.cancel.Success()
+	writeHTTPResponse(ctx, writer, response)
+}
@@ -0,0 +1,102 @@
+package adminservice
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/audit"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/rpc/adminservice/util"
+	"google.golang.org/grpc/codes"
+)
+
+// Serves
+//
+//	GET /api/v1/schedule_previews/{project}/{domain}/{name}/{version}?start_time=&end_time=&limit=
+//
+// where the times are formatted as RFC 3339.
+const schedulePreviewsPath = httpAPIPrefix + "schedule_previews/"
+
+func parseSchedulePreviewTime(query url.Values, key string) (time.Time, error) {
+	value := query.Get(key)
+	if len(value) == 0 {
+		return time.Time{}, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid %s [%s]", key, value)
+	}
+	return parsed, nil
+}
+
+func parseSchedulePreviewRequest(request *http.Request) (interfaces.SchedulePreviewRequest, error) {
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(request.URL.Path, schedulePreviewsPath), "/"), "/")
+	if len(segments) != 4 {
+		return interfaces.SchedulePreviewRequest{}, errors.NewFlyteAdminErrorf(codes.NotFound,
+			"no schedule previews endpoint matches %s %s", request.Method, request.URL.Path)
+	}
+	previewRequest := interfaces.SchedulePreviewRequest{
+		Project: segments[0],
+		Domain:  segments[1],
+		Name:    segments[2],
+		Version: segments[3],
+	}
+	query := request.URL.Query()
+	var err error
+	if previewRequest.StartTime, err = parseSchedulePreviewTime(query, "start_time"); err != nil {
+		return previewRequest, err
+	}
+	if previewRequest.EndTime, err = parseSchedulePreviewTime(query, "end_time"); err != nil {
+		return previewRequest, err
+	}
+	if limit := query.Get("limit"); len(limit) > 0 {
+		parsed, parseErr := strconv.ParseUint(limit, 10, 32)
+		if parseErr != nil {
+			return previewRequest, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid limit [%s]", limit)
+		}
+		previewRequest.Limit = uint32(parsed)
+	}
+	return previewRequest, nil
+}
+
+func (m *AdminService) handleSchedulePreviews(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	if request.Method != http.MethodGet {
+		writeHTTPError(ctx, writer, errors.NewFlyteAdminErrorf(codes.NotFound,
+			"no schedule previews endpoint matches %s %s", request.Method, request.URL.Path))
+		return
+	}
+	m.previewSchedule(ctx, writer, request)
+}
+
+func (m *AdminService) previewSchedule(ctx context.Context, writer http.ResponseWriter, request *http.Request) {
+	requestedAt := time.Now()
+	previewRequest, err := parseSchedulePreviewRequest(request)
+	var response *interfaces.SchedulePreview
+	if err == nil {
+		m.Metrics.schedulePreviewEndpointMetrics.preview.Time(func() {
+			response, err = m.SchedulePreviewManager.PreviewSchedule(ctx, previewRequest)
+		})
+	}
+	audit.NewLogBuilder().WithAuthenticatedCtx(ctx).WithRequest(
+		"PreviewSchedule",
+		map[string]string{
+			audit.Project: previewRequest.Project,
+			audit.Domain:  previewRequest.Domain,
+			audit.Name:    previewRequest.Name,
+		},
+		audit.ReadOnly,
+		requestedAt,
+	).WithResponse(time.Now(), err).Log(ctx)
+	if err != nil {
+		writeHTTPError(ctx, writer, util.TransformAndRecordError(err, &m.Metrics.schedulePreviewEndpointMetrics.preview))
+		return
+	}
+	m.Metrics.schedulePreviewEndpointMetrics.preview.Success()
+	writeHTTPResponse(ctx, writer, response)
+}
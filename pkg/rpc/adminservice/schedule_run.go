@@ -0,0 +1,82 @@
+package adminservice
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/audit"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/rpc/adminservice/util"
+	"google.golang.org/grpc/codes"
+)
+
+// Serves
+//
+//	GET /api/v1/schedule_runs/{project}/{domain}/{name}?version=&outcome=&limit=&token=
+const scheduleRunsPath = httpAPIPrefix + "schedule_runs/"
+
+func parseScheduleRunListRequest(request *http.Request) (interfaces.ScheduleRunListRequest, error) {
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(request.URL.Path, scheduleRunsPath), "/"), "/")
+	if len(segments) != 3 {
+		return interfaces.ScheduleRunListRequest{}, errors.NewFlyteAdminErrorf(codes.NotFound,
+			"no schedule runs endpoint matches %s %s", request.Method, request.URL.Path)
+	}
+	query := request.URL.Query()
+	listRequest := interfaces.ScheduleRunListRequest{
+		Project: segments[0],
+		Domain:  segments[1],
+		Name:    segments[2],
+		Version: query.Get("version"),
+		Outcome: query.Get("outcome"),
+		Token:   query.Get("token"),
+	}
+	if limit := query.Get("limit"); len(limit) > 0 {
+		parsed, parseErr := strconv.ParseUint(limit, 10, 32)
+		if parseErr != nil {
+			return listRequest, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid limit [%s]", limit)
+		}
+		listRequest.Limit = uint32(parsed)
+	}
+	return listRequest, nil
+}
+
+func (m *AdminService) handleScheduleRuns(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	if request.Method != http.MethodGet {
+		writeHTTPError(ctx, writer, errors.NewFlyteAdminErrorf(codes.NotFound,
+			"no schedule runs endpoint matches %s %s", request.Method, request.URL.Path))
+		return
+	}
+	m.listScheduleRuns(ctx, writer, request)
+}
+
+func (m *AdminService) listScheduleRuns(ctx context.Context, writer http.ResponseWriter, request *http.Request) {
+	requestedAt := time.Now()
+	listRequest, err := parseScheduleRunListRequest(request)
+	var response *interfaces.ScheduleRunList
+	if err == nil {
+		m.Metrics.scheduleRunEndpointMetrics.list.Time(func() {
+			response, err = m.ScheduleRunManager.ListScheduleRuns(ctx, listRequest)
+		})
+	}
+	audit.NewLogBuilder().WithAuthenticatedCtx(ctx).WithRequest(
+		"ListScheduleRuns",
+		map[string]string{
+			audit.Project: listRequest.Project,
+			audit.Domain:  listRequest.Domain,
+			audit.Name:    listRequest.Name,
+		},
+		audit.ReadOnly,
+		requestedAt,
+	).WithResponse(time.Now(), err).Log(ctx)
+	if err != nil {
+		writeHTTPError(ctx, writer, util.TransformAndRecordError(err, &m.Metrics.scheduleRunEndpointMetrics.list))
+		return
+	}
+	m.Metrics.scheduleRunEndpointMetrics.list.Success()
+	writeHTTPResponse(ctx, writer, response)
+}
@@ -0,0 +1,158 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/manager/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListExecutionClusters(t *testing.T) {
+	manager := mocks.MockExecutionClusterManager{}
+	manager.SetListCallback(func(ctx context.Context) (*interfaces.ExecutionClusterList, error) {
+		return &interfaces.ExecutionClusterList{
+			HealthChecksEnabled: true,
+			Clusters: []executioncluster.ClusterHealth{
+				{ID: "cluster", Healthy: false, Error: "unreachable", ConsecutiveFailures: 3},
+			},
+		}, nil
+	})
+	mux := http.NewServeMux()
+	NewMockAdminServer(NewMockAdminServerInput{
+		executionClusterManager: &manager,
+	}).RegisterHTTPHandlers(mux)
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/execution_clusters", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.ExecutionClusterList
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.True(t, response.HealthChecksEnabled)
+	assert.Len(t, response.Clusters, 1)
+	assert.False(t, response.Clusters[0].Healthy)
+
+	recorder = httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodDelete, "/api/v1/execution_clusters", nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+func TestRegisterExecutionCluster(t *testing.T) {
+	manager := mocks.MockExecutionClusterManager{}
+	manager.SetRegisterCallback(func(ctx context.Context, request interfaces.ExecutionClusterRegistration) (
+		*interfaces.ExecutionClusterRegistration, error) {
+		assert.Equal(t, "cluster", request.Name)
+		assert.Equal(t, "/var/secrets/token", request.Auth.TokenPath)
+		assert.Equal(t, map[string]float32{"gpu": 0.5}, request.Labels)
+		return &request, nil
+	})
+	mux := http.NewServeMux()
+	NewMockAdminServer(NewMockAdminServerInput{
+		executionClusterManager: &manager,
+	}).RegisterHTTPHandlers(mux)
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/api/v1/execution_clusters", strings.NewReader(
+		`{"name": "cluster", "endpoint": "endpoint", "auth": {"tokenPath": "/var/secrets/token"}, `+
+			`"enabled": true, "labels": {"gpu": 0.5}}`)))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.ExecutionClusterRegistration
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.True(t, response.Enabled)
+
+	recorder = httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/api/v1/execution_clusters",
+		strings.NewReader("{")))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestUpdateExecutionCluster(t *testing.T) {
+	manager := mocks.MockExecutionClusterManager{}
+	manager.SetGetCallback(func(ctx context.Context, name string) (*interfaces.ExecutionClusterRegistration, error) {
+		return &interfaces.ExecutionClusterRegistration{Name: name}, nil
+	})
+	manager.SetUpdateCallback(func(ctx context.Context, request interfaces.ExecutionClusterUpdateRequest) (
+		*interfaces.ExecutionClusterRegistration, error) {
+		assert.Equal(t, "cluster", request.Name)
+		assert.Nil(t, request.Enabled)
+		assert.True(t, *request.Draining)
+		return &interfaces.ExecutionClusterRegistration{Name: request.Name, Draining: true}, nil
+	})
+	mux := http.NewServeMux()
+	NewMockAdminServer(NewMockAdminServerInput{
+		executionClusterManager: &manager,
+	}).RegisterHTTPHandlers(mux)
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/execution_clusters/cluster", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodPatch, "/api/v1/execution_clusters/cluster",
+		strings.NewReader(`{"draining": true}`)))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.ExecutionClusterRegistration
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.True(t, response.Draining)
+}
+
+func TestSetExecutionClusterLabel(t *testing.T) {
+	manager := mocks.MockExecutionClusterManager{}
+	manager.SetSetLabelCallback(func(ctx context.Context, request interfaces.ExecutionClusterLabelRequest) (
+		*interfaces.ExecutionClusterRegistration, error) {
+		assert.Equal(t, interfaces.ExecutionClusterLabelRequest{Cluster: "cluster", Label: "gpu", Weight: 2}, request)
+		return &interfaces.ExecutionClusterRegistration{Name: "cluster"}, nil
+	})
+	var removed bool
+	manager.SetRemoveLabelCallback(func(ctx context.Context, cluster, label string) (
+		*interfaces.ExecutionClusterRegistration, error) {
+		removed = cluster == "cluster" && label == "gpu"
+		return &interfaces.ExecutionClusterRegistration{Name: "cluster"}, nil
+	})
+	mux := http.NewServeMux()
+	NewMockAdminServer(NewMockAdminServerInput{
+		executionClusterManager: &manager,
+	}).RegisterHTTPHandlers(mux)
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodPut, "/api/v1/execution_clusters/cluster/labels/gpu",
+		strings.NewReader(`{"weight": 2}`)))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodDelete, "/api/v1/execution_clusters/cluster/labels/gpu", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.True(t, removed)
+
+	recorder = httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodDelete, "/api/v1/execution_clusters/cluster/weights/gpu", nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+func TestGetExecutionClusterDrainStatus(t *testing.T) {
+	manager := mocks.MockExecutionClusterManager{}
+	manager.SetGetDrainStatusCallback(func(ctx context.Context, cluster string) (
+		*interfaces.ExecutionClusterDrainStatus, error) {
+		assert.Equal(t, "cluster", cluster)
+		return &interfaces.ExecutionClusterDrainStatus{Cluster: cluster, Draining: true, NonTerminalExecutions: 2}, nil
+	})
+	mux := http.NewServeMux()
+	NewMockAdminServer(NewMockAdminServerInput{
+		executionClusterManager: &manager,
+	}).RegisterHTTPHandlers(mux)
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/execution_clusters/cluster/drain", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.ExecutionClusterDrainStatus
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.True(t, response.Draining)
+	assert.False(t, response.Drained)
+	assert.EqualValues(t, 2, response.NonTerminalExecutions)
+}
@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/manager/mocks"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func newNotificationDeliveryHandler(manager *mocks.MockNotificationDeliveryManager) http.Handler {
+	mux := http.NewServeMux()
+	NewMockAdminServer(NewMockAdminServerInput{
+		notificationDeliveryManager: manager,
+	}).RegisterHTTPHandlers(mux)
+	return mux
+}
+
+func serveNotificationDeliveryRequest(handler http.Handler, method, target string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(method, target, nil))
+	return recorder
+}
+
+func TestListNotificationDeliveries(t *testing.T) {
+	manager := mocks.MockNotificationDeliveryManager{}
+	manager.SetListCallback(func(ctx context.Context, request interfaces.NotificationDeliveryListRequest) (
+		*interfaces.NotificationDeliveryList, error) {
+		assert.Equal(t, interfaces.NotificationDeliveryListRequest{
+			Project: "project",
+			Domain:  "development",
+			Status:  "DEAD_LETTER",
+			Limit:   10,
+			Token:   "20",
+		}, request)
+		return &interfaces.NotificationDeliveryList{
+			Deliveries: []interfaces.NotificationDelivery{{ID: 1, Status: "DEAD_LETTER"}},
+			Token:      "30",
+		}, nil
+	})
+	recorder := serveNotificationDeliveryRequest(newNotificationDeliveryHandler(&manager), http.MethodGet,
+		"/api/v1/notification_deliveries/project?domain=development&status=DEAD_LETTER&limit=10&token=20")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var response interfaces.NotificationDeliveryList
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, "30", response.Token)
+	assert.Len(t, response.Deliveries, 1)
+	assert.Equal(t, uint(1), response.Deliveries[0].ID)
+}
+
+func TestListNotificationDeliveries_InvalidLimit(t *testing.T) {
+	recorder := serveNotificationDeliveryRequest(newNotificationDeliveryHandler(&mocks.MockNotificationDeliveryManager{}),
+		http.MethodGet, "/api/v1/notification_deliveries/project?limit=foo")
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestGetNotificationDelivery(t *testing.T) {
+	manager := mocks.MockNotificationDeliveryManager{}
+	manager.SetGetCallback(func(ctx context.Context, project string, id uint) (*interfaces.NotificationDelivery, error) {
+		if id != 1 {
+			return nil, errors.NewFlyteAdminErrorf(codes.NotFound, "not found")
+		}
+		assert.Equal(t, "project", project)
+		return &interfaces.NotificationDelivery{ID: id}, nil
+	})
+	handler := newNotificationDeliveryHandler(&manager)
+
+	recorder := serveNotificationDeliveryRequest(handler, http.MethodGet, "/api/v1/notification_deliveries/project/1")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	recorder = serveNotificationDeliveryRequest(handler, http.MethodGet, "/api/v1/notification_deliveries/project/2")
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	recorder = serveNotificationDeliveryRequest(handler, http.MethodGet, "/api/v1/notification_deliveries/project/foo")
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestRedriveNotificationDelivery(t *testing.T) {
+	manager := mocks.MockNotificationDeliveryManager{}
+	manager.SetRedriveCallback(func(ctx context.Context, project string, id uint) (
+		*interfaces.NotificationDelivery, error) {
+		return nil, errors.NewFlyteAdminErrorf(codes.FailedPrecondition, "not dead-lettered")
+	})
+	handler := newNotificationDeliveryHandler(&manager)
+
+	recorder := serveNotificationDeliveryRequest(handler, http.MethodPost,
+		"/api/v1/notification_deliveries/project/1/redrive")
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var response map[string]interface{}
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, float64(codes.FailedPrecondition), response["code"])
+
+	// Re-drives must be POSTed.
+	recorder = serveNotificationDeliveryRequest(handler, http.MethodGet,
+		"/api/v1/notification_deliveries/project/1/redrive")
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
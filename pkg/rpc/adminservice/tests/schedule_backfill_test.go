@@ -0,0 +1,124 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/manager/mocks"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func newScheduleBackfillHandler(manager *mocks.MockScheduleBackfillManager) http.Handler {
+	mux := http.NewServeMux()
+	NewMockAdminServer(NewMockAdminServerInput{
+		scheduleBackfillManager: manager,
+	}).RegisterHTTPHandlers(mux)
+	return mux
+}
+
+func serveScheduleBackfillRequest(handler http.Handler, method, target, body string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(method, target, strings.NewReader(body)))
+	return recorder
+}
+
+func TestCreateScheduleBackfill(t *testing.T) {
+	startTime := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+	manager := mocks.MockScheduleBackfillManager{}
+	manager.SetCreateCallback(func(ctx context.Context, request interfaces.ScheduleBackfillCreateRequest) (
+		*interfaces.ScheduleBackfill, error) {
+		assert.Equal(t, interfaces.ScheduleBackfillCreateRequest{
+			Project:     "project",
+			Domain:      "development",
+			Name:        "lp",
+			Version:     "v1",
+			StartTime:   startTime,
+			EndTime:     startTime.Add(24 * time.Hour),
+			Parallelism: 4,
+			Order:       "DESCENDING",
+		}, request)
+		return &interfaces.ScheduleBackfill{ID: 1, Phase: "PENDING", TotalExecutions: 24}, nil
+	})
+	handler := newScheduleBackfillHandler(&manager)
+
+	recorder := serveScheduleBackfillRequest(handler, http.MethodPost, "/api/v1/schedule_backfills/project",
+		`{"project": "ignored", "domain": "development", "name": "lp", "version": "v1", `+
+			`"startTime": "2021-10-01T00:00:00Z", "endTime": "2021-10-02T00:00:00Z", "parallelism": 4, `+
+			`"order": "DESCENDING"}`)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.ScheduleBackfill
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, uint(1), response.ID)
+	assert.Equal(t, uint32(24), response.TotalExecutions)
+
+	recorder = serveScheduleBackfillRequest(handler, http.MethodPost, "/api/v1/schedule_backfills/project", "{")
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestListScheduleBackfills(t *testing.T) {
+	manager := mocks.MockScheduleBackfillManager{}
+	manager.SetListCallback(func(ctx context.Context, request interfaces.ScheduleBackfillListRequest) (
+		*interfaces.ScheduleBackfillList, error) {
+		assert.Equal(t, interfaces.ScheduleBackfillListRequest{
+			Project: "project",
+			Domain:  "development",
+			Name:    "lp",
+			Phase:   "RUNNING",
+			Limit:   10,
+			Token:   "20",
+		}, request)
+		return &interfaces.ScheduleBackfillList{
+			Backfills: []interfaces.ScheduleBackfill{{ID: 1, Phase: "RUNNING"}},
+			Token:     "30",
+		}, nil
+	})
+	recorder := serveScheduleBackfillRequest(newScheduleBackfillHandler(&manager), http.MethodGet,
+		"/api/v1/schedule_backfills/project?domain=development&name=lp&phase=RUNNING&limit=10&token=20", "")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var response interfaces.ScheduleBackfillList
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, "30", response.Token)
+	assert.Len(t, response.Backfills, 1)
+}
+
+func TestGetAndCancelScheduleBackfill(t *testing.T) {
+	manager := mocks.MockScheduleBackfillManager{}
+	manager.SetGetCallback(func(ctx context.Context, project string, id uint) (*interfaces.ScheduleBackfill, error) {
+		assert.Equal(t, "project", project)
+		return &interfaces.ScheduleBackfill{ID: id, Phase: "RUNNING"}, nil
+	})
+	manager.SetCancelCallback(func(ctx context.Context, project string, id uint) (*interfaces.ScheduleBackfill, error) {
+		if id != 1 {
+			return nil, errors.NewFlyteAdminErrorf(codes.FailedPrecondition, "already finished")
+		}
+		return &interfaces.ScheduleBackfill{ID: id, Phase: "CANCELLED"}, nil
+	})
+	handler := newScheduleBackfillHandler(&manager)
+
+	recorder := serveScheduleBackfillRequest(handler, http.MethodGet, "/api/v1/schedule_backfills/project/1", "")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	recorder = serveScheduleBackfillRequest(handler, http.MethodGet, "/api/v1/schedule_backfills/project/foo", "")
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = serveScheduleBackfillRequest(handler, http.MethodPost, "/api/v1/schedule_backfills/project/1/cancel", "")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.ScheduleBackfill
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, "CANCELLED", response.Phase)
+
+	recorder = serveScheduleBackfillRequest(handler, http.MethodPost, "/api/v1/schedule_backfills/project/2/cancel", "")
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = serveScheduleBackfillRequest(handler, http.MethodDelete, "/api/v1/schedule_backfills/project/1", "")
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
@@ -0,0 +1,93 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/manager/mocks"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func newSchedulePreviewHandler(manager *mocks.MockSchedulePreviewManager) http.Handler {
+	mux := http.NewServeMux()
+	NewMockAdminServer(NewMockAdminServerInput{
+		schedulePreviewManager: manager,
+	}).RegisterHTTPHandlers(mux)
+	return mux
+}
+
+func TestPreviewSchedule(t *testing.T) {
+	startTime := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+	manager := mocks.MockSchedulePreviewManager{}
+	manager.SetPreviewCallback(func(ctx context.Context, request interfaces.SchedulePreviewRequest) (
+		*interfaces.SchedulePreview, error) {
+		assert.Equal(t, interfaces.SchedulePreviewRequest{
+			Project:   "project",
+			Domain:    "development",
+			Name:      "lp",
+			Version:   "v1",
+			StartTime: startTime,
+			EndTime:   startTime.Add(24 * time.Hour),
+			Limit:     5,
+		}, request)
+		return &interfaces.SchedulePreview{
+			LaunchPlan: &core.Identifier{Name: "lp"},
+			FireTimes: []interfaces.ScheduleFireTime{
+				{
+					ScheduledTime: startTime.Add(time.Hour),
+					Inputs:        map[string]time.Time{"kickoff_time": startTime.Add(time.Hour)},
+				},
+			},
+		}, nil
+	})
+	handler := newSchedulePreviewHandler(&manager)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet,
+		"/api/v1/schedule_previews/project/development/lp/v1"+
+			"?start_time=2021-10-01T00:00:00Z&end_time=2021-10-02T00:00:00Z&limit=5", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.SchedulePreview
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Len(t, response.FireTimes, 1)
+	assert.True(t, startTime.Add(time.Hour).Equal(response.FireTimes[0].Inputs["kickoff_time"]))
+}
+
+func TestPreviewSchedule_Errors(t *testing.T) {
+	manager := mocks.MockSchedulePreviewManager{}
+	manager.SetPreviewCallback(func(ctx context.Context, request interfaces.SchedulePreviewRequest) (
+		*interfaces.SchedulePreview, error) {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "launch plan [lp] has no schedule to preview")
+	})
+	handler := newSchedulePreviewHandler(&manager)
+
+	testCases := []struct {
+		method string
+		target string
+		status int
+	}{
+		{method: http.MethodGet, target: "/api/v1/schedule_previews/project/development/lp/v1",
+			status: http.StatusBadRequest},
+		{method: http.MethodGet, target: "/api/v1/schedule_previews/project/development/lp/v1?start_time=today",
+			status: http.StatusBadRequest},
+		{method: http.MethodGet, target: "/api/v1/schedule_previews/project/development/lp/v1?limit=-1",
+			status: http.StatusBadRequest},
+		{method: http.MethodGet, target: "/api/v1/schedule_previews/project/development/lp",
+			status: http.StatusNotFound},
+		{method: http.MethodPost, target: "/api/v1/schedule_previews/project/development/lp/v1",
+			status: http.StatusNotFound},
+	}
+	for _, tc := range testCases {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(tc.method, tc.target, nil))
+		assert.Equal(t, tc.status, recorder.Code, tc.target)
+	}
+}
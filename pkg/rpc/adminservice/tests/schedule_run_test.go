@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/manager/mocks"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func newScheduleRunHandler(manager *mocks.MockScheduleRunManager) http.Handler {
+	mux := http.NewServeMux()
+	NewMockAdminServer(NewMockAdminServerInput{
+		scheduleRunManager: manager,
+	}).RegisterHTTPHandlers(mux)
+	return mux
+}
+
+func TestListScheduleRuns(t *testing.T) {
+	manager := mocks.MockScheduleRunManager{}
+	manager.SetListCallback(func(ctx context.Context, request interfaces.ScheduleRunListRequest) (
+		*interfaces.ScheduleRunList, error) {
+		assert.Equal(t, interfaces.ScheduleRunListRequest{
+			Project: "project",
+			Domain:  "development",
+			Name:    "lp",
+			Version: "v1",
+			Outcome: "FAILED",
+			Limit:   5,
+			Token:   "10",
+		}, request)
+		return &interfaces.ScheduleRunList{
+			Runs:  []interfaces.ScheduleRun{{Outcome: "FAILED", Attempts: 30}},
+			Token: "15",
+		}, nil
+	})
+	handler := newScheduleRunHandler(&manager)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet,
+		"/api/v1/schedule_runs/project/development/lp?version=v1&outcome=FAILED&limit=5&token=10", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.ScheduleRunList
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Len(t, response.Runs, 1)
+	assert.Equal(t, uint32(30), response.Runs[0].Attempts)
+	assert.Equal(t, "15", response.Token)
+}
+
+func TestListScheduleRuns_Errors(t *testing.T) {
+	manager := mocks.MockScheduleRunManager{}
+	manager.SetListCallback(func(ctx context.Context, request interfaces.ScheduleRunListRequest) (
+		*interfaces.ScheduleRunList, error) {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid limit")
+	})
+	handler := newScheduleRunHandler(&manager)
+
+	testCases := []struct {
+		method string
+		target string
+		status int
+	}{
+		{method: http.MethodGet, target: "/api/v1/schedule_runs/project/development/lp",
+			status: http.StatusBadRequest},
+		{method: http.MethodGet, target: "/api/v1/schedule_runs/project/development/lp?limit=many",
+			status: http.StatusBadRequest},
+		{method: http.MethodGet, target: "/api/v1/schedule_runs/project/development",
+			status: http.StatusNotFound},
+		{method: http.MethodDelete, target: "/api/v1/schedule_runs/project/development/lp",
+			status: http.StatusNotFound},
+	}
+	for _, tc := range testCases {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(tc.method, tc.target, nil))
+		assert.Equal(t, tc.status, recorder.Code, tc.target)
+	}
+}
@@ -7,27 +7,37 @@ import (
 )
 
 type NewMockAdminServerInput struct {
-	executionManager     *mocks.MockExecutionManager
-	launchPlanManager    *mocks.MockLaunchPlanManager
-	nodeExecutionManager *mocks.MockNodeExecutionManager
-	projectManager       *mocks.MockProjectManager
-	resourceManager      *mocks.MockResourceManager
-	taskManager          *mocks.MockTaskManager
-	workflowManager      *mocks.MockWorkflowManager
-	taskExecutionManager *mocks.MockTaskExecutionManager
+	executionManager            *mocks.MockExecutionManager
+	launchPlanManager           *mocks.MockLaunchPlanManager
+	nodeExecutionManager        *mocks.MockNodeExecutionManager
+	projectManager              *mocks.MockProjectManager
+	resourceManager             *mocks.MockResourceManager
+	taskManager                 *mocks.MockTaskManager
+	workflowManager             *mocks.MockWorkflowManager
+	taskExecutionManager        *mocks.MockTaskExecutionManager
+	executionClusterManager     *mocks.MockExecutionClusterManager
+	notificationDeliveryManager *mocks.MockNotificationDeliveryManager
+	scheduleBackfillManager     *mocks.MockScheduleBackfillManager
+	schedulePreviewManager      *mocks.MockSchedulePreviewManager
+	scheduleRunManager          *mocks.MockScheduleRunManager
 }
 
 func NewMockAdminServer(input NewMockAdminServerInput) *adminservice.AdminService {
 	var testScope = mockScope.NewTestScope()
 	return &adminservice.AdminService{
-		ExecutionManager:     input.executionManager,
-		LaunchPlanManager:    input.launchPlanManager,
-		NodeExecutionManager: input.nodeExecutionManager,
-		TaskManager:          input.taskManager,
-		ProjectManager:       input.projectManager,
-		ResourceManager:      input.resourceManager,
-		WorkflowManager:      input.workflowManager,
-		TaskExecutionManager: input.taskExecutionManager,
-		Metrics:              adminservice.InitMetrics(testScope),
+		ExecutionManager:            input.executionManager,
+		LaunchPlanManager:           input.launchPlanManager,
+		NodeExecutionManager:        input.nodeExecutionManager,
+		TaskManager:                 input.taskManager,
+		ProjectManager:              input.projectManager,
+		ResourceManager:             input.resourceManager,
+		WorkflowManager:             input.workflowManager,
+		TaskExecutionManager:        input.taskExecutionManager,
+		ExecutionClusterManager:     input.executionClusterManager,
+		NotificationDeliveryManager: input.notificationDeliveryManager,
+		ScheduleBackfillManager:     input.scheduleBackfillManager,
+		SchedulePreviewManager:      input.schedulePreviewManager,
+		ScheduleRunManager:          input.scheduleRunManager,
+		Metrics:                     adminservice.InitMetrics(testScope),
 	}
 }
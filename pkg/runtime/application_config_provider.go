@@ -42,13 +42,68 @@ var flyteAdminConfig = config.MustRegisterSection(flyteAdmin, &interfaces.Applic
 	EventVersion:          2,
 	AsyncEventsBufferSize: 100,
 	MaxParallelism:        25,
+	MaxTriggerDepth:       10,
+	LaunchPlanTriggerQueue: interfaces.LaunchPlanTriggerQueueConfig{
+		PollInterval: config.Duration{
+			Duration: 5 * time.Second,
+		},
+		BatchSize: 10,
+		VisibilityTimeout: config.Duration{
+			Duration: 5 * time.Minute,
+		},
+		MaxAttempts: 5,
+	},
+	// etcd's default request size limit of 1.5MiB.
+	MaxWorkflowCRDSizeBytes: 1572864,
+	RecordingExecutor: interfaces.RecordingExecutorConfig{
+		PhaseInterval: config.Duration{
+			Duration: 5 * time.Second,
+		},
+	},
 })
 
 var schedulerConfig = config.MustRegisterSection(scheduler, &interfaces.SchedulerConfig{
 	ProfilerPort: config.Port{Port: 10253},
 	EventSchedulerConfig: interfaces.EventSchedulerConfig{
-		Scheme:               common.Local,
-		FlyteSchedulerConfig: &interfaces.FlyteSchedulerConfig{},
+		Scheme: common.Local,
+		FlyteSchedulerConfig: &interfaces.FlyteSchedulerConfig{
+			LeaderElection: interfaces.SchedulerLeaderElectionConfig{
+				LeaseName: "flytescheduler",
+				LeaseDuration: config.Duration{
+					Duration: 15 * time.Second,
+				},
+				RenewInterval: config.Duration{
+					Duration: 5 * time.Second,
+				},
+				RetryInterval: config.Duration{
+					Duration: 5 * time.Second,
+				},
+			},
+			Backfill: interfaces.ScheduleBackfillConfig{
+				MaxExecutions:  1000,
+				MaxParallelism: 10,
+			},
+			Catchup: interfaces.ScheduleCatchupConfig{
+				DefaultPolicy: "all",
+			},
+			StorageTriggers: interfaces.StorageTriggersConfig{
+				MinPollInterval: config.Duration{
+					Duration: 30 * time.Second,
+				},
+				MaxObjectsPerPoll: 100,
+				LateObjectGracePeriod: config.Duration{
+					Duration: time.Hour,
+				},
+			},
+			ScheduleUpdates: interfaces.ScheduleUpdatesConfig{
+				PollInterval: config.Duration{
+					Duration: 30 * time.Second,
+				},
+				ReconcileInterval: config.Duration{
+					Duration: 5 * time.Minute,
+				},
+			},
+		},
 	},
 	WorkflowExecutorConfig: interfaces.WorkflowExecutorConfig{
 		Scheme: common.Local,
@@ -73,6 +128,27 @@ var remoteDataConfig = config.MustRegisterSection(remoteData, &interfaces.Remote
 })
 var notificationsConfig = config.MustRegisterSection(notifications, &interfaces.NotificationsConfig{
 	Type: common.Local,
+	DBQueueConfig: interfaces.NotificationsDBQueueConfig{
+		PollInterval: config.Duration{
+			Duration: 5 * time.Second,
+		},
+		BatchSize: 10,
+		VisibilityTimeout: config.Duration{
+			Duration: 5 * time.Minute,
+		},
+		MaxAttempts: 5,
+	},
+	DeliveryLogConfig: interfaces.NotificationsDeliveryLogConfig{
+		MaxAttempts: 5,
+	},
+	LongRunningChecker: interfaces.LongRunningCheckerConfig{
+		Interval: config.Duration{
+			Duration: time.Minute,
+		},
+		MaxLaunchPlanThreshold: config.Duration{
+			Duration: 24 * time.Hour,
+		},
+	},
 })
 var domainsConfig = config.MustRegisterSection(domains, &interfaces.DomainsConfig{
 	{
@@ -2,6 +2,7 @@ package runtime
 
 import (
 	"context"
+	"time"
 
 	"github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 
@@ -12,7 +13,40 @@ import (
 
 const clustersKey = "clusters"
 
-var clusterConfig = config.MustRegisterSection(clustersKey, &interfaces.Clusters{})
+var clusterConfig = config.MustRegisterSection(clustersKey, &interfaces.Clusters{
+	HealthCheck: interfaces.ClusterHealthCheckConfig{
+		Interval: config.Duration{
+			Duration: 30 * time.Second,
+		},
+		Timeout: config.Duration{
+			Duration: 5 * time.Second,
+		},
+		FailureThreshold: 3,
+		PropellerLeaseMaxAge: config.Duration{
+			Duration: 2 * time.Minute,
+		},
+	},
+	Selector: interfaces.ClusterSelectorConfig{
+		Name: interfaces.ClusterSelectorRandom,
+		LoadRefreshInterval: config.Duration{
+			Duration: 10 * time.Second,
+		},
+	},
+	Registry: interfaces.ClusterRegistryConfig{
+		Source: interfaces.ClusterRegistrySourceConfig,
+		RefreshInterval: config.Duration{
+			Duration: 30 * time.Second,
+		},
+	},
+	Failover: interfaces.ClusterFailoverConfig{
+		MaxAttempts: 3,
+	},
+	Credentials: interfaces.ClusterCredentialsConfig{
+		RefreshInterval: config.Duration{
+			Duration: time.Minute,
+		},
+	},
+})
 
 // Implementation of an interfaces.ClusterConfiguration
 type ClusterConfigurationProvider struct{}
@@ -35,6 +69,57 @@ func (p *ClusterConfigurationProvider) GetClusterConfigs() []interfaces.ClusterC
 	return make([]interfaces.ClusterConfig, 0)
 }
 
+func (p *ClusterConfigurationProvider) GetHealthCheckConfig() interfaces.ClusterHealthCheckConfig {
+	if clusterConfig != nil {
+		clusters := clusterConfig.GetConfig().(*interfaces.Clusters)
+		return clusters.HealthCheck
+	}
+	logger.Warningf(context.Background(), "Failed to find clusters in config. Returning the default health check config")
+	return interfaces.ClusterHealthCheckConfig{}
+}
+
+func (p *ClusterConfigurationProvider) GetSelectorConfig() interfaces.ClusterSelectorConfig {
+	if clusterConfig != nil {
+		clusters := clusterConfig.GetConfig().(*interfaces.Clusters)
+		return clusters.Selector
+	}
+	logger.Warningf(context.Background(), "Failed to find clusters in config. Returning the default selector config")
+	return interfaces.ClusterSelectorConfig{
+		Name: interfaces.ClusterSelectorRandom,
+	}
+}
+
+func (p *ClusterConfigurationProvider) GetRegistryConfig() interfaces.ClusterRegistryConfig {
+	if clusterConfig != nil {
+		clusters := clusterConfig.GetConfig().(*interfaces.Clusters)
+		return clusters.Registry
+	}
+	logger.Warningf(context.Background(), "Failed to find clusters in config. Returning the default registry config")
+	return interfaces.ClusterRegistryConfig{
+		Source: interfaces.ClusterRegistrySourceConfig,
+	}
+}
+
+func (p *ClusterConfigurationProvider) GetFailoverConfig() interfaces.ClusterFailoverConfig {
+	if clusterConfig != nil {
+		clusters := clusterConfig.GetConfig().(*interfaces.Clusters)
+		return clusters.Failover
+	}
+	logger.Warningf(context.Background(), "Failed to find clusters in config. Returning the default failover config")
+	return interfaces.ClusterFailoverConfig{
+		MaxAttempts: 1,
+	}
+}
+
+func (p *ClusterConfigurationProvider) GetCredentialsConfig() interfaces.ClusterCredentialsConfig {
+	if clusterConfig != nil {
+		clusters := clusterConfig.GetConfig().(*interfaces.Clusters)
+		return clusters.Credentials
+	}
+	logger.Warningf(context.Background(), "Failed to find clusters in config. Returning the default credentials config")
+	return interfaces.ClusterCredentialsConfig{}
+}
+
 func NewClusterConfigurationProvider() interfaces.ClusterConfiguration {
 	clusterConfigProvider := ClusterConfigurationProvider{}
 	clusterNameMap := make(map[string]bool)
@@ -62,6 +62,19 @@ type ApplicationConfig struct {
 	// This is useful to achieve fairness. Note: MapTasks are regarded as one unit,
 	// and parallelism/concurrency of MapTasks is independent from this.
 	MaxParallelism int32 `json:"maxParallelism"`
+	// Maximum number of launch plan triggers which may fire in a row, starting from an execution which wasn't
+	// triggered. Triggers which would exceed it are skipped, which also breaks any trigger loop.
+	MaxTriggerDepth int `json:"maxTriggerDepth"`
+	// Database queue the launch plan triggers fired by terminal executions are processed from.
+	LaunchPlanTriggerQueue LaunchPlanTriggerQueueConfig `json:"launchPlanTriggerQueue"`
+	// Whether the inputs of executions are left out of their Flyte workflow CRDs, which reference the location the
+	// inputs were offloaded to instead. Requires a flytepropeller which reads the inputs from that location.
+	OffloadWorkflowInputs bool `json:"offloadWorkflowInputs"`
+	// Size in bytes above which Flyte workflow CRDs are rejected rather than created, as etcd wouldn't store them.
+	// 0 disables the check.
+	MaxWorkflowCRDSizeBytes int `json:"maxWorkflowCRDSizeBytes"`
+	// Records the executions routed to it rather than creating them in Kubernetes, e.g. for local development.
+	RecordingExecutor RecordingExecutorConfig `json:"recordingExecutor"`
 }
 
 func (a *ApplicationConfig) GetRoleNameKey() string {
@@ -92,6 +105,59 @@ func (a *ApplicationConfig) GetMaxParallelism() int32 {
 	return a.MaxParallelism
 }
 
+func (a *ApplicationConfig) GetMaxTriggerDepth() int {
+	return a.MaxTriggerDepth
+}
+
+func (a *ApplicationConfig) GetLaunchPlanTriggerQueueConfig() LaunchPlanTriggerQueueConfig {
+	return a.LaunchPlanTriggerQueue
+}
+
+func (a *ApplicationConfig) GetOffloadWorkflowInputs() bool {
+	return a.OffloadWorkflowInputs
+}
+
+func (a *ApplicationConfig) GetMaxWorkflowCRDSizeBytes() int {
+	return a.MaxWorkflowCRDSizeBytes
+}
+
+func (a *ApplicationConfig) GetRecordingExecutorConfig() RecordingExecutorConfig {
+	return a.RecordingExecutor
+}
+
+// LaunchPlanTriggerQueueConfig configures how the launch plan triggers fired by terminal executions are processed.
+// Executions only enqueue the triggers they fire, which every admin replica then claims and fires from the database.
+type LaunchPlanTriggerQueueConfig struct {
+	// How often the queue is polled for triggers to fire.
+	PollInterval config.Duration `json:"pollInterval"`
+	// The maximum number of triggers claimed per poll.
+	BatchSize int `json:"batchSize"`
+	// How long a claimed trigger stays hidden from other replicas. A trigger which fails to fire becomes visible again
+	// once this timeout has passed.
+	VisibilityTimeout config.Duration `json:"visibilityTimeout"`
+	// The number of attempts after which a trigger which fails to fire is moved to the dead-letter state.
+	MaxAttempts uint32 `json:"maxAttempts"`
+}
+
+// RecordingExecutorConfig configures the workflow executor which records the prepared Flyte workflows of executions
+// rather than creating them in Kubernetes, so that admin runs end to end with only Postgres. Executions are routed to
+// it by the "workflow_executor" plugin override with the "RecordingExecutor" plugin ID.
+type RecordingExecutorConfig struct {
+	// Whether the recording executor is registered as a selectable workflow executor.
+	Enabled bool `json:"enabled"`
+	// Whether admin runs without Kubernetes at all: the recording executor replaces the Kubernetes one as the default
+	// workflow executor, and no execution cluster is used. Requires Enabled.
+	WithoutKubernetes bool `json:"withoutKubernetes"`
+	// Directory the Flyte workflows are written to as JSON, under a directory per namespace. The workflows are kept in
+	// memory when no directory is set.
+	Directory string `json:"directory"`
+	// Whether recorded executions are moved to the running and then the succeeded phase by workflow events, as
+	// propeller would report them.
+	SimulatePhases bool `json:"simulatePhases"`
+	// How long each simulated phase lasts.
+	PhaseInterval config.Duration `json:"phaseInterval"`
+}
+
 // This section holds common config for AWS
 type AWSConfig struct {
 	Region string `json:"region"`
@@ -177,6 +243,113 @@ func (a *AWSSchedulerConfig) GetScheduleNamePrefix() string {
 
 // FlyteSchedulerConfig is the config for native or default flyte scheduler
 type FlyteSchedulerConfig struct {
+	// Elects a single leader among the replicas of the native scheduler so that schedules are only fired once.
+	LeaderElection SchedulerLeaderElectionConfig `json:"leaderElection"`
+	// Limits on the schedule backfills which can be requested through admin.
+	Backfill ScheduleBackfillConfig `json:"backfill"`
+	// Which of the schedule times missed while the scheduler was down are fired once it is back up.
+	Catchup ScheduleCatchupConfig `json:"catchup"`
+	// Polling of the storage prefixes which launch plans with a storage trigger are launched for.
+	StorageTriggers StorageTriggersConfig `json:"storageTriggers"`
+	// Named calendars of the days on which schedules referencing them don't fire, e.g. exchange holidays.
+	Calendars map[string]ScheduleCalendarConfig `json:"calendars"`
+	// How the scheduler learns of the schedules activated and deactivated through admin.
+	ScheduleUpdates ScheduleUpdatesConfig `json:"scheduleUpdates"`
+}
+
+func (f *FlyteSchedulerConfig) GetLeaderElection() SchedulerLeaderElectionConfig {
+	return f.LeaderElection
+}
+
+func (f *FlyteSchedulerConfig) GetBackfill() ScheduleBackfillConfig {
+	return f.Backfill
+}
+
+func (f *FlyteSchedulerConfig) GetCatchup() ScheduleCatchupConfig {
+	return f.Catchup
+}
+
+func (f *FlyteSchedulerConfig) GetStorageTriggers() StorageTriggersConfig {
+	return f.StorageTriggers
+}
+
+func (f *FlyteSchedulerConfig) GetCalendars() map[string]ScheduleCalendarConfig {
+	return f.Calendars
+}
+
+func (f *FlyteSchedulerConfig) GetScheduleUpdates() ScheduleUpdatesConfig {
+	return f.ScheduleUpdates
+}
+
+type ScheduleBackfillConfig struct {
+	// Maximum number of executions a single backfill may create.
+	MaxExecutions uint32 `json:"maxExecutions"`
+	// Maximum number of executions a backfill may create concurrently.
+	MaxParallelism uint32 `json:"maxParallelism"`
+}
+
+type ScheduleCatchupConfig struct {
+	// Policy of the schedules whose launch plan doesn't set one with the flyte.org/catchup-policy annotation. One of
+	// all, latest-only, none or max-N to fire at most the N most recent missed times.
+	DefaultPolicy string `json:"defaultPolicy"`
+	// Missed schedule times older than this are never fired. Zero disables the lookback window.
+	Lookback config.Duration `json:"lookback"`
+}
+
+// ScheduleCalendarConfig lists the days a calendar excludes. A day is excluded if any of the lists matches it.
+type ScheduleCalendarConfig struct {
+	// Dates excluded once, as YYYY-MM-DD.
+	Dates []string `json:"dates"`
+	// Dates excluded every year, as MM-DD.
+	AnnualDates []string `json:"annualDates"`
+	// Days of the week excluded, e.g. Saturday.
+	Weekdays []string `json:"weekdays"`
+	// IANA timezone on whose wall clock the days start and end. Defaults to UTC.
+	Timezone string `json:"timezone"`
+}
+
+// ScheduleUpdatesNotifierPostgres notifies the scheduler of schedule updates using Postgres LISTEN/NOTIFY.
+const ScheduleUpdatesNotifierPostgres = "postgres"
+
+type ScheduleUpdatesConfig struct {
+	// Channel through which admin notifies the scheduler of the schedules it activates and deactivates, so that the
+	// scheduler applies them right away. Either postgres or empty to only poll the schedules.
+	Notifier string `json:"notifier"`
+	// How often the scheduler reads all the schedules to apply their updates when no notifier is configured.
+	PollInterval config.Duration `json:"pollInterval"`
+	// How often the scheduler reads all the schedules when a notifier is configured, to reconcile the updates whose
+	// notifications were missed.
+	ReconcileInterval config.Duration `json:"reconcileInterval"`
+}
+
+type StorageTriggersConfig struct {
+	// Whether the scheduler polls the storage prefixes of the storage triggers. The objects are listed using the
+	// storage configuration of the scheduler.
+	Enabled bool `json:"enabled"`
+	// Lower bound on the poll interval of the storage triggers, shorter intervals requested by launch plans are raised
+	// to it.
+	MinPollInterval config.Duration `json:"minPollInterval"`
+	// Maximum number of new objects for which a storage trigger launches executions in a single poll. The remaining
+	// objects are picked up by the following polls.
+	MaxObjectsPerPoll int `json:"maxObjectsPerPoll"`
+	// How long before the watermark objects may have been last modified and still be launched for, when they weren't
+	// already. Multipart uploads and copies can show up with a last modified time earlier than when they appeared.
+	LateObjectGracePeriod config.Duration `json:"lateObjectGracePeriod"`
+}
+
+// SchedulerLeaderElectionConfig configures the lease in the scheduler tables which scheduler replicas compete for.
+// Lease expiry is compared across replicas so their clocks are expected to be in sync.
+type SchedulerLeaderElectionConfig struct {
+	// Whether to run leader election. When disabled every replica fires all the schedules.
+	Enabled bool `json:"enabled"`
+	// Name of the lease. Replicas sharing a database but using different names elect independent leaders.
+	LeaseName string `json:"leaseName"`
+	// How long the lease is held after it was last renewed. A standby takes over once the lease expires.
+	LeaseDuration config.Duration `json:"leaseDuration"`
+	// How often the leader renews the lease. This should be well below the lease duration.
+	RenewInterval config.Duration `json:"renewInterval"`
+	// How often a standby attempts to acquire the lease.
+	RetryInterval config.Duration `json:"retryInterval"`
 }
 
 // This section holds configuration for the executor that processes workflow scheduled events fired.
@@ -371,6 +544,123 @@ type NotificationsEmailerConfig struct {
 	Body string `json:"body"`
 }
 
+// NotificationTriggerType names the condition on which a NotificationTrigger fires.
+type NotificationTriggerType = string
+
+const (
+	// NotificationTriggerTypeTaskRetriesExhausted fires when a task execution fails on its final retry attempt.
+	NotificationTriggerTypeTaskRetriesExhausted NotificationTriggerType = "taskRetriesExhausted"
+	// NotificationTriggerTypeNodeFailure fires when a node execution fails, regardless of whether the workflow
+	// execution continues.
+	NotificationTriggerTypeNodeFailure NotificationTriggerType = "nodeFailure"
+	// NotificationTriggerTypeLongRunning fires once when a non-terminal workflow execution has been running for longer
+	// than the configured threshold.
+	NotificationTriggerTypeLongRunning NotificationTriggerType = "longRunning"
+)
+
+// NotificationTrigger describes a notification which is sent on task, node or execution state changes beyond the
+// terminal workflow execution phases declared in launch plan notification specs.
+// Triggers are matched against executions the same way matchable attributes are: an empty project, domain, workflow
+// or launch plan name matches all values. Launch plans declare their own triggers through annotations, which add to
+// the triggers configured here. Triggers can't be declared as matchable attributes, so project and domain wide
+// triggers are configured here only.
+type NotificationTrigger struct {
+	Type       NotificationTriggerType `json:"type"`
+	Project    string                  `json:"project"`
+	Domain     string                  `json:"domain"`
+	Workflow   string                  `json:"workflow"`
+	LaunchPlan string                  `json:"launchPlan"`
+	// Only applies to nodeFailure triggers. When empty, the failure of any node fires the trigger.
+	NodeIDs []string `json:"nodeIds"`
+	// Only applies to longRunning triggers. The time since execution creation after which the trigger fires.
+	RunningThreshold config.Duration `json:"runningThreshold"`
+	// Recipients of the notification email.
+	RecipientsEmail []string `json:"recipientsEmail"`
+	// The optionally templatized subject and body used in place of the defaults for the trigger type.
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+// Configuration for the database-backed notifications queue. Any number of admin replicas may process the queue
+// concurrently.
+type NotificationsDBQueueConfig struct {
+	// Whether notifications are enqueued in and processed from the database when the notifications type is 'local'.
+	Enabled bool `json:"enabled"`
+	// How often the queue is polled for pending notifications.
+	PollInterval config.Duration `json:"pollInterval"`
+	// The maximum number of notifications claimed per poll.
+	BatchSize int `json:"batchSize"`
+	// How long a claimed notification stays hidden from other processors. A notification which fails to deliver
+	// becomes visible again once this timeout has passed.
+	VisibilityTimeout config.Duration `json:"visibilityTimeout"`
+	// The number of delivery attempts after which a notification is moved to the dead-letter state. Unused when the
+	// delivery log is enabled, in which case its maxAttempts applies.
+	MaxAttempts uint32 `json:"maxAttempts"`
+}
+
+// Configuration for the notification delivery log.
+type NotificationsDeliveryLogConfig struct {
+	// Whether published emails and webhook calls and their delivery attempts are recorded in the database. Failed
+	// deliveries are left on the processor's queue to be retried, rather than dropped.
+	Enabled bool `json:"enabled"`
+	// The number of failed delivery attempts after which a notification is moved to the dead-letter state and is no
+	// longer retried until it is re-driven. This applies to every notifications queue, including the database one.
+	MaxAttempts uint32 `json:"maxAttempts"`
+}
+
+// WebhookSecret names where a secret is read from. Only one of these should be set.
+type WebhookSecret struct {
+	EnvVar   string `json:"envVar"`
+	FilePath string `json:"filePath"`
+}
+
+// Webhook describes an HTTP endpoint which is POSTed to when a matching execution changes phase.
+// Webhooks are matched against executions the same way notification triggers are: an empty project, domain, workflow
+// or launch plan name matches all values.
+type Webhook struct {
+	// Unique name of the webhook, used to look up its configuration when the call is processed.
+	Name       string `json:"name"`
+	URL        string `json:"url"`
+	Project    string `json:"project"`
+	Domain     string `json:"domain"`
+	Workflow   string `json:"workflow"`
+	LaunchPlan string `json:"launchPlan"`
+	// Workflow execution phases, e.g. SUCCEEDED or FAILED, which call the webhook. When empty, every phase change does.
+	Phases []string `json:"phases"`
+	// Headers sent with every call.
+	Headers map[string]string `json:"headers"`
+	// Headers whose values are read from secrets when the call is made.
+	SecretHeaders map[string]WebhookSecret `json:"secretHeaders"`
+	// The optionally templatized JSON body. Substituted values are JSON escaped.
+	Body string `json:"body"`
+	// When set, calls carry the hex encoded HMAC-SHA256 of the body keyed with this secret.
+	SigningSecret WebhookSecret   `json:"signingSecret"`
+	Timeout       config.Duration `json:"timeout"`
+}
+
+// LaunchPlanWebhooksConfig configures the webhooks launch plans declare through annotations.
+type LaunchPlanWebhooksConfig struct {
+	// URL prefixes launch plan webhooks may call, e.g. https://hooks.example.com/flyte/. Webhook URLs must have the
+	// scheme and host of a prefix and a path under its path. Launch plans can't declare webhooks unless at least one
+	// prefix is allowed.
+	AllowedURLPrefixes []string `json:"allowedUrlPrefixes"`
+	// When set, calls carry the hex encoded HMAC-SHA256 of the body keyed with this secret.
+	SigningSecret WebhookSecret   `json:"signingSecret"`
+	Timeout       config.Duration `json:"timeout"`
+}
+
+// This section configures the background checker which evaluates longRunning notification triggers.
+type LongRunningCheckerConfig struct {
+	// Whether this admin instance evaluates longRunning triggers. Any number of replicas may enable the checker, as
+	// each notification is recorded in the database before it's published.
+	Enabled bool `json:"enabled"`
+	// How often non-terminal executions are checked against the configured thresholds.
+	Interval config.Duration `json:"interval"`
+	// The longest threshold launch plans may declare in their longRunning trigger annotations, 0 disables such
+	// triggers.
+	MaxLaunchPlanThreshold config.Duration `json:"maxLaunchPlanThreshold"`
+}
+
 // This section handles configuration for the workflow notifications pipeline.
 type EventsPublisherConfig struct {
 	// The topic which events should be published, e.g. node, task, workflow
@@ -410,6 +700,21 @@ type NotificationsConfig struct {
 	ReconnectAttempts int `json:"reconnectAttempts"`
 	// Specifies the time interval to wait before attempting to reconnect the notifications processor client.
 	ReconnectDelaySeconds int `json:"reconnectDelaySeconds"`
+	// Database-backed queue used in place of a cloud queue when the type is 'local'.
+	DBQueueConfig NotificationsDBQueueConfig `json:"dbQueue"`
+	// Records every notification delivery attempt in the database.
+	DeliveryLogConfig NotificationsDeliveryLogConfig `json:"deliveryLog"`
+	// Additional notifications sent on task retry exhaustion, node failures and long-running executions.
+	Triggers []NotificationTrigger `json:"triggers"`
+	// Whether launch plans may declare notification triggers through annotations. Unless enabled, or triggers of the
+	// same type are configured above, failed task and node events don't look up the triggers of their execution.
+	LaunchPlanTriggersEnabled bool                     `json:"launchPlanTriggersEnabled"`
+	LongRunningChecker        LongRunningCheckerConfig `json:"longRunningChecker"`
+	// HTTP endpoints called by the notifications processor when executions change phase. Each delivery makes a single
+	// call. Failed calls are redelivered by the database queue, or by cloud queues when the delivery log is enabled to
+	// bound the attempts. These are operator defaults which apply in addition to the webhooks declared by launch plans.
+	Webhooks           []Webhook                `json:"webhooks"`
+	LaunchPlanWebhooks LaunchPlanWebhooksConfig `json:"launchPlanWebhooks"`
 }
 
 // Domains are always globally set in the application config, whereas individual projects can be individually registered.
@@ -3,6 +3,7 @@ package interfaces
 import (
 	"io/ioutil"
 
+	"github.com/flyteorg/flytestdlib/config"
 	"github.com/pkg/errors"
 )
 
@@ -12,6 +13,11 @@ type ClusterConfig struct {
 	Endpoint string `json:"endpoint"`
 	Auth     Auth   `json:"auth"`
 	Enabled  bool   `json:"enabled"`
+	// Whether the cluster is assigned no new executions while the executions running on it complete.
+	Draining bool `json:"draining"`
+	// Optional number of concurrent executions the cluster is sized for. The least loaded selector compares clusters
+	// by their running executions relative to their capacity.
+	Capacity int `json:"capacity"`
 }
 
 type Auth struct {
@@ -41,9 +47,86 @@ func (auth Auth) GetToken() (string, error) {
 	return string(token), nil
 }
 
+// ClusterHealthCheckConfig configures the probes of the execution clusters. Clusters failing their probes are assigned
+// no new executions until they recover.
+type ClusterHealthCheckConfig struct {
+	// Whether the clusters are probed.
+	Enabled bool `json:"enabled"`
+	// How often the clusters are probed.
+	Interval config.Duration `json:"interval"`
+	// Timeout of each request made by a probe.
+	Timeout config.Duration `json:"timeout"`
+	// Number of consecutive failed probes after which a cluster is unhealthy. A single successful probe makes it
+	// healthy again.
+	FailureThreshold int `json:"failureThreshold"`
+	// Optional lease which flytepropeller renews while it runs, as namespace/name. Clusters whose lease wasn't renewed
+	// within PropellerLeaseMaxAge fail their probes.
+	PropellerLease string `json:"propellerLease"`
+	// How long ago the propeller lease may have been renewed last.
+	PropellerLeaseMaxAge config.Duration `json:"propellerLeaseMaxAge"`
+}
+
+// Names of the strategies selecting the cluster executions are assigned to.
+const (
+	// Picks a cluster at random, by the weights of the clusters of the execution cluster label.
+	ClusterSelectorRandom = "random"
+	// Picks the cluster running the fewest executions, relative to its capacity, of the execution cluster label.
+	ClusterSelectorLeastLoaded = "leastLoaded"
+)
+
+type ClusterSelectorConfig struct {
+	// Name of the strategy selecting the cluster of an execution. Defaults to random.
+	Name string `json:"name"`
+	// How often the least loaded selector refreshes the number of running executions per cluster.
+	LoadRefreshInterval config.Duration `json:"loadRefreshInterval"`
+	// Whether executions launched by launch plan nodes prefer the cluster of the execution which launched them.
+	// Relaunched and recovered executions always prefer the cluster of the execution they were relaunched from.
+	ColocateChildExecutions bool `json:"colocateChildExecutions"`
+}
+
+// Sources of the execution clusters.
+const (
+	// The clusters are read from ClusterConfigs and LabelClusterMap at startup.
+	ClusterRegistrySourceConfig = "config"
+	// The clusters are managed through the admin API and reloaded without a restart.
+	ClusterRegistrySourceDatabase = "database"
+)
+
+type ClusterRegistryConfig struct {
+	// Where the clusters are read from. Defaults to config.
+	Source string `json:"source"`
+	// How often the clusters are reloaded from the database.
+	RefreshInterval config.Duration `json:"refreshInterval"`
+	// Directory the token and CA files of registered clusters must be in, e.g. where their secrets are mounted. No
+	// credential files can be registered when it's unset.
+	CredentialsDirectory string `json:"credentialsDirectory"`
+	// Hosts the endpoints of registered clusters must be on. Endpoints on any host can be registered when it's empty.
+	AllowedEndpointHosts []string `json:"allowedEndpointHosts"`
+}
+
 type Clusters struct {
 	ClusterConfigs  []ClusterConfig            `json:"clusterConfigs"`
 	LabelClusterMap map[string][]ClusterEntity `json:"labelClusterMap"`
+	HealthCheck     ClusterHealthCheckConfig   `json:"healthCheck"`
+	Selector        ClusterSelectorConfig      `json:"selector"`
+	Registry        ClusterRegistryConfig      `json:"registry"`
+	Failover        ClusterFailoverConfig      `json:"failover"`
+	Credentials     ClusterCredentialsConfig   `json:"credentials"`
+}
+
+// ClusterFailoverConfig bounds how often the creation of an execution is retried on another cluster of its label after
+// creating it on the selected cluster failed.
+type ClusterFailoverConfig struct {
+	// How many clusters the creation of an execution is attempted on, including the first. 1 disables failover.
+	MaxAttempts int `json:"maxAttempts"`
+}
+
+// ClusterCredentialsConfig configures how the token and CA files of the clusters are re-read, so that rotated
+// credentials are picked up without a restart.
+type ClusterCredentialsConfig struct {
+	// How often the credential files are re-read. The clients of a cluster are rebuilt whenever its credentials changed.
+	// 0 disables re-reading the credentials.
+	RefreshInterval config.Duration `json:"refreshInterval"`
 }
 
 // Provides values set in runtime configuration files.
@@ -54,4 +137,19 @@ type ClusterConfiguration interface {
 
 	// Returns label cluster map for routing
 	GetLabelClusterMap() map[string][]ClusterEntity
+
+	// Returns the configuration of the cluster health probes
+	GetHealthCheckConfig() ClusterHealthCheckConfig
+
+	// Returns the configuration of the strategy selecting the cluster of an execution
+	GetSelectorConfig() ClusterSelectorConfig
+
+	// Returns the configuration of where the clusters are read from
+	GetRegistryConfig() ClusterRegistryConfig
+
+	// Returns the configuration of retrying the creation of executions on other clusters
+	GetFailoverConfig() ClusterFailoverConfig
+
+	// Returns the configuration of re-reading the credentials of the clusters
+	GetCredentialsConfig() ClusterCredentialsConfig
 }
@@ -2,12 +2,18 @@ package impl
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
 	"github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
 	execClusterInterfaces "github.com/flyteorg/flyteadmin/pkg/executioncluster/interfaces"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/workflowengine/interfaces"
+	"github.com/flyteorg/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
 	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc/codes"
 	k8_api_err "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -17,11 +23,22 @@ var deletePropagationBackground = v1.DeletePropagationBackground
 
 const defaultIdentifier = "DefaultK8sExecutor"
 
+const clusterLabel = "cluster"
+
+type k8sExecutorMetrics struct {
+	Scope promutils.Scope
+	// Creations of executions which failed on the cluster and were retried on another one.
+	Failovers *prometheus.CounterVec
+}
+
 // K8sWorkflowExecutor directly creates and delete Flyte workflow execution CRD objects using the configured execution
 // cluster interface.
 type K8sWorkflowExecutor struct {
 	executionCluster execClusterInterfaces.ClusterInterface
 	workflowBuilder  interfaces.FlyteWorkflowBuilder
+	// How many clusters the creation of an execution is attempted on.
+	maxAttempts int
+	metrics     k8sExecutorMetrics
 }
 
 func (e K8sWorkflowExecutor) ID() string {
@@ -29,39 +46,120 @@ func (e K8sWorkflowExecutor) ID() string {
 }
 
 func (e K8sWorkflowExecutor) Execute(ctx context.Context, data interfaces.ExecutionData) (interfaces.ExecutionResponse, error) {
-	// TODO: Reduce CRD size and use offloaded input URI to blob store instead.
-	flyteWf, err := e.workflowBuilder.Build(data.WorkflowClosure, data.ExecutionParameters.Inputs, data.ExecutionID, data.Namespace)
+	flyteWf, err := buildFlyteWorkflow(ctx, e.workflowBuilder, data)
+	if err != nil {
+		return interfaces.ExecutionResponse{}, err
+	}
+
+	executionTargetSpec := executioncluster.ExecutionTargetSpec{
+		Project:           data.ExecutionID.Project,
+		Domain:            data.ExecutionID.Domain,
+		Workflow:          data.ReferenceWorkflowName,
+		LaunchPlan:        data.ReferenceWorkflowName,
+		ExecutionID:       data.ExecutionID.Name,
+		PreferredTargetID: data.PreferredCluster,
+	}
+	// The first attempt goes to the preferred cluster, or the one seeded by the execution name. Should creating the
+	// workflow fail there, it's attempted on the next cluster selected from the remaining ones, up to maxAttempts
+	// clusters.
+	var failedTargets []*executioncluster.ExecutionTarget
+	var createErr error
+	for attempt := 1; ; attempt++ {
+		targetCluster, err := e.executionCluster.GetTarget(ctx, &executionTargetSpec)
+		if err == nil && executionTargetSpec.IsExcluded(targetCluster.ID) {
+			err = fmt.Errorf("no cluster left to fail over to from %v", executionTargetSpec.ExcludedTargetIDs)
+		}
+		if err != nil {
+			if createErr != nil {
+				logger.Warnf(ctx, "Failed to select a cluster to fail over execution [%+v] to: %v", data.ExecutionID, err)
+				err = createErr
+			}
+			return interfaces.ExecutionResponse{}, errors.NewFlyteAdminErrorf(codes.Internal, "failed to create workflow in propeller %v", err)
+		}
+		createErr = e.createWorkflow(ctx, targetCluster, data.Namespace, flyteWf)
+		if createErr == nil {
+			if len(failedTargets) > 0 {
+				logger.Infof(ctx, "Created execution [%+v] in cluster [%s] after failing over from %v",
+					data.ExecutionID, targetCluster.ID, executionTargetSpec.ExcludedTargetIDs)
+				e.deleteFailedWorkflows(ctx, failedTargets, data.Namespace, data.ExecutionID.Name)
+			}
+			return interfaces.ExecutionResponse{
+				Cluster: targetCluster.ID,
+			}, nil
+		}
+		logger.Warnf(ctx, "Failed to create execution [%+v] in cluster [%s]: %v", data.ExecutionID, targetCluster.ID, createErr)
+		if attempt >= e.maxAttempts || !canFailOver(createErr) {
+			return interfaces.ExecutionResponse{}, errors.NewFlyteAdminErrorf(codes.Internal, "failed to create workflow in propeller %v", createErr)
+		}
+		e.metrics.Failovers.WithLabelValues(targetCluster.ID).Inc()
+		failedTargets = append(failedTargets, targetCluster)
+		executionTargetSpec.ExcludedTargetIDs = append(executionTargetSpec.ExcludedTargetIDs, targetCluster.ID)
+	}
+}
+
+// Builds the Flyte workflow of the execution, ready to be created.
+func buildFlyteWorkflow(ctx context.Context, workflowBuilder interfaces.FlyteWorkflowBuilder,
+	data interfaces.ExecutionData) (*v1alpha1.FlyteWorkflow, error) {
+	flyteWf, err := workflowBuilder.Build(data.WorkflowClosure, data.ExecutionParameters.Inputs, data.ExecutionID, data.Namespace)
 	if err != nil {
 		logger.Infof(ctx, "failed to build the workflow [%+v] %v",
 			data.WorkflowClosure.Primary.Template.Id, err)
-		return interfaces.ExecutionResponse{}, err
+		return nil, err
 	}
 	err = PrepareFlyteWorkflow(data, flyteWf)
 	if err != nil {
-		return interfaces.ExecutionResponse{}, err
+		return nil, err
+	}
+	if err = checkWorkflowSize(data.ExecutionParameters.MaxCRDSizeBytes, flyteWf); err != nil {
+		return nil, err
 	}
+	return flyteWf, nil
+}
 
-	executionTargetSpec := executioncluster.ExecutionTargetSpec{
-		Project:     data.ExecutionID.Project,
-		Domain:      data.ExecutionID.Domain,
-		Workflow:    data.ReferenceWorkflowName,
-		LaunchPlan:  data.ReferenceWorkflowName,
-		ExecutionID: data.ExecutionID.Name,
+// Rejects workflows too large for etcd to store up front, rather than attempting to create them on every cluster.
+func checkWorkflowSize(maxSizeBytes int, flyteWf *v1alpha1.FlyteWorkflow) error {
+	if maxSizeBytes <= 0 {
+		return nil
 	}
-	targetCluster, err := e.executionCluster.GetTarget(ctx, &executionTargetSpec)
+	serialized, err := json.Marshal(flyteWf)
 	if err != nil {
-		return interfaces.ExecutionResponse{}, errors.NewFlyteAdminErrorf(codes.Internal, "failed to create workflow in propeller %v", err)
+		return errors.NewFlyteAdminErrorf(codes.Internal, "failed to serialize the workflow: %v", err)
 	}
-	_, err = targetCluster.FlyteClient.FlyteworkflowV1alpha1().FlyteWorkflows(data.Namespace).Create(ctx, flyteWf, v1.CreateOptions{})
-	if err != nil {
-		if !k8_api_err.IsAlreadyExists(err) {
-			logger.Debugf(context.TODO(), "Failed to create execution [%+v] in cluster: %s", data.ExecutionID, targetCluster.ID)
-			return interfaces.ExecutionResponse{}, errors.NewFlyteAdminErrorf(codes.Internal, "failed to create workflow in propeller %v", err)
+	if len(serialized) > maxSizeBytes {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"the workflow execution is %d bytes, which exceeds the limit of %d bytes; reduce the size of the workflow "+
+				"or its inputs", len(serialized), maxSizeBytes)
+	}
+	return nil
+}
+
+func (e K8sWorkflowExecutor) createWorkflow(ctx context.Context, target *executioncluster.ExecutionTarget,
+	namespace string, flyteWf *v1alpha1.FlyteWorkflow) error {
+	_, err := target.FlyteClient.FlyteworkflowV1alpha1().FlyteWorkflows(namespace).Create(ctx, flyteWf, v1.CreateOptions{})
+	if err != nil && !k8_api_err.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// Requests rejected as invalid fail the same way on every cluster, whereas e.g. timeouts are worth retrying elsewhere.
+func canFailOver(err error) bool {
+	return !k8_api_err.IsInvalid(err) && !k8_api_err.IsBadRequest(err)
+}
+
+// A failed create, e.g. one which timed out, may still have created the workflow. Such workflows are deleted on a best
+// effort basis so that the execution doesn't also run on the clusters failed over from.
+func (e K8sWorkflowExecutor) deleteFailedWorkflows(ctx context.Context, failedTargets []*executioncluster.ExecutionTarget,
+	namespace, name string) {
+	for _, target := range failedTargets {
+		err := target.FlyteClient.FlyteworkflowV1alpha1().FlyteWorkflows(namespace).Delete(ctx, name, v1.DeleteOptions{
+			PropagationPolicy: &deletePropagationBackground,
+		})
+		if err != nil && !k8_api_err.IsNotFound(err) {
+			logger.Warnf(ctx, "Failed to delete workflow [%s/%s] from cluster [%s] failed over from: %v",
+				namespace, name, target.ID, err)
 		}
 	}
-	return interfaces.ExecutionResponse{
-		Cluster: targetCluster.ID,
-	}, nil
 }
 
 func (e K8sWorkflowExecutor) Abort(ctx context.Context, data interfaces.AbortData) error {
@@ -82,10 +180,17 @@ func (e K8sWorkflowExecutor) Abort(ctx context.Context, data interfaces.AbortDat
 }
 
 func NewK8sWorkflowExecutor(executionCluster execClusterInterfaces.ClusterInterface,
-	workflowBuilder interfaces.FlyteWorkflowBuilder) *K8sWorkflowExecutor {
+	workflowBuilder interfaces.FlyteWorkflowBuilder, failoverConfig runtimeInterfaces.ClusterFailoverConfig,
+	scope promutils.Scope) *K8sWorkflowExecutor {
 
 	return &K8sWorkflowExecutor{
 		executionCluster: executionCluster,
 		workflowBuilder:  workflowBuilder,
+		maxAttempts:      failoverConfig.MaxAttempts,
+		metrics: k8sExecutorMetrics{
+			Scope: scope,
+			Failovers: scope.MustNewCounterVec("cluster_failovers",
+				"count of executions whose creation failed on the cluster and was retried on another one", clusterLabel),
+		},
 	}
 }
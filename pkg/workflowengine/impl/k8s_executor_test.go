@@ -3,11 +3,18 @@ package impl
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
 	"github.com/flyteorg/flyteadmin/pkg/workflowengine/mocks"
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
 
 	"github.com/flyteorg/flyteadmin/pkg/workflowengine/interfaces"
 	"github.com/stretchr/testify/assert"
@@ -222,6 +229,137 @@ func TestExecute_MiscError(t *testing.T) {
 	assert.EqualError(t, err, "failed to create workflow in propeller call failed")
 }
 
+func TestExecute_TooLarge(t *testing.T) {
+	fakeFlyteWorkflow := FakeFlyteWorkflow{}
+	fakeFlyteWorkflow.createCallback = func(flyteWorkflow *v1alpha1.FlyteWorkflow, opts v1.CreateOptions) (*v1alpha1.FlyteWorkflow, error) {
+		assert.Fail(t, "workflows exceeding the size limit mustn't be created")
+		return nil, nil
+	}
+	fakeFlyteWF.flyteWorkflowsCallback = func(ns string) v1alpha12.FlyteWorkflowInterface {
+		return &fakeFlyteWorkflow
+	}
+	mockBuilder := mocks.FlyteWorkflowBuilder{}
+	mockBuilder.OnBuildMatch(mock.Anything, mock.Anything, mock.Anything, namespace).Return(
+		&v1alpha1.FlyteWorkflow{
+			Inputs: &v1alpha1.Inputs{LiteralMap: testInputs},
+		}, nil)
+	executor := K8sWorkflowExecutor{
+		workflowBuilder:  &mockBuilder,
+		executionCluster: getFakeExecutionCluster(),
+	}
+
+	_, err := executor.Execute(context.TODO(), interfaces.ExecutionData{
+		Namespace:   namespace,
+		ExecutionID: execID,
+		ExecutionParameters: interfaces.ExecutionParameters{
+			Inputs:          testInputs,
+			MaxCRDSizeBytes: 64,
+		},
+	})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+type failoverFlyteClient struct {
+	flyteclient.Interface
+	workflows *FakeFlyteWorkflowV1alpha1
+}
+
+func (c *failoverFlyteClient) FlyteworkflowV1alpha1() v1alpha12.FlyteworkflowV1alpha1Interface {
+	return c.workflows
+}
+
+// Returns a cluster selecting the clusters in order, skipping the excluded ones, whose creates fail with the given
+// errors. Records the clusters workflows were created on and deleted from.
+func getFailoverExecutionCluster(createErrs []error, created, deleted *[]string) execClusterIfaces.ClusterInterface {
+	targets := make([]*executioncluster.ExecutionTarget, 0, len(createErrs))
+	for idx, createErr := range createErrs {
+		id := fmt.Sprintf("C%d", idx+1)
+		createErr := createErr
+		workflow := &FakeFlyteWorkflow{
+			createCallback: func(*v1alpha1.FlyteWorkflow, v1.CreateOptions) (*v1alpha1.FlyteWorkflow, error) {
+				*created = append(*created, id)
+				return nil, createErr
+			},
+			deleteCallback: func(name string, options *v1.DeleteOptions) error {
+				*deleted = append(*deleted, id)
+				return nil
+			},
+		}
+		targets = append(targets, &executioncluster.ExecutionTarget{
+			ID: id,
+			FlyteClient: &failoverFlyteClient{workflows: &FakeFlyteWorkflowV1alpha1{
+				flyteWorkflowsCallback: func(string) v1alpha12.FlyteWorkflowInterface {
+					return workflow
+				},
+			}},
+		})
+	}
+	fakeCluster := clusterMock.MockCluster{}
+	fakeCluster.SetGetTargetCallback(func(ctx context.Context, spec *executioncluster.ExecutionTargetSpec) (*executioncluster.ExecutionTarget, error) {
+		for _, target := range targets {
+			if !spec.IsExcluded(target.ID) {
+				return target, nil
+			}
+		}
+		return nil, errors.New("no enabled cluster targets")
+	})
+	return &fakeCluster
+}
+
+func TestExecute_Failover(t *testing.T) {
+	timeout := k8_api_err.NewTimeoutError("create timed out", 1)
+	mockBuilder := mocks.FlyteWorkflowBuilder{}
+	mockBuilder.OnBuildMatch(mock.Anything, mock.Anything, mock.Anything, namespace).Return(flyteWf, nil)
+	data := interfaces.ExecutionData{
+		Namespace:             namespace,
+		ExecutionID:           execID,
+		ReferenceWorkflowName: "ref_workflow_name",
+	}
+	failoverConfig := runtimeInterfaces.ClusterFailoverConfig{MaxAttempts: 3}
+
+	t.Run("succeeds on another cluster", func(t *testing.T) {
+		var created, deleted []string
+		scope := promutils.NewTestScope()
+		executor := NewK8sWorkflowExecutor(getFailoverExecutionCluster([]error{timeout, nil}, &created, &deleted),
+			&mockBuilder, failoverConfig, scope)
+		resp, err := executor.Execute(context.TODO(), data)
+		assert.NoError(t, err)
+		assert.Equal(t, "C2", resp.Cluster)
+		assert.Equal(t, []string{"C1", "C2"}, created)
+		// The timed out create may have succeeded regardless.
+		assert.Equal(t, []string{"C1"}, deleted)
+		assert.Equal(t, float64(1), testutil.ToFloat64(executor.metrics.Failovers.WithLabelValues("C1")))
+	})
+	t.Run("bounded attempts", func(t *testing.T) {
+		var created, deleted []string
+		executor := NewK8sWorkflowExecutor(
+			getFailoverExecutionCluster([]error{timeout, timeout, timeout, nil}, &created, &deleted),
+			&mockBuilder, failoverConfig, promutils.NewTestScope())
+		_, err := executor.Execute(context.TODO(), data)
+		assert.EqualError(t, err, "failed to create workflow in propeller Timeout: create timed out")
+		assert.Equal(t, []string{"C1", "C2", "C3"}, created)
+		assert.Empty(t, deleted)
+	})
+	t.Run("no cluster left", func(t *testing.T) {
+		var created, deleted []string
+		executor := NewK8sWorkflowExecutor(getFailoverExecutionCluster([]error{timeout}, &created, &deleted),
+			&mockBuilder, failoverConfig, promutils.NewTestScope())
+		_, err := executor.Execute(context.TODO(), data)
+		assert.EqualError(t, err, "failed to create workflow in propeller Timeout: create timed out")
+		assert.Equal(t, []string{"C1"}, created)
+	})
+	t.Run("invalid workflow", func(t *testing.T) {
+		var created, deleted []string
+		invalid := k8_api_err.NewInvalid(schema.GroupKind{}, execID.Name, nil)
+		executor := NewK8sWorkflowExecutor(getFailoverExecutionCluster([]error{invalid, nil}, &created, &deleted),
+			&mockBuilder, failoverConfig, promutils.NewTestScope())
+		_, err := executor.Execute(context.TODO(), data)
+		assert.Error(t, err)
+		assert.Equal(t, []string{"C1"}, created)
+	})
+}
+
 func TestAbort(t *testing.T) {
 	fakeFlyteWorkflow := FakeFlyteWorkflow{}
 	fakeFlyteWorkflow.deleteCallback = func(name string, options *v1.DeleteOptions) error {
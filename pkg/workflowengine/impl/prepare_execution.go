@@ -6,10 +6,14 @@ import (
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+	"github.com/flyteorg/flytestdlib/storage"
 	"google.golang.org/grpc/codes"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// Annotation of Flyte workflow CRDs built without their inputs, holding the location the inputs were offloaded to.
+const InputsURIAnnotation = "flyte.org/inputs-uri"
+
 func addMapValues(overrides map[string]string, defaultValues map[string]string) map[string]string {
 	if defaultValues == nil {
 		defaultValues = map[string]string{}
@@ -101,6 +105,17 @@ func addExecutionOverrides(taskPluginOverrides []*admin.PluginOverride,
 	flyteWf.ExecutionConfig = executionConfig
 }
 
+// Replaces the inputs, which were already validated against the workflow when it was built, with a reference to the
+// location they were offloaded to.
+func offloadInputs(inputsURI storage.DataReference, flyteWf *v1alpha1.FlyteWorkflow) error {
+	if len(inputsURI) == 0 {
+		return errors.NewFlyteAdminErrorf(codes.Internal, "missing offloaded inputs location")
+	}
+	flyteWf.Inputs = nil
+	flyteWf.Annotations[InputsURIAnnotation] = inputsURI.String()
+	return nil
+}
+
 func PrepareFlyteWorkflow(data interfaces.ExecutionData, flyteWorkflow *v1alpha1.FlyteWorkflow) error {
 	if data.ExecutionID == nil {
 		return errors.NewFlyteAdminErrorf(codes.Internal, "invalid execution id")
@@ -123,6 +138,11 @@ func PrepareFlyteWorkflow(data interfaces.ExecutionData, flyteWorkflow *v1alpha1
 	flyteWorkflow.Labels = labels
 	annotations := addMapValues(data.ExecutionParameters.Annotations, flyteWorkflow.Annotations)
 	flyteWorkflow.Annotations = annotations
+	if data.ExecutionParameters.OffloadInputs {
+		if err := offloadInputs(data.ExecutionParameters.InputsURI, flyteWorkflow); err != nil {
+			return err
+		}
+	}
 	if flyteWorkflow.WorkflowMeta == nil {
 		flyteWorkflow.WorkflowMeta = &v1alpha1.WorkflowMeta{}
 	}
@@ -201,3 +201,28 @@ func TestPrepareFlyteWorkflow(t *testing.T) {
 		},
 	})
 }
+
+func TestPrepareFlyteWorkflow_OffloadInputs(t *testing.T) {
+	flyteWorkflow := v1alpha1.FlyteWorkflow{
+		Inputs: &v1alpha1.Inputs{LiteralMap: &core.LiteralMap{}},
+	}
+	data := interfaces.ExecutionData{
+		ExecutionID: &core.WorkflowExecutionIdentifier{
+			Project: "p",
+			Domain:  "d",
+			Name:    "n",
+		},
+		ExecutionParameters: interfaces.ExecutionParameters{
+			InputsURI:     "s3://bucket/metadata/p/d/n/inputs",
+			OffloadInputs: true,
+		},
+	}
+	err := PrepareFlyteWorkflow(data, &flyteWorkflow)
+	assert.NoError(t, err)
+	assert.Nil(t, flyteWorkflow.Inputs)
+	assert.Equal(t, "s3://bucket/metadata/p/d/n/inputs", flyteWorkflow.Annotations[InputsURIAnnotation])
+
+	data.ExecutionParameters.InputsURI = ""
+	err = PrepareFlyteWorkflow(data, &v1alpha1.FlyteWorkflow{})
+	assert.EqualError(t, err, "missing offloaded inputs location")
+}
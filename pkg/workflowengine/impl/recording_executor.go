@@ -0,0 +1,239 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/workflowengine/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/flyteorg/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+)
+
+const recordingIdentifier = "RecordingExecutor"
+
+// Reported as the cluster of recorded executions, and as the producer of their simulated events.
+const recordingCluster = "recording"
+
+// How many times a simulated event is retried while the execution it's for isn't stored yet.
+const maxEventAttempts = 5
+
+// Stores the Flyte workflows recorded by the RecordingWorkflowExecutor.
+type workflowStore interface {
+	put(namespace, name string, flyteWf *v1alpha1.FlyteWorkflow) error
+	get(namespace, name string) (*v1alpha1.FlyteWorkflow, error)
+	delete(namespace, name string) error
+}
+
+type memoryWorkflowStore struct {
+	m         sync.RWMutex
+	workflows map[string]*v1alpha1.FlyteWorkflow
+}
+
+func (s *memoryWorkflowStore) put(namespace, name string, flyteWf *v1alpha1.FlyteWorkflow) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.workflows[filepath.Join(namespace, name)] = flyteWf
+	return nil
+}
+
+func (s *memoryWorkflowStore) get(namespace, name string) (*v1alpha1.FlyteWorkflow, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	flyteWf, ok := s.workflows[filepath.Join(namespace, name)]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return flyteWf, nil
+}
+
+func (s *memoryWorkflowStore) delete(namespace, name string) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	delete(s.workflows, filepath.Join(namespace, name))
+	return nil
+}
+
+// Writes each workflow to <directory>/<namespace>/<name>.json.
+type directoryWorkflowStore struct {
+	directory string
+}
+
+func (s *directoryWorkflowStore) path(namespace, name string) string {
+	return filepath.Join(s.directory, namespace, name+".json")
+}
+
+func (s *directoryWorkflowStore) put(namespace, name string, flyteWf *v1alpha1.FlyteWorkflow) error {
+	serialized, err := json.MarshalIndent(flyteWf, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := s.path(namespace, name)
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, serialized, 0644)
+}
+
+func (s *directoryWorkflowStore) get(namespace, name string) (*v1alpha1.FlyteWorkflow, error) {
+	serialized, err := ioutil.ReadFile(s.path(namespace, name))
+	if err != nil {
+		return nil, err
+	}
+	flyteWf := &v1alpha1.FlyteWorkflow{}
+	if err = json.Unmarshal(serialized, flyteWf); err != nil {
+		return nil, err
+	}
+	return flyteWf, nil
+}
+
+func (s *directoryWorkflowStore) delete(namespace, name string) error {
+	err := os.Remove(s.path(namespace, name))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// RecordingWorkflowExecutor records the fully prepared Flyte workflows of executions rather than creating them in
+// Kubernetes, for local development and integration tests. Recorded executions optionally progress through simulated
+// phases, reported through the event recorder as propeller would.
+type RecordingWorkflowExecutor struct {
+	workflowBuilder interfaces.FlyteWorkflowBuilder
+	eventRecorder   interfaces.WorkflowEventRecorder
+	store           workflowStore
+	simulatePhases  bool
+	phaseInterval   time.Duration
+	// Cancels the phase simulation of running executions, by namespace and name.
+	m           sync.Mutex
+	simulations map[string]context.CancelFunc
+}
+
+func (e *RecordingWorkflowExecutor) ID() string {
+	return recordingIdentifier
+}
+
+func (e *RecordingWorkflowExecutor) Execute(ctx context.Context, data interfaces.ExecutionData) (interfaces.ExecutionResponse, error) {
+	flyteWf, err := buildFlyteWorkflow(ctx, e.workflowBuilder, data)
+	if err != nil {
+		return interfaces.ExecutionResponse{}, err
+	}
+	if err = e.store.put(data.Namespace, data.ExecutionID.Name, flyteWf); err != nil {
+		return interfaces.ExecutionResponse{}, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to record workflow [%s/%s]: %v", data.Namespace, data.ExecutionID.Name, err)
+	}
+	logger.Infof(ctx, "Recorded execution [%+v] rather than creating it", data.ExecutionID)
+	if e.simulatePhases {
+		e.startSimulation(data.Namespace, data.ExecutionID)
+	}
+	return interfaces.ExecutionResponse{
+		Cluster: recordingCluster,
+	}, nil
+}
+
+func (e *RecordingWorkflowExecutor) Abort(ctx context.Context, data interfaces.AbortData) error {
+	e.stopSimulation(data.Namespace, data.ExecutionID.GetName())
+	if err := e.store.delete(data.Namespace, data.ExecutionID.GetName()); err != nil {
+		return errors.NewFlyteAdminErrorf(codes.Internal, "failed to terminate execution: %v with err %v", data.ExecutionID, err)
+	}
+	return nil
+}
+
+// GetWorkflow returns the Flyte workflow recorded for an execution.
+func (e *RecordingWorkflowExecutor) GetWorkflow(namespace, name string) (*v1alpha1.FlyteWorkflow, error) {
+	flyteWf, err := e.store.get(namespace, name)
+	if os.IsNotExist(err) {
+		return nil, errors.NewFlyteAdminErrorf(codes.NotFound, "no workflow recorded for [%s/%s]", namespace, name)
+	} else if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to read workflow [%s/%s]: %v", namespace, name, err)
+	}
+	return flyteWf, nil
+}
+
+func (e *RecordingWorkflowExecutor) startSimulation(namespace string, executionID *core.WorkflowExecutionIdentifier) {
+	ctx, cancel := context.WithCancel(context.Background())
+	e.m.Lock()
+	e.simulations[filepath.Join(namespace, executionID.Name)] = cancel
+	e.m.Unlock()
+	go func() {
+		defer e.stopSimulation(namespace, executionID.Name)
+		for _, phase := range []core.WorkflowExecution_Phase{
+			core.WorkflowExecution_RUNNING, core.WorkflowExecution_SUCCEEDED} {
+			if !e.recordPhase(ctx, executionID, phase) {
+				return
+			}
+		}
+	}()
+}
+
+func (e *RecordingWorkflowExecutor) stopSimulation(namespace, name string) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	key := filepath.Join(namespace, name)
+	if cancel, ok := e.simulations[key]; ok {
+		cancel()
+		delete(e.simulations, key)
+	}
+}
+
+// Records the execution moving to the phase after the phase interval. The execution is only stored once it's been
+// executed, so events for it are retried while it isn't found. Returns whether the phase was recorded.
+func (e *RecordingWorkflowExecutor) recordPhase(ctx context.Context, executionID *core.WorkflowExecutionIdentifier,
+	phase core.WorkflowExecution_Phase) bool {
+	for attempt := 1; ; attempt++ {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(e.phaseInterval):
+		}
+		_, err := e.eventRecorder.CreateWorkflowEvent(ctx, admin.WorkflowExecutionEventRequest{
+			RequestId: fmt.Sprintf("%s-%s", executionID.Name, phase.String()),
+			Event: &event.WorkflowExecutionEvent{
+				ExecutionId: executionID,
+				ProducerId:  recordingCluster,
+				Phase:       phase,
+				OccurredAt:  ptypes.TimestampNow(),
+			},
+		})
+		if err == nil {
+			return true
+		}
+		if adminErr, ok := err.(errors.FlyteAdminError); ok && adminErr.Code() == codes.NotFound && attempt < maxEventAttempts {
+			continue
+		}
+		logger.Warnf(ctx, "Failed to record simulated phase [%v] of execution [%+v]: %v", phase, executionID, err)
+		return false
+	}
+}
+
+func NewRecordingWorkflowExecutor(workflowBuilder interfaces.FlyteWorkflowBuilder,
+	config runtimeInterfaces.RecordingExecutorConfig, eventRecorder interfaces.WorkflowEventRecorder) *RecordingWorkflowExecutor {
+	var store workflowStore = &memoryWorkflowStore{
+		workflows: make(map[string]*v1alpha1.FlyteWorkflow),
+	}
+	if len(config.Directory) > 0 {
+		store = &directoryWorkflowStore{
+			directory: config.Directory,
+		}
+	}
+	return &RecordingWorkflowExecutor{
+		workflowBuilder: workflowBuilder,
+		eventRecorder:   eventRecorder,
+		store:           store,
+		simulatePhases:  config.SimulatePhases,
+		phaseInterval:   config.PhaseInterval.Duration,
+		simulations:     make(map[string]context.CancelFunc),
+	}
+}
@@ -0,0 +1,168 @@
+package impl
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/workflowengine/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/workflowengine/mocks"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+	"github.com/flyteorg/flytestdlib/config"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+type fakeEventRecorder struct {
+	m sync.Mutex
+	// Events for the execution fail as not found this many times, as if it weren't stored yet.
+	notFoundCount int
+	phases        []core.WorkflowExecution_Phase
+}
+
+func (r *fakeEventRecorder) CreateWorkflowEvent(ctx context.Context, request admin.WorkflowExecutionEventRequest) (
+	*admin.WorkflowExecutionEventResponse, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.notFoundCount > 0 {
+		r.notFoundCount--
+		return nil, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "execution not found")
+	}
+	r.phases = append(r.phases, request.Event.Phase)
+	return &admin.WorkflowExecutionEventResponse{}, nil
+}
+
+func (r *fakeEventRecorder) getPhases() []core.WorkflowExecution_Phase {
+	r.m.Lock()
+	defer r.m.Unlock()
+	return append([]core.WorkflowExecution_Phase{}, r.phases...)
+}
+
+func getRecordingExecutionData() interfaces.ExecutionData {
+	return interfaces.ExecutionData{
+		Namespace:   namespace,
+		ExecutionID: execID,
+		WorkflowClosure: &core.CompiledWorkflowClosure{
+			Primary: &core.CompiledWorkflow{
+				Template: &core.WorkflowTemplate{
+					Id: &core.Identifier{
+						Project: "p",
+						Domain:  "d",
+						Name:    "n",
+						Version: "version",
+					},
+				},
+			},
+		},
+		ExecutionParameters: interfaces.ExecutionParameters{
+			Inputs: testInputs,
+		},
+	}
+}
+
+func getRecordingWorkflowBuilder() interfaces.FlyteWorkflowBuilder {
+	mockBuilder := mocks.FlyteWorkflowBuilder{}
+	mockBuilder.On("Build", mock.Anything, mock.Anything, mock.Anything, namespace).Return(
+		func(_ *core.CompiledWorkflowClosure, inputs *core.LiteralMap, executionID *core.WorkflowExecutionIdentifier,
+			_ string) *v1alpha1.FlyteWorkflow {
+			return &v1alpha1.FlyteWorkflow{
+				ExecutionID: v1alpha1.ExecutionID{
+					WorkflowExecutionIdentifier: executionID,
+				},
+				Inputs: &v1alpha1.Inputs{LiteralMap: inputs},
+			}
+		}, nil)
+	return &mockBuilder
+}
+
+func TestRecordingExecutor(t *testing.T) {
+	for name, directory := range map[string]string{
+		"memory":    "",
+		"directory": t.TempDir(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			executor := NewRecordingWorkflowExecutor(getRecordingWorkflowBuilder(), runtimeInterfaces.RecordingExecutorConfig{
+				Enabled:   true,
+				Directory: directory,
+			}, &fakeEventRecorder{})
+			assert.Equal(t, recordingIdentifier, executor.ID())
+
+			resp, err := executor.Execute(context.TODO(), getRecordingExecutionData())
+			assert.NoError(t, err)
+			assert.Equal(t, recordingCluster, resp.Cluster)
+
+			flyteWorkflow, err := executor.GetWorkflow(namespace, execID.Name)
+			assert.NoError(t, err)
+			assert.True(t, proto.Equal(testInputs, flyteWorkflow.Inputs.LiteralMap))
+			assert.True(t, proto.Equal(execID, flyteWorkflow.ExecutionID.WorkflowExecutionIdentifier))
+
+			err = executor.Abort(context.TODO(), interfaces.AbortData{
+				Namespace:   namespace,
+				ExecutionID: execID,
+				Cluster:     resp.Cluster,
+			})
+			assert.NoError(t, err)
+			_, err = executor.GetWorkflow(namespace, execID.Name)
+			assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+			// Aborting an execution which was already deleted succeeds.
+			err = executor.Abort(context.TODO(), interfaces.AbortData{
+				Namespace:   namespace,
+				ExecutionID: execID,
+				Cluster:     resp.Cluster,
+			})
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestRecordingExecutor_SimulatePhases(t *testing.T) {
+	recorder := &fakeEventRecorder{
+		notFoundCount: 2,
+	}
+	executor := NewRecordingWorkflowExecutor(getRecordingWorkflowBuilder(), runtimeInterfaces.RecordingExecutorConfig{
+		Enabled:        true,
+		SimulatePhases: true,
+		PhaseInterval: config.Duration{
+			Duration: time.Millisecond,
+		},
+	}, recorder)
+
+	_, err := executor.Execute(context.TODO(), getRecordingExecutionData())
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		return len(recorder.getPhases()) == 2
+	}, 5*time.Second, time.Millisecond)
+	assert.Equal(t, []core.WorkflowExecution_Phase{
+		core.WorkflowExecution_RUNNING, core.WorkflowExecution_SUCCEEDED}, recorder.getPhases())
+}
+
+func TestRecordingExecutor_AbortStopsSimulation(t *testing.T) {
+	recorder := &fakeEventRecorder{}
+	executor := NewRecordingWorkflowExecutor(getRecordingWorkflowBuilder(), runtimeInterfaces.RecordingExecutorConfig{
+		Enabled:        true,
+		SimulatePhases: true,
+		PhaseInterval: config.Duration{
+			Duration: time.Hour,
+		},
+	}, recorder)
+
+	_, err := executor.Execute(context.TODO(), getRecordingExecutionData())
+	assert.NoError(t, err)
+	err = executor.Abort(context.TODO(), interfaces.AbortData{
+		Namespace:   namespace,
+		ExecutionID: execID,
+	})
+	assert.NoError(t, err)
+	executor.m.Lock()
+	assert.Empty(t, executor.simulations)
+	executor.m.Unlock()
+	assert.Empty(t, recorder.getPhases())
+}
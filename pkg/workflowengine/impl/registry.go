@@ -16,6 +16,8 @@ type workflowExecutorRegistry struct {
 	m               sync.RWMutex
 	executor        interfaces.WorkflowExecutor
 	defaultExecutor interfaces.WorkflowExecutor
+	// Executors handling only the executions routed to them, by their IDs.
+	selectableExecutors map[string]interfaces.WorkflowExecutor
 }
 
 func (r *workflowExecutorRegistry) Register(executor interfaces.WorkflowExecutor) {
@@ -40,6 +42,20 @@ func (r *workflowExecutorRegistry) RegisterDefault(executor interfaces.WorkflowE
 	r.defaultExecutor = executor
 }
 
+func (r *workflowExecutorRegistry) RegisterSelectable(executor interfaces.WorkflowExecutor) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.selectableExecutors == nil {
+		r.selectableExecutors = make(map[string]interfaces.WorkflowExecutor)
+	}
+	if _, ok := r.selectableExecutors[executor.ID()]; ok {
+		logger.Debugf(context.TODO(), "updating selectable flyte workflow executor [%s]", executor.ID())
+	} else {
+		logger.Debugf(context.TODO(), "adding selectable flyte workflow executor [%s]", executor.ID())
+	}
+	r.selectableExecutors[executor.ID()] = executor
+}
+
 func (r *workflowExecutorRegistry) GetExecutor() interfaces.WorkflowExecutor {
 	r.m.RLock()
 	defer r.m.RUnlock()
@@ -49,6 +65,20 @@ func (r *workflowExecutorRegistry) GetExecutor() interfaces.WorkflowExecutor {
 	return r.executor
 }
 
+func (r *workflowExecutorRegistry) GetExecutorByID(id string) (interfaces.WorkflowExecutor, bool) {
+	r.m.RLock()
+	defer r.m.RUnlock()
+	if executor, ok := r.selectableExecutors[id]; ok {
+		return executor, true
+	}
+	for _, executor := range []interfaces.WorkflowExecutor{r.executor, r.defaultExecutor} {
+		if executor != nil && executor.ID() == id {
+			return executor, true
+		}
+	}
+	return nil, false
+}
+
 func NewRegistry() interfaces2.WorkflowExecutorRegistry {
 	return &workflowExecutorRegistry{}
 }
@@ -35,3 +35,19 @@ func TestRegisterDefault(t *testing.T) {
 	registry.Register(exec)
 	assert.Equal(t, testExecID, registry.GetExecutor().ID())
 }
+
+func TestRegisterSelectable(t *testing.T) {
+	registry := workflowExecutorRegistry{}
+	registry.RegisterDefault(getMockK8sWorkflowExecutor(defaultExecID))
+	registry.RegisterSelectable(getMockK8sWorkflowExecutor(testExecID))
+	registry.RegisterSelectable(getMockK8sWorkflowExecutor("bar"))
+	assert.Equal(t, defaultExecID, registry.GetExecutor().ID())
+
+	for _, id := range []string{testExecID, "bar", defaultExecID} {
+		exec, ok := registry.GetExecutorByID(id)
+		assert.True(t, ok)
+		assert.Equal(t, id, exec.ID())
+	}
+	_, ok := registry.GetExecutorByID("unknown")
+	assert.False(t, ok)
+}
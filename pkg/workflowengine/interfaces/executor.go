@@ -8,6 +8,7 @@ import (
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/storage"
 )
 
 //go:generate mockery -name=WorkflowExecutor -output=../mocks/ -case=underscore
@@ -30,6 +31,12 @@ type ExecutionParameters struct {
 	EventVersion        int
 	RoleNameKey         string
 	RawOutputDataConfig *admin.RawOutputDataConfig
+	// Location the inputs were offloaded to.
+	InputsURI storage.DataReference
+	// Whether the Flyte workflow CRD references InputsURI rather than embedding the inputs.
+	OffloadInputs bool
+	// Size in bytes above which the Flyte workflow CRD is rejected, 0 for no limit.
+	MaxCRDSizeBytes int
 }
 
 // ExecutionData includes all parameters required to create an execution CRD object.
@@ -46,6 +53,8 @@ type ExecutionData struct {
 	WorkflowClosure *core.CompiledWorkflowClosure
 	// Additional parameters used to build a workflow execution
 	ExecutionParameters ExecutionParameters
+	// Optional cluster the execution is assigned to while it's selectable, rather than selecting one by its label.
+	PreferredCluster string
 }
 
 // ExecutionResponse is returned when a Flyte workflow execution is successfully created.
@@ -73,3 +82,10 @@ type WorkflowExecutor interface {
 	// Abort aborts a running Flyte workflow execution CRD object.
 	Abort(ctx context.Context, data AbortData) error
 }
+
+// WorkflowEventRecorder records the workflow execution events of executions whose progress isn't reported by propeller,
+// such as those of the recording executor.
+type WorkflowEventRecorder interface {
+	CreateWorkflowEvent(ctx context.Context, request admin.WorkflowExecutionEventRequest) (
+		*admin.WorkflowExecutionEventResponse, error)
+}
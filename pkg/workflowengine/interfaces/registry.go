@@ -1,5 +1,14 @@
 package interfaces
 
+// WorkflowExecutorTaskType is the task type of the PLUGIN_OVERRIDE matchable attribute routing executions to another
+// WorkflowExecutor than the one resolved by GetExecutor. It isn't the type of any task: the override is taken out of
+// the plugin overrides of an execution before they're passed on to propeller. Its plugin IDs are the IDs of the
+// registered executors to use, in order of preference, e.g. "RecordingExecutor" for the recording executor. When none
+// of them is registered the execution fails to be created if the missing plugin behavior is FAIL, and is created with
+// the executor resolved by GetExecutor otherwise. The ID of the executor used is stored with the execution, so that
+// it's aborted with the same executor.
+const WorkflowExecutorTaskType = "workflow_executor"
+
 // WorkflowExecutorRegistry is a singleton provider of a WorkflowExecutor implementation to use for
 // creating and deleting Flyte workflow CRD objects.
 type WorkflowExecutorRegistry interface {
@@ -7,6 +16,11 @@ type WorkflowExecutorRegistry interface {
 	Register(executor WorkflowExecutor)
 	// RegisterDefault registers the default WorkflowExecutor to handle creating and aborting Flyte workflow executions.
 	RegisterDefault(executor WorkflowExecutor)
+	// RegisterSelectable registers a WorkflowExecutor handling only the executions routed to it by its ID. Any number
+	// of selectable executors can be registered, and none of them replaces the one resolved by GetExecutor.
+	RegisterSelectable(executor WorkflowExecutor)
 	// GetExecutor resolves the definitive WorkflowExecutor implementation to be used for creating and aborting Flyte workflow executions.
 	GetExecutor() WorkflowExecutor
+	// GetExecutorByID returns the registered WorkflowExecutor with the ID, selectable or not, and whether there is one.
+	GetExecutorByID(id string) (WorkflowExecutor, bool)
 }
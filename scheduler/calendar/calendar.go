@@ -0,0 +1,141 @@
+package calendar
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+)
+
+// ExclusionCalendarsAnnotation is the launch plan annotation listing the comma separated names of the calendars whose
+// days its schedule doesn't fire on.
+const ExclusionCalendarsAnnotation = "flyte.org/exclusion-calendars"
+
+const (
+	dateLayout       = "2006-01-02"
+	annualDateLayout = "01-02"
+)
+
+// Calendar is a set of excluded days.
+type Calendar struct {
+	dates       map[string]bool
+	annualDates map[string]bool
+	weekdays    map[time.Weekday]bool
+	location    *time.Location
+}
+
+// Excludes returns whether the time falls on one of the days of the calendar.
+func (c Calendar) Excludes(t time.Time) bool {
+	local := t.In(c.location)
+	return c.dates[local.Format(dateLayout)] || c.annualDates[local.Format(annualDateLayout)] ||
+		c.weekdays[local.Weekday()]
+}
+
+// Calendars are the calendars which schedules can reference by name.
+type Calendars map[string]Calendar
+
+// GetExcludingCalendar returns the name of the first of the named calendars which excludes the time, or an empty
+// string if none does. Names of calendars which don't exist, e.g. because they were removed from the configuration
+// after the schedule was activated, are ignored.
+func (c Calendars) GetExcludingCalendar(names string, t time.Time) string {
+	for _, name := range ParseNames(names) {
+		if calendar, ok := c[name]; ok && calendar.Excludes(t) {
+			return name
+		}
+	}
+	return ""
+}
+
+// Validate returns an error if any of the named calendars doesn't exist.
+func (c Calendars) Validate(names string) error {
+	for _, name := range ParseNames(names) {
+		if _, ok := c[name]; !ok {
+			known := make([]string, 0, len(c))
+			for knownName := range c {
+				known = append(known, knownName)
+			}
+			sort.Strings(known)
+			return fmt.Errorf("unknown calendar [%s], expected one of [%s]", name, strings.Join(known, ", "))
+		}
+	}
+	return nil
+}
+
+// ParseNames splits the comma separated calendar names.
+func ParseNames(names string) []string {
+	var parsed []string
+	for _, name := range strings.Split(names, ",") {
+		if name = strings.TrimSpace(name); len(name) > 0 {
+			parsed = append(parsed, name)
+		}
+	}
+	return parsed
+}
+
+func newCalendar(config runtimeInterfaces.ScheduleCalendarConfig) (Calendar, error) {
+	calendar := Calendar{
+		dates:       map[string]bool{},
+		annualDates: map[string]bool{},
+		weekdays:    map[time.Weekday]bool{},
+		location:    time.UTC,
+	}
+	if len(config.Timezone) > 0 {
+		location, err := time.LoadLocation(config.Timezone)
+		if err != nil {
+			return Calendar{}, fmt.Errorf("unknown timezone [%s]: %v", config.Timezone, err)
+		}
+		calendar.location = location
+	}
+	for _, date := range config.Dates {
+		parsed, err := time.Parse(dateLayout, strings.TrimSpace(date))
+		if err != nil {
+			return Calendar{}, fmt.Errorf("invalid date [%s], expected YYYY-MM-DD", date)
+		}
+		calendar.dates[parsed.Format(dateLayout)] = true
+	}
+	for _, date := range config.AnnualDates {
+		// Parsed in a leap year so that February 29 is accepted.
+		parsed, err := time.Parse(dateLayout, "2020-"+strings.TrimSpace(date))
+		if err != nil {
+			return Calendar{}, fmt.Errorf("invalid annual date [%s], expected MM-DD", date)
+		}
+		calendar.annualDates[parsed.Format(annualDateLayout)] = true
+	}
+	for _, weekday := range config.Weekdays {
+		found := false
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			if strings.EqualFold(strings.TrimSpace(weekday), day.String()) {
+				calendar.weekdays[day] = true
+				found = true
+			}
+		}
+		if !found {
+			return Calendar{}, fmt.Errorf("invalid weekday [%s], expected a day such as Saturday", weekday)
+		}
+	}
+	return calendar, nil
+}
+
+// New parses the calendars in the scheduler configuration.
+func New(configs map[string]runtimeInterfaces.ScheduleCalendarConfig) (Calendars, error) {
+	calendars := Calendars{}
+	for name, config := range configs {
+		calendar, err := newCalendar(config)
+		if err != nil {
+			return nil, fmt.Errorf("invalid calendar [%s]: %v", name, err)
+		}
+		calendars[name] = calendar
+	}
+	return calendars, nil
+}
+
+// Load parses the calendars in the scheduler section of the application configuration.
+func Load(config runtimeInterfaces.ApplicationConfiguration) (Calendars, error) {
+	eventSchedulerConfig := config.GetSchedulerConfig().GetEventSchedulerConfig()
+	if eventSchedulerConfig.GetFlyteSchedulerConfig() == nil {
+		return Calendars{}, nil
+	}
+	return New(eventSchedulerConfig.GetFlyteSchedulerConfig().GetCalendars())
+}
@@ -0,0 +1,75 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func getTestCalendars(t *testing.T) Calendars {
+	calendars, err := New(map[string]runtimeInterfaces.ScheduleCalendarConfig{
+		"nyse": {
+			Dates:       []string{"2021-11-25"},
+			AnnualDates: []string{"12-25", "01-01"},
+			Timezone:    "America/New_York",
+		},
+		"weekends": {Weekdays: []string{"saturday", "Sunday"}},
+	})
+	assert.NoError(t, err)
+	return calendars
+}
+
+func TestCalendar_Excludes(t *testing.T) {
+	calendars := getTestCalendars(t)
+	nyse := calendars["nyse"]
+	assert.True(t, nyse.Excludes(time.Date(2021, 11, 25, 15, 0, 0, 0, time.UTC)))
+	assert.True(t, nyse.Excludes(time.Date(2022, 12, 25, 15, 0, 0, 0, time.UTC)))
+	assert.False(t, nyse.Excludes(time.Date(2022, 11, 25, 15, 0, 0, 0, time.UTC)))
+	// Days start and end on the wall clock of the calendar's timezone.
+	assert.True(t, nyse.Excludes(time.Date(2021, 11, 26, 3, 0, 0, 0, time.UTC)))
+	assert.False(t, nyse.Excludes(time.Date(2021, 11, 25, 3, 0, 0, 0, time.UTC)))
+
+	weekends := calendars["weekends"]
+	assert.True(t, weekends.Excludes(time.Date(2021, 10, 2, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, weekends.Excludes(time.Date(2021, 10, 3, 23, 0, 0, 0, time.UTC)))
+	assert.False(t, weekends.Excludes(time.Date(2021, 10, 4, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestCalendars_GetExcludingCalendar(t *testing.T) {
+	calendars := getTestCalendars(t)
+	christmas := time.Date(2021, 12, 25, 15, 0, 0, 0, time.UTC)
+	assert.Equal(t, "nyse", calendars.GetExcludingCalendar("nyse, weekends", christmas))
+	assert.Equal(t, "weekends", calendars.GetExcludingCalendar("weekends,nyse", christmas))
+	assert.Empty(t, calendars.GetExcludingCalendar("unknown", christmas))
+	assert.Empty(t, calendars.GetExcludingCalendar("", christmas))
+	assert.Empty(t, Calendars(nil).GetExcludingCalendar("nyse", christmas))
+}
+
+func TestCalendars_Validate(t *testing.T) {
+	calendars := getTestCalendars(t)
+	assert.NoError(t, calendars.Validate("nyse, weekends"))
+	assert.NoError(t, calendars.Validate(""))
+	assert.EqualError(t, calendars.Validate("nyse,lse"), "unknown calendar [lse], expected one of [nyse, weekends]")
+}
+
+func TestNew_Invalid(t *testing.T) {
+	for name, config := range map[string]runtimeInterfaces.ScheduleCalendarConfig{
+		"date":        {Dates: []string{"2021-13-01"}},
+		"annual date": {AnnualDates: []string{"02-30"}},
+		"weekday":     {Weekdays: []string{"Caturday"}},
+		"timezone":    {Timezone: "Mars/Olympus_Mons"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(map[string]runtimeInterfaces.ScheduleCalendarConfig{"invalid": config})
+			assert.Error(t, err)
+		})
+	}
+	// February 29 is a valid annual date.
+	_, err := New(map[string]runtimeInterfaces.ScheduleCalendarConfig{
+		"leap": {AnnualDates: []string{"02-29"}},
+	})
+	assert.NoError(t, err)
+}
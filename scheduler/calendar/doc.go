@@ -0,0 +1,3 @@
+// Package calendar
+// This package provides the named calendars of days on which the schedules referencing them don't fire
+package calendar
@@ -0,0 +1,267 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/scheduler/calendar"
+	"github.com/flyteorg/flyteadmin/scheduler/executor"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+)
+
+type backfillRunnerMetrics struct {
+	Scope                promutils.Scope
+	BackfillSucceeded    prometheus.Counter
+	BackfillFailed       prometheus.Counter
+	BackfillCancelled    prometheus.Counter
+	ExecutionsFired      prometheus.Counter
+	ProgressUpdateErrors prometheus.Counter
+}
+
+// BackfillRunner fires the executions of the pending schedule backfills. Progress is recorded after every batch of
+// executions so that backfills interrupted by a restart or a change of leader are resumed after the last batch.
+type BackfillRunner struct {
+	db          repositories.SchedulerRepoInterface
+	executor    executor.Executor
+	rateLimiter *rate.Limiter
+	calendars   calendar.Calendars
+	metrics     backfillRunnerMetrics
+}
+
+// GetBackfillTimes returns the times in [from, to) at which the schedule fires, in ascending order. At most limit times
+// are returned unless the limit is zero.
+// Cron schedules fire at the matching times while fixed rate schedules fire at from and every interval after it. Times
+// excluded by the calendars of the schedule are skipped.
+func GetBackfillTimes(s models.SchedulableEntity, from time.Time, to time.Time, limit int,
+	calendars calendar.Calendars) ([]time.Time, error) {
+	var backfillTimes []time.Time
+	scheduledTime := from
+	var err error
+	if len(s.CronExpression) > 0 {
+		// Scheduled times are strictly after the time they are computed from.
+		scheduledTime, err = getCronScheduledTime(s.CronExpression, s.Timezone, from.Add(-time.Nanosecond))
+	}
+	for err == nil && scheduledTime.Before(to) && (limit == 0 || len(backfillTimes) < limit) {
+		if len(calendars.GetExcludingCalendar(s.ExclusionCalendars, scheduledTime)) == 0 {
+			backfillTimes = append(backfillTimes, scheduledTime)
+		}
+		scheduledTime, err = GetScheduledTime(s, scheduledTime)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return backfillTimes, nil
+}
+
+// GetOrderedBackfillTimes returns the times at which the backfill fires executions, in the order they are fired.
+func GetOrderedBackfillTimes(backfill models.ScheduleBackfill, calendars calendar.Calendars) ([]time.Time, error) {
+	backfillTimes, err := GetBackfillTimes(GetBackfillSchedule(backfill), backfill.StartTime, backfill.EndTime, 0,
+		calendars)
+	if err != nil {
+		return nil, err
+	}
+	if backfillTimes, err = ApplyCatchupPolicy(backfill.CatchupPolicy, backfillTimes); err != nil {
+		return nil, err
+	}
+	if backfill.Order == models.ScheduleBackfillOrderDescending {
+		for i, j := 0, len(backfillTimes)-1; i < j; i, j = i+1, j-1 {
+			backfillTimes[i], backfillTimes[j] = backfillTimes[j], backfillTimes[i]
+		}
+	}
+	return backfillTimes, nil
+}
+
+// GetBackfillSchedule returns the schedule which the backfill fires executions of.
+func GetBackfillSchedule(backfill models.ScheduleBackfill) models.SchedulableEntity {
+	active := true
+	return models.SchedulableEntity{
+		SchedulableEntityKey: models.SchedulableEntityKey{
+			Project: backfill.Project,
+			Domain:  backfill.Domain,
+			Name:    backfill.Name,
+			Version: backfill.Version,
+		},
+		CronExpression:      backfill.CronExpression,
+		Timezone:            backfill.Timezone,
+		FixedRateValue:      backfill.FixedRateValue,
+		Unit:                backfill.Unit,
+		KickoffTimeInputArg: backfill.KickoffTimeInputArg,
+		CatchupPolicy:       backfill.CatchupPolicy,
+		ExclusionCalendars:  backfill.ExclusionCalendars,
+		Active:              &active,
+	}
+}
+
+// Fires a batch of executions concurrently and returns the first error encountered.
+func (b BackfillRunner) fireBatch(ctx context.Context, schedule models.SchedulableEntity, batch []time.Time) error {
+	var wg sync.WaitGroup
+	errs := make([]error, len(batch))
+	for idx, scheduledTime := range batch {
+		wg.Add(1)
+		go func(idx int, scheduledTime time.Time) {
+			defer wg.Done()
+			if errs[idx] = b.rateLimiter.Wait(ctx); errs[idx] != nil {
+				return
+			}
+			errs[idx] = b.executor.Execute(ctx, scheduledTime, schedule)
+			if errs[idx] == nil {
+				b.metrics.ExecutionsFired.Inc()
+			}
+		}(idx, scheduledTime)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the backfill times which are yet to be fired. The times are recomputed with the current calendars, so the
+// backfill is resumed after the last time it fired rather than after its number of completed executions. Backfills
+// which only recorded the latter, before the last fired time was recorded, fall back to it.
+func getRemainingBackfillTimes(backfill models.ScheduleBackfill, backfillTimes []time.Time) []time.Time {
+	if backfill.LastFiredTime == nil {
+		if int(backfill.CompletedExecutions) >= len(backfillTimes) {
+			return nil
+		}
+		return backfillTimes[backfill.CompletedExecutions:]
+	}
+	for idx, backfillTime := range backfillTimes {
+		if backfill.Order == models.ScheduleBackfillOrderDescending && backfillTime.Before(*backfill.LastFiredTime) ||
+			backfill.Order != models.ScheduleBackfillOrderDescending && backfillTime.After(*backfill.LastFiredTime) {
+			return backfillTimes[idx:]
+		}
+	}
+	return nil
+}
+
+// Records the progress of the backfill. Returns false when the backfill should stop, either because it was cancelled
+// or because its progress couldn't be recorded, in which case it's resumed in a later round.
+func (b BackfillRunner) update(ctx context.Context, backfill models.ScheduleBackfill) bool {
+	updated, err := b.db.ScheduleBackfillRepo().Update(ctx, backfill)
+	if err != nil {
+		b.metrics.ProgressUpdateErrors.Inc()
+		logger.Errorf(ctx, "Failed to record the progress of backfill %d due to %v", backfill.ID, err)
+		return false
+	}
+	if !updated {
+		b.metrics.BackfillCancelled.Inc()
+		logger.Infof(ctx, "Backfill %d was cancelled after firing %d of %d executions", backfill.ID,
+			backfill.CompletedExecutions, backfill.TotalExecutions)
+		return false
+	}
+	return true
+}
+
+func (b BackfillRunner) runBackfill(ctx context.Context, backfill models.ScheduleBackfill) {
+	backfillTimes, err := GetOrderedBackfillTimes(backfill, b.calendars)
+	if err != nil {
+		b.metrics.BackfillFailed.Inc()
+		backfill.Phase = models.ScheduleBackfillPhaseFailed
+		backfill.Error = err.Error()
+		b.update(ctx, backfill)
+		return
+	}
+	if backfill.Phase == models.ScheduleBackfillPhasePending {
+		logger.Infof(ctx, "Starting backfill %d of %d executions", backfill.ID, len(backfillTimes))
+		backfill.Phase = models.ScheduleBackfillPhaseRunning
+		if !b.update(ctx, backfill) {
+			return
+		}
+	}
+	schedule := GetBackfillSchedule(backfill)
+	parallelism := int(backfill.Parallelism)
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	remainingTimes := getRemainingBackfillTimes(backfill, backfillTimes)
+	for len(remainingTimes) > 0 {
+		if ctx.Err() != nil {
+			return
+		}
+		batch := remainingTimes
+		if len(batch) > parallelism {
+			batch = batch[:parallelism]
+		}
+		// Executions are named deterministically so re-firing a batch after an interruption is safe.
+		if err := b.fireBatch(ctx, schedule, batch); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			logger.Errorf(ctx, "Backfill %d failed after firing %d executions due to %v", backfill.ID,
+				backfill.CompletedExecutions, err)
+			b.metrics.BackfillFailed.Inc()
+			backfill.Phase = models.ScheduleBackfillPhaseFailed
+			backfill.Error = err.Error()
+			b.update(ctx, backfill)
+			return
+		}
+		remainingTimes = remainingTimes[len(batch):]
+		lastFiredTime := batch[len(batch)-1]
+		backfill.LastFiredTime = &lastFiredTime
+		backfill.CompletedExecutions += uint32(len(batch))
+		if !b.update(ctx, backfill) {
+			return
+		}
+	}
+	logger.Infof(ctx, "Backfill %d fired all of its %d executions", backfill.ID, backfill.CompletedExecutions)
+	b.metrics.BackfillSucceeded.Inc()
+	backfill.Phase = models.ScheduleBackfillPhaseSucceeded
+	b.update(ctx, backfill)
+}
+
+// Run runs the pending and interrupted backfills one after another, oldest first.
+func (b BackfillRunner) Run(ctx context.Context) {
+	backfills, err := b.db.ScheduleBackfillRepo().List(ctx, interfaces.ListScheduleBackfillsInput{
+		Phases: []models.ScheduleBackfillPhase{
+			models.ScheduleBackfillPhasePending, models.ScheduleBackfillPhaseRunning,
+		},
+	})
+	if err != nil {
+		logger.Errorf(ctx, "Failed to fetch the backfills in this round due to %v", err)
+		return
+	}
+	for _, backfill := range backfills {
+		if ctx.Err() != nil {
+			return
+		}
+		b.runBackfill(ctx, backfill)
+	}
+}
+
+func NewBackfillRunner(db repositories.SchedulerRepoInterface, executor executor.Executor,
+	rateLimiter *rate.Limiter, calendars calendar.Calendars, scope promutils.Scope) BackfillRunner {
+	return BackfillRunner{
+		db:          db,
+		executor:    executor,
+		rateLimiter: rateLimiter,
+		calendars:   calendars,
+		metrics:     newBackfillRunnerMetrics(scope.NewSubScope("backfill")),
+	}
+}
+
+func newBackfillRunnerMetrics(scope promutils.Scope) backfillRunnerMetrics {
+	return backfillRunnerMetrics{
+		Scope: scope,
+		BackfillSucceeded: scope.MustNewCounter("succeeded",
+			"count of backfills which fired all of their executions"),
+		BackfillFailed: scope.MustNewCounter("failed",
+			"count of backfills which failed to fire their executions"),
+		BackfillCancelled: scope.MustNewCounter("cancelled",
+			"count of backfills which were cancelled while running"),
+		ExecutionsFired: scope.MustNewCounter("executions_fired",
+			"count of executions fired by backfills"),
+		ProgressUpdateErrors: scope.MustNewCounter("progress_update_errors",
+			"count of failures to record the progress of backfills"),
+	}
+}
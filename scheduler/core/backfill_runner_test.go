@@ -0,0 +1,224 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteadmin/scheduler/calendar"
+	schedMocks "github.com/flyteorg/flyteadmin/scheduler/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/promutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/time/rate"
+)
+
+var backfillStart = time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+
+type recordingExecutor struct {
+	mutex     sync.Mutex
+	fired     []time.Time
+	objects   []string
+	failAfter int
+}
+
+func (e *recordingExecutor) Execute(ctx context.Context, scheduledTime time.Time, s models.SchedulableEntity) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if e.failAfter > 0 && len(e.fired) >= e.failAfter {
+		return errors.New("admin unavailable")
+	}
+	e.fired = append(e.fired, scheduledTime)
+	return nil
+}
+
+func (e *recordingExecutor) ExecuteForObject(ctx context.Context, trigger models.StorageTrigger, objectURI string,
+	lastModified time.Time) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if e.failAfter > 0 && len(e.objects) >= e.failAfter {
+		return errors.New("admin unavailable")
+	}
+	e.objects = append(e.objects, objectURI)
+	return nil
+}
+
+func hourlyBackfill(phase models.ScheduleBackfillPhase, completed uint32) models.ScheduleBackfill {
+	return models.ScheduleBackfill{
+		ID:                  1,
+		Project:             "project",
+		Domain:              "domain",
+		Name:                "lp",
+		Version:             "v1",
+		CronExpression:      "0 * * * *",
+		StartTime:           backfillStart,
+		EndTime:             backfillStart.Add(5 * time.Hour),
+		Parallelism:         2,
+		Order:               models.ScheduleBackfillOrderAscending,
+		Phase:               phase,
+		TotalExecutions:     5,
+		CompletedExecutions: completed,
+	}
+}
+
+func hoursAfterStart(hours ...int) []time.Time {
+	times := make([]time.Time, len(hours))
+	for idx, hour := range hours {
+		times[idx] = backfillStart.Add(time.Duration(hour) * time.Hour)
+	}
+	return times
+}
+
+func setupBackfillRunner(backfill models.ScheduleBackfill, executor *recordingExecutor) (
+	BackfillRunner, *schedMocks.ScheduleBackfillRepoInterface) {
+	db := mocks.NewMockRepository()
+	backfillRepo := db.ScheduleBackfillRepo().(*schedMocks.ScheduleBackfillRepoInterface)
+	backfillRepo.OnListMatch(mock.Anything, mock.Anything).Return([]models.ScheduleBackfill{backfill}, nil)
+	return NewBackfillRunner(db, executor, rate.NewLimiter(rate.Inf, 1), nil, promutils.NewTestScope()), backfillRepo
+}
+
+func TestGetBackfillTimes(t *testing.T) {
+	cron := models.SchedulableEntity{CronExpression: "0 * * * *"}
+	backfillTimes, err := GetBackfillTimes(cron, backfillStart, backfillStart.Add(3*time.Hour), 0, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, hoursAfterStart(0, 1, 2), backfillTimes)
+
+	backfillTimes, err = GetBackfillTimes(cron, backfillStart.Add(time.Minute), backfillStart.Add(3*time.Hour), 1, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, hoursAfterStart(1), backfillTimes)
+
+	fixedRate := models.SchedulableEntity{FixedRateValue: 2, Unit: admin.FixedRateUnit_HOUR}
+	backfillTimes, err = GetBackfillTimes(fixedRate, backfillStart, backfillStart.Add(5*time.Hour), 0, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, hoursAfterStart(0, 2, 4), backfillTimes)
+
+	_, err = GetBackfillTimes(models.SchedulableEntity{CronExpression: "invalid"}, backfillStart,
+		backfillStart.Add(time.Hour), 0, nil)
+	assert.Error(t, err)
+}
+
+func TestGetBackfillTimes_ExclusionCalendars(t *testing.T) {
+	calendars, err := calendar.New(map[string]runtimeInterfaces.ScheduleCalendarConfig{
+		"weekends": {Weekdays: []string{"Saturday", "Sunday"}},
+	})
+	assert.NoError(t, err)
+	daily := models.SchedulableEntity{CronExpression: "0 0 * * *", ExclusionCalendars: "weekends"}
+	// The backfill starts on a Friday.
+	backfillTimes, err := GetBackfillTimes(daily, backfillStart, backfillStart.Add(96*time.Hour), 0, calendars)
+	assert.NoError(t, err)
+	assert.Equal(t, hoursAfterStart(0, 72), backfillTimes)
+
+	backfill := hourlyBackfill(models.ScheduleBackfillPhasePending, 0)
+	backfill.StartTime = backfillStart.Add(22 * time.Hour)
+	backfill.EndTime = backfillStart.Add(74 * time.Hour)
+	backfill.ExclusionCalendars = "weekends"
+	backfillTimes, err = GetOrderedBackfillTimes(backfill, calendars)
+	assert.NoError(t, err)
+	assert.Equal(t, hoursAfterStart(22, 23, 72, 73), backfillTimes)
+}
+
+func TestGetOrderedBackfillTimes_CatchupPolicy(t *testing.T) {
+	backfill := hourlyBackfill(models.ScheduleBackfillPhasePending, 0)
+	backfill.CatchupPolicy = "max-2"
+	backfillTimes, err := GetOrderedBackfillTimes(backfill, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, hoursAfterStart(3, 4), backfillTimes)
+
+	backfill.Order = models.ScheduleBackfillOrderDescending
+	backfillTimes, err = GetOrderedBackfillTimes(backfill, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, hoursAfterStart(4, 3), backfillTimes)
+}
+
+func TestBackfillRunner_Run(t *testing.T) {
+	executor := &recordingExecutor{}
+	backfill := hourlyBackfill(models.ScheduleBackfillPhasePending, 0)
+	backfill.Order = models.ScheduleBackfillOrderDescending
+	runner, backfillRepo := setupBackfillRunner(backfill, executor)
+	var progress []uint32
+	var lastFiredTimes []time.Time
+	backfillRepo.OnUpdateMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		updated := args.Get(1).(models.ScheduleBackfill)
+		progress = append(progress, updated.CompletedExecutions)
+		if updated.LastFiredTime != nil {
+			lastFiredTimes = append(lastFiredTimes, *updated.LastFiredTime)
+		}
+	}).Return(true, nil)
+
+	runner.Run(context.Background())
+	assert.ElementsMatch(t, hoursAfterStart(4, 3, 2, 1, 0), executor.fired)
+	// Descending backfills fire the most recent batch first.
+	assert.ElementsMatch(t, hoursAfterStart(4, 3), executor.fired[:2])
+	assert.Equal(t, []uint32{0, 2, 4, 5, 5}, progress)
+	assert.Equal(t, hoursAfterStart(3, 1, 0, 0), lastFiredTimes)
+	backfillRepo.AssertCalled(t, "Update", mock.Anything, mock.MatchedBy(func(b models.ScheduleBackfill) bool {
+		return b.Phase == models.ScheduleBackfillPhaseSucceeded
+	}))
+}
+
+func TestBackfillRunner_Resume(t *testing.T) {
+	executor := &recordingExecutor{}
+	backfill := hourlyBackfill(models.ScheduleBackfillPhaseRunning, 2)
+	// The backfill times are recomputed on resume, so it resumes after the last time it fired rather than after its
+	// number of completed executions.
+	lastFiredTime := backfillStart.Add(3 * time.Hour)
+	backfill.LastFiredTime = &lastFiredTime
+	runner, backfillRepo := setupBackfillRunner(backfill, executor)
+	backfillRepo.OnUpdateMatch(mock.Anything, mock.Anything).Return(true, nil)
+
+	runner.Run(context.Background())
+	assert.Equal(t, hoursAfterStart(4), executor.fired)
+	backfillRepo.AssertCalled(t, "Update", mock.Anything, mock.MatchedBy(func(b models.ScheduleBackfill) bool {
+		return b.Phase == models.ScheduleBackfillPhaseSucceeded && b.CompletedExecutions == 3 &&
+			b.LastFiredTime.Equal(backfillStart.Add(4*time.Hour))
+	}))
+
+	// Descending backfills resume with the times before the last one they fired.
+	executor = &recordingExecutor{}
+	backfill.Order = models.ScheduleBackfillOrderDescending
+	runner, backfillRepo = setupBackfillRunner(backfill, executor)
+	backfillRepo.OnUpdateMatch(mock.Anything, mock.Anything).Return(true, nil)
+
+	runner.Run(context.Background())
+	assert.ElementsMatch(t, hoursAfterStart(2, 1, 0), executor.fired)
+}
+
+func TestBackfillRunner_ResumeWithoutLastFiredTime(t *testing.T) {
+	executor := &recordingExecutor{}
+	runner, backfillRepo := setupBackfillRunner(hourlyBackfill(models.ScheduleBackfillPhaseRunning, 4), executor)
+	backfillRepo.OnUpdateMatch(mock.Anything, mock.Anything).Return(true, nil)
+
+	runner.Run(context.Background())
+	assert.Equal(t, hoursAfterStart(4), executor.fired)
+}
+
+func TestBackfillRunner_Cancelled(t *testing.T) {
+	executor := &recordingExecutor{}
+	runner, backfillRepo := setupBackfillRunner(hourlyBackfill(models.ScheduleBackfillPhaseRunning, 0), executor)
+	// The backfill is cancelled while its first batch is fired.
+	backfillRepo.OnUpdateMatch(mock.Anything, mock.Anything).Return(false, nil)
+
+	runner.Run(context.Background())
+	assert.Len(t, executor.fired, 2)
+	backfillRepo.AssertNumberOfCalls(t, "Update", 1)
+}
+
+func TestBackfillRunner_Failure(t *testing.T) {
+	executor := &recordingExecutor{failAfter: 3}
+	runner, backfillRepo := setupBackfillRunner(hourlyBackfill(models.ScheduleBackfillPhaseRunning, 0), executor)
+	backfillRepo.OnUpdateMatch(mock.Anything, mock.Anything).Return(true, nil)
+
+	runner.Run(context.Background())
+	assert.Len(t, executor.fired, 3)
+	backfillRepo.AssertCalled(t, "Update", mock.Anything, mock.MatchedBy(func(b models.ScheduleBackfill) bool {
+		return b.Phase == models.ScheduleBackfillPhaseFailed && b.CompletedExecutions == 2 &&
+			b.Error == "admin unavailable"
+	}))
+}
@@ -0,0 +1,55 @@
+package core
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CatchupPolicyAnnotation is the launch plan annotation which sets the catch-up policy of its schedule.
+const CatchupPolicyAnnotation = "flyte.org/catchup-policy"
+
+// Catch-up policies decide which of the schedule times missed while the scheduler was down are fired.
+const (
+	// Fires all the missed times.
+	CatchupPolicyAll = "all"
+	// Fires only the most recent missed time.
+	CatchupPolicyLatestOnly = "latest-only"
+	// Fires none of the missed times.
+	CatchupPolicyNone = "none"
+	// Prefix of max-N, which fires at most the N most recent missed times.
+	CatchupPolicyMaxPrefix = "max-"
+)
+
+// ParseCatchupPolicy returns the maximum number of missed times the catch-up policy fires, or a negative number if it
+// fires all of them. An empty policy fires all of them.
+func ParseCatchupPolicy(policy string) (int, error) {
+	switch policy {
+	case "", CatchupPolicyAll:
+		return -1, nil
+	case CatchupPolicyLatestOnly:
+		return 1, nil
+	case CatchupPolicyNone:
+		return 0, nil
+	}
+	if strings.HasPrefix(policy, CatchupPolicyMaxPrefix) {
+		if limit, err := strconv.Atoi(strings.TrimPrefix(policy, CatchupPolicyMaxPrefix)); err == nil && limit > 0 {
+			return limit, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid catch-up policy [%s], expected one of %s, %s, %s or %sN", policy,
+		CatchupPolicyAll, CatchupPolicyLatestOnly, CatchupPolicyNone, CatchupPolicyMaxPrefix)
+}
+
+// ApplyCatchupPolicy returns the times which the catch-up policy fires out of the missed times in ascending order.
+func ApplyCatchupPolicy(policy string, missedTimes []time.Time) ([]time.Time, error) {
+	limit, err := ParseCatchupPolicy(policy)
+	if err != nil {
+		return nil, err
+	}
+	if limit >= 0 && len(missedTimes) > limit {
+		return missedTimes[len(missedTimes)-limit:], nil
+	}
+	return missedTimes, nil
+}
@@ -0,0 +1,99 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flytestdlib/config"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+)
+
+func TestApplyCatchupPolicy(t *testing.T) {
+	missedTimes := hoursAfterStart(0, 1, 2, 3)
+	testCases := []struct {
+		policy   string
+		expected []time.Time
+	}{
+		{policy: "", expected: missedTimes},
+		{policy: CatchupPolicyAll, expected: missedTimes},
+		{policy: CatchupPolicyLatestOnly, expected: hoursAfterStart(3)},
+		{policy: CatchupPolicyNone, expected: []time.Time{}},
+		{policy: "max-2", expected: hoursAfterStart(2, 3)},
+		{policy: "max-10", expected: missedTimes},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.policy, func(t *testing.T) {
+			caughtUp, err := ApplyCatchupPolicy(tc.policy, missedTimes)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, caughtUp)
+		})
+	}
+
+	for _, policy := range []string{"latest", "max-0", "max-", "max-two"} {
+		_, err := ApplyCatchupPolicy(policy, missedTimes)
+		assert.Error(t, err, policy)
+	}
+}
+
+func TestCatchUpSingleSchedule_Policy(t *testing.T) {
+	hourly := models.SchedulableEntity{CronExpression: "0 * * * *"}
+	latestOnly := hourly
+	latestOnly.CatchupPolicy = CatchupPolicyLatestOnly
+	testCases := []struct {
+		name          string
+		schedule      models.SchedulableEntity
+		catchupConfig runtimeInterfaces.ScheduleCatchupConfig
+		expected      []time.Time
+	}{
+		{
+			name:          "default policy",
+			schedule:      hourly,
+			catchupConfig: runtimeInterfaces.ScheduleCatchupConfig{DefaultPolicy: CatchupPolicyAll},
+			expected:      hoursAfterStart(1, 2, 3, 4),
+		},
+		{
+			name:          "schedule policy overrides the default",
+			schedule:      latestOnly,
+			catchupConfig: runtimeInterfaces.ScheduleCatchupConfig{DefaultPolicy: CatchupPolicyAll},
+			expected:      hoursAfterStart(4),
+		},
+		{
+			name:     "lookback window",
+			schedule: hourly,
+			catchupConfig: runtimeInterfaces.ScheduleCatchupConfig{
+				DefaultPolicy: CatchupPolicyAll,
+				Lookback:      config.Duration{Duration: 150 * time.Minute},
+			},
+			expected: hoursAfterStart(3, 4),
+		},
+		{
+			name:     "policy within the lookback window",
+			schedule: hourly,
+			catchupConfig: runtimeInterfaces.ScheduleCatchupConfig{
+				DefaultPolicy: "max-3",
+				Lookback:      config.Duration{Duration: 150 * time.Minute},
+			},
+			expected: hoursAfterStart(3, 4),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			executor := &recordingExecutor{}
+			scheduler := &GoCronScheduler{
+				executor:      executor,
+				rateLimiter:   rate.NewLimiter(rate.Inf, 1),
+				catchupConfig: tc.catchupConfig,
+			}
+			// The scheduler was down from the tick at the start until half past the fourth hour.
+			err := scheduler.CatchUpSingleSchedule(context.Background(), tc.schedule, backfillStart,
+				backfillStart.Add(270*time.Minute))
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, executor.fired)
+		})
+	}
+}
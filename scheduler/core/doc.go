@@ -3,7 +3,13 @@
 // - scheduler interface
 // - scheduler implementation using gocron https://github.com/robfig/cron
 // - updater which updates the schedules in the scheduler by reading periodically from the DB
+//   and, with a listener configured, applies the schedule updates admin notifies it of as they come.
 // - snapshot runner which snapshot the schedules with there last exec times so that it can be used as check point
 //   in case of a crash. After a crash the scheduler replays the schedules from the last recorded snapshot.
 //   It relies on the admin idempotency aspect to fail executions if the execution with a scheduled time already exists with it.
+// - backfill runner which fires the executions of the schedule backfills requested through admin over a time range.
+// - leader elector which lets only one of several scheduler replicas run the scheduler by holding a lease in the DB.
+//   A standby which takes over replays the schedules from the snapshot written by the previous leader.
+// - storage trigger runner which polls the storage prefixes of the launch plans with a storage trigger and launches
+//   them once for every new object. A watermark per trigger in the DB records the objects already launched for.
 package core
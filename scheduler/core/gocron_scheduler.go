@@ -6,6 +6,7 @@ import (
 	"sync"
 	"time"
 
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyteadmin/scheduler/executor"
 	"github.com/flyteorg/flyteadmin/scheduler/identifier"
 	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
@@ -35,6 +36,10 @@ type GoCronScheduler struct {
 	rateLimiter *rate.Limiter
 	executor    executor.Executor
 	snapshot    snapshoter.Snapshot
+	// Saves the fire times of the schedules as they fire. Optional.
+	persistence snapshoter.Persistence
+	// Which of the missed schedule times are fired during the catch up.
+	catchupConfig runtimeInterfaces.ScheduleCatchupConfig
 }
 
 func (g *GoCronScheduler) GetTimedFuncWithSchedule() TimedFuncWithSchedule {
@@ -44,8 +49,18 @@ func (g *GoCronScheduler) GetTimedFuncWithSchedule() TimedFuncWithSchedule {
 		if err != nil {
 			logger.Errorf(jobCtx, "unable to fire the schedule %+v at %v time due to %v", schedule, scheduleTime,
 				err)
+			return err
 		}
-		return err
+		g.saveLastExecutionTime(jobCtx, schedule, scheduleTime)
+		return nil
+	}
+}
+
+// saveLastExecutionTime persists the time the schedule fired so that a restarted scheduler catches up from it.
+func (g *GoCronScheduler) saveLastExecutionTime(ctx context.Context, schedule models.SchedulableEntity,
+	scheduleTime time.Time) {
+	if g.persistence != nil {
+		g.persistence.SaveLastExecutionTime(ctx, schedule, scheduleTime)
 	}
 }
 
@@ -98,6 +113,9 @@ func (g *GoCronScheduler) CalculateSnapshot(ctx context.Context) snapshoter.Snap
 		scheduleIdentifier := key.(string)
 		if job.lastTime != nil {
 			snapshot.UpdateLastExecutionTime(scheduleIdentifier, job.lastTime)
+		} else if job.catchupFromTime != nil {
+			// Keep the time the schedule was bootstrapped from until it fires again.
+			snapshot.UpdateLastExecutionTime(scheduleIdentifier, job.catchupFromTime)
 		}
 		return true
 	})
@@ -192,7 +210,7 @@ func (g *GoCronScheduler) CatchupAll(ctx context.Context, until time.Time) bool
 func (g *GoCronScheduler) CatchUpSingleSchedule(ctx context.Context, s models.SchedulableEntity, fromTime time.Time, toTime time.Time) error {
 	var catchUpTimes []time.Time
 	var err error
-	catchUpTimes, err = GetCatchUpTimes(s, fromTime, toTime)
+	catchUpTimes, err = g.getPolicyCatchUpTimes(s, fromTime, toTime)
 	if err != nil {
 		return err
 	}
@@ -205,10 +223,32 @@ func (g *GoCronScheduler) CatchUpSingleSchedule(ctx context.Context, s models.Sc
 			logger.Errorf(ctx, "unable to fire the schedule %+v at %v time due to %v", s, catchupTime, err)
 			return err
 		}
+		g.saveLastExecutionTime(ctx, s, catchupTime)
 	}
 	return nil
 }
 
+// Returns the missed times in (from, to] which the catch-up policy of the schedule fires, oldest first.
+func (g *GoCronScheduler) getPolicyCatchUpTimes(s models.SchedulableEntity, from time.Time, to time.Time) (
+	[]time.Time, error) {
+	policy := s.CatchupPolicy
+	if len(policy) == 0 {
+		policy = g.catchupConfig.DefaultPolicy
+	}
+	if lookback := g.catchupConfig.Lookback.Duration; lookback > 0 && from.Before(to.Add(-lookback)) {
+		from = to.Add(-lookback)
+	}
+	catchUpTimes, err := GetCatchUpTimes(s, from, to)
+	if err != nil {
+		return nil, err
+	}
+	// The times after to are fired by the cron scheduler.
+	for len(catchUpTimes) > 0 && catchUpTimes[len(catchUpTimes)-1].After(to) {
+		catchUpTimes = catchUpTimes[:len(catchUpTimes)-1]
+	}
+	return ApplyCatchupPolicy(policy, catchUpTimes)
+}
+
 func GetCatchUpTimes(s models.SchedulableEntity, from time.Time, to time.Time) ([]time.Time, error) {
 	var scheduledTimes []time.Time
 	currFrom := from
@@ -225,13 +265,13 @@ func GetCatchUpTimes(s models.SchedulableEntity, from time.Time, to time.Time) (
 
 func GetScheduledTime(s models.SchedulableEntity, fromTime time.Time) (time.Time, error) {
 	if len(s.CronExpression) > 0 {
-		return getCronScheduledTime(s.CronExpression, fromTime)
+		return getCronScheduledTime(s.CronExpression, s.Timezone, fromTime)
 	}
 	return getFixedIntervalScheduledTime(s.Unit, s.FixedRateValue, fromTime)
 }
 
-func getCronScheduledTime(cronString string, fromTime time.Time) (time.Time, error) {
-	sched, err := cron.ParseStandard(cronString)
+func getCronScheduledTime(cronString string, timezone string, fromTime time.Time) (time.Time, error) {
+	sched, err := parseCronSchedule(cronString, timezone)
 	if err != nil {
 		return time.Time{}, err
 	}
@@ -275,14 +315,15 @@ func (g *GoCronScheduler) AddCronJob(ctx context.Context, job *GoCronJob) error
 	var jobFunc cron.TimedFuncJob
 	jobFunc = job.Run
 
-	entryID, err := g.cron.AddTimedJob(job.schedule.CronExpression, jobFunc)
-	// Update the enttry id in the job which is handle to be used for removal
-	job.entryID = entryID
-	if err == nil {
-		logger.Infof(ctx, "successfully added the schedule %s to the scheduler for schedule %+v",
-			job.nameOfSchedule, job.schedule)
+	schedule, err := parseCronSchedule(job.schedule.CronExpression, job.schedule.Timezone)
+	if err != nil {
+		return err
 	}
-	return err
+	// Update the enttry id in the job which is handle to be used for removal
+	job.entryID = g.cron.ScheduleTimedJob(schedule, jobFunc)
+	logger.Infof(ctx, "successfully added the schedule %s to the scheduler for schedule %+v",
+		job.nameOfSchedule, job.schedule)
+	return nil
 }
 
 func (g *GoCronScheduler) RemoveCronJob(ctx context.Context, job *GoCronJob) {
@@ -308,17 +349,25 @@ func getFixedRateDurationFromSchedule(unit admin.FixedRateUnit, fixedRateValue u
 }
 
 func NewGoCronScheduler(ctx context.Context, schedules []models.SchedulableEntity, scope promutils.Scope,
-	snapshot snapshoter.Snapshot, rateLimiter *rate.Limiter, executor executor.Executor) Scheduler {
+	snapshot snapshoter.Snapshot, persistence snapshoter.Persistence, rateLimiter *rate.Limiter,
+	executor executor.Executor, catchupConfig runtimeInterfaces.ScheduleCatchupConfig) Scheduler {
 	// Create the new cron scheduler and start it off
 	c := cron.New()
 	c.Start()
+	// Stop firing the schedules once the scheduler is shut down or loses leadership.
+	go func() {
+		<-ctx.Done()
+		c.Stop()
+	}()
 	scheduler := &GoCronScheduler{
-		cron:        c,
-		jobStore:    sync.Map{},
-		metrics:     getCronMetrics(scope),
-		rateLimiter: rateLimiter,
-		executor:    executor,
-		snapshot:    snapshot,
+		cron:          c,
+		jobStore:      sync.Map{},
+		metrics:       getCronMetrics(scope),
+		rateLimiter:   rateLimiter,
+		executor:      executor,
+		snapshot:      snapshot,
+		persistence:   persistence,
+		catchupConfig: catchupConfig,
 	}
 	scheduler.BootStrapSchedulesFromSnapShot(ctx, schedules, snapshot)
 	return scheduler
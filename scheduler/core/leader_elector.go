@@ -0,0 +1,163 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+
+	"github.com/benbjohnson/clock"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// LeadFunc runs the scheduler while this replica is the leader. The context passed to it is cancelled as soon as
+// leadership is lost.
+type LeadFunc func(ctx context.Context) error
+
+type leaderElectorMetrics struct {
+	Scope              promutils.Scope
+	IsLeader           prometheus.Gauge
+	LeadershipAcquired prometheus.Counter
+	LeadershipLost     prometheus.Counter
+	LeaseErrors        prometheus.Counter
+}
+
+// LeaderElector elects a single leader among the scheduler replicas using a lease row in the scheduler tables.
+// The leader renews the lease periodically while standbys poll for it to expire.
+type LeaderElector struct {
+	db       repositories.SchedulerRepoInterface
+	config   runtimeInterfaces.SchedulerLeaderElectionConfig
+	holderID string
+	clock    clock.Clock
+	metrics  leaderElectorMetrics
+}
+
+// Returns whether this replica holds the lease after the attempt.
+func (l *LeaderElector) tryAcquire(ctx context.Context) (bool, error) {
+	acquired, err := l.db.SchedulerLeaseRepo().Acquire(ctx, interfaces.AcquireLeaseInput{
+		Name:     l.config.LeaseName,
+		HolderID: l.holderID,
+		Now:      l.clock.Now(),
+		Duration: l.config.LeaseDuration.Duration,
+	})
+	if err != nil {
+		l.metrics.LeaseErrors.Inc()
+		logger.Warningf(ctx, "Failed to acquire scheduler lease [%s] as [%s] due to %v", l.config.LeaseName,
+			l.holderID, err)
+	}
+	return acquired, err
+}
+
+// Blocks until the lease is acquired. Returns false if the context is done first.
+func (l *LeaderElector) waitForLeadership(ctx context.Context) bool {
+	for {
+		if acquired, _ := l.tryAcquire(ctx); acquired {
+			return true
+		}
+		select {
+		case <-ctx.Done():
+			return false
+		case <-l.clock.After(l.config.RetryInterval.Duration):
+		}
+	}
+}
+
+// Runs the lead func while renewing the lease. Returns whether leadership was lost along with the error the lead
+// func returned when it stopped by itself.
+func (l *LeaderElector) lead(ctx context.Context, leadFunc LeadFunc) (bool, error) {
+	logger.Infof(ctx, "Scheduler replica [%s] acquired leadership", l.holderID)
+	l.metrics.LeadershipAcquired.Inc()
+	l.metrics.IsLeader.Set(1)
+	defer l.metrics.IsLeader.Set(0)
+
+	leaderCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- leadFunc(leaderCtx)
+	}()
+
+	lastRenewal := l.clock.Now()
+	ticker := l.clock.Ticker(l.config.RenewInterval.Duration)
+	defer ticker.Stop()
+	for {
+		select {
+		case err := <-done:
+			if releaseErr := l.db.SchedulerLeaseRepo().Release(ctx, l.config.LeaseName, l.holderID); releaseErr != nil {
+				logger.Warningf(ctx, "Failed to release scheduler lease [%s] due to %v", l.config.LeaseName, releaseErr)
+			}
+			return false, err
+		case <-ticker.C:
+			acquired, err := l.tryAcquire(ctx)
+			if acquired {
+				lastRenewal = l.clock.Now()
+				continue
+			}
+			// Transient failures are tolerated as long as the lease can't have expired by the next renewal.
+			if err != nil && l.clock.Now().Add(l.config.RenewInterval.Duration).Before(
+				lastRenewal.Add(l.config.LeaseDuration.Duration)) {
+				continue
+			}
+			logger.Warningf(ctx, "Scheduler replica [%s] lost leadership", l.holderID)
+			l.metrics.LeadershipLost.Inc()
+			cancel()
+			<-done
+			return true, nil
+		}
+	}
+}
+
+// Run waits for this replica to become the leader and then runs the lead func until the context is done or the lead
+// func stops by itself. When leadership is lost the lead func is stopped and an error is returned, since the scheduler
+// isn't meant to be restarted in the same process. The restarted replica becomes a standby.
+func (l *LeaderElector) Run(ctx context.Context, leadFunc LeadFunc) error {
+	logger.Infof(ctx, "Scheduler replica [%s] waiting for leadership", l.holderID)
+	if !l.waitForLeadership(ctx) {
+		return nil
+	}
+	lost, err := l.lead(ctx, leadFunc)
+	if lost && ctx.Err() == nil {
+		return fmt.Errorf("scheduler replica [%s] lost leadership of lease [%s]", l.holderID, l.config.LeaseName)
+	}
+	return err
+}
+
+func newLeaderElectorMetrics(scope promutils.Scope) leaderElectorMetrics {
+	return leaderElectorMetrics{
+		Scope: scope,
+		IsLeader: scope.MustNewGauge("is_leader",
+			"whether this scheduler replica is currently the leader"),
+		LeadershipAcquired: scope.MustNewCounter("leadership_acquired",
+			"count of times this scheduler replica became the leader"),
+		LeadershipLost: scope.MustNewCounter("leadership_lost",
+			"count of times this scheduler replica lost leadership before it stopped"),
+		LeaseErrors: scope.MustNewCounter("lease_errors",
+			"count of failed attempts to acquire or renew the scheduler lease"),
+	}
+}
+
+// Identifies a replica by its host name with a random suffix so that restarted replicas don't reuse leases.
+func newHolderID() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return fmt.Sprintf("%s-%s", hostname, uuid.New().String())
+}
+
+func NewLeaderElector(db repositories.SchedulerRepoInterface, config runtimeInterfaces.SchedulerLeaderElectionConfig,
+	scope promutils.Scope, clock clock.Clock) *LeaderElector {
+	return &LeaderElector{
+		db:       db,
+		config:   config,
+		holderID: newHolderID(),
+		clock:    clock,
+		metrics:  newLeaderElectorMetrics(scope.NewSubScope("leader_election")),
+	}
+}
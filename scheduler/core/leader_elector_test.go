@@ -0,0 +1,83 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	schedMocks "github.com/flyteorg/flyteadmin/scheduler/repositories/mocks"
+	"github.com/flyteorg/flytestdlib/config"
+	"github.com/flyteorg/flytestdlib/promutils"
+
+	"github.com/benbjohnson/clock"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var testLeaderElectionConfig = runtimeInterfaces.SchedulerLeaderElectionConfig{
+	Enabled:       true,
+	LeaseName:     "flytescheduler",
+	LeaseDuration: config.Duration{Duration: 100 * time.Millisecond},
+	RenewInterval: config.Duration{Duration: 10 * time.Millisecond},
+	RetryInterval: config.Duration{Duration: 10 * time.Millisecond},
+}
+
+func setupLeaderElector() (*LeaderElector, *schedMocks.SchedulerLeaseRepoInterface) {
+	db := mocks.NewMockRepository()
+	elector := NewLeaderElector(db, testLeaderElectionConfig, promutils.NewTestScope(), clock.New())
+	return elector, db.SchedulerLeaseRepo().(*schedMocks.SchedulerLeaseRepoInterface)
+}
+
+func TestLeaderElector_TakeOverAndLoseLeadership(t *testing.T) {
+	for _, renewErr := range []error{nil, errors.New("db unavailable")} {
+		elector, leaseRepo := setupLeaderElector()
+		leaseRepo.OnAcquireMatch(mock.Anything, mock.Anything).Return(false, nil).Once()
+		leaseRepo.OnAcquireMatch(mock.Anything, mock.Anything).Return(true, nil).Once()
+		leaseRepo.OnAcquireMatch(mock.Anything, mock.Anything).Return(false, renewErr)
+
+		var leadCalls int
+		var isLeader float64
+		err := elector.Run(context.Background(), func(leaderCtx context.Context) error {
+			leadCalls++
+			isLeader = testutil.ToFloat64(elector.metrics.IsLeader)
+			<-leaderCtx.Done()
+			return leaderCtx.Err()
+		})
+		assert.EqualError(t, err, fmt.Sprintf("scheduler replica [%s] lost leadership of lease [flytescheduler]",
+			elector.holderID))
+		assert.Equal(t, 1, leadCalls)
+		assert.Equal(t, float64(1), isLeader)
+		assert.Equal(t, float64(0), testutil.ToFloat64(elector.metrics.IsLeader))
+		assert.Equal(t, float64(1), testutil.ToFloat64(elector.metrics.LeadershipLost))
+		leaseRepo.AssertNotCalled(t, "Release", mock.Anything, mock.Anything, mock.Anything)
+	}
+}
+
+func TestLeaderElector_LeadFuncFails(t *testing.T) {
+	elector, leaseRepo := setupLeaderElector()
+	leaseRepo.OnAcquireMatch(mock.Anything, mock.Anything).Return(true, nil)
+	leaseRepo.OnReleaseMatch(mock.Anything, "flytescheduler", elector.holderID).Return(nil)
+
+	err := elector.Run(context.Background(), func(ctx context.Context) error {
+		return errors.New("failed to read snapshot")
+	})
+	assert.EqualError(t, err, "failed to read snapshot")
+	leaseRepo.AssertCalled(t, "Release", mock.Anything, "flytescheduler", elector.holderID)
+}
+
+func TestLeaderElector_StandbyShutdown(t *testing.T) {
+	elector, leaseRepo := setupLeaderElector()
+	leaseRepo.OnAcquireMatch(mock.Anything, mock.Anything).Return(false, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.NoError(t, elector.Run(ctx, func(ctx context.Context) error {
+		t.Fatal("standby shouldn't lead")
+		return nil
+	}))
+}
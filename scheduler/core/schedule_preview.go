@@ -0,0 +1,89 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/scheduler/calendar"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+// Bounds the search for the next times of schedules whose calendars exclude all of them.
+const maxConsecutiveExcludedTimes = 100000
+
+// NewSchedulableEntity converts the schedule of a launch plan to the entity which the native scheduler fires.
+func NewSchedulableEntity(key models.SchedulableEntityKey, schedule admin.Schedule, catchupPolicy string) (
+	models.SchedulableEntity, error) {
+	var cronString string
+	var timezone string
+	var fixedRateValue uint32
+	var fixedRateUnit admin.FixedRateUnit
+	switch v := schedule.GetScheduleExpression().(type) {
+	case *admin.Schedule_Rate:
+		fixedRateValue = v.Rate.Value
+		fixedRateUnit = v.Rate.Unit
+	case *admin.Schedule_CronSchedule:
+		// The timezone of the schedule is given by an optional CRON_TZ= prefix, e.g. "CRON_TZ=Europe/Berlin 0 9 * * *".
+		cronString, timezone = SplitCronTimezone(v.CronSchedule.Schedule)
+		if len(timezone) > 0 {
+			if _, err := time.LoadLocation(timezone); err != nil {
+				return models.SchedulableEntity{}, fmt.Errorf("unknown timezone [%s]: %v", timezone, err)
+			}
+		}
+	default:
+		return models.SchedulableEntity{}, fmt.Errorf("unknown schedule expression type %v", v)
+	}
+	if _, err := ParseCatchupPolicy(catchupPolicy); err != nil {
+		return models.SchedulableEntity{}, err
+	}
+	active := true
+	return models.SchedulableEntity{
+		SchedulableEntityKey: key,
+		CronExpression:       cronString,
+		Timezone:             timezone,
+		FixedRateValue:       fixedRateValue,
+		Unit:                 fixedRateUnit,
+		KickoffTimeInputArg:  schedule.KickoffTimeInputArg,
+		CatchupPolicy:        catchupPolicy,
+		Active:               &active,
+	}, nil
+}
+
+// GetNextScheduledTimes returns at most limit of the times after from at which the native scheduler fires the
+// schedule. Times at or after to are excluded unless to is zero. Fixed rate schedules are assumed to be activated at
+// from. Times excluded by the calendars of the schedule are skipped.
+func GetNextScheduledTimes(s models.SchedulableEntity, from time.Time, to time.Time, limit int,
+	calendars calendar.Calendars) ([]time.Time, error) {
+	var scheduledTimes []time.Time
+	excluded := 0
+	for len(scheduledTimes) < limit && excluded < maxConsecutiveExcludedTimes {
+		scheduledTime, err := GetScheduledTime(s, from)
+		if err != nil {
+			return nil, err
+		}
+		// The cron parser returns the zero time for expressions which don't fire in the next five years.
+		if !scheduledTime.After(from) || (!to.IsZero() && !scheduledTime.Before(to)) {
+			break
+		}
+		if len(calendars.GetExcludingCalendar(s.ExclusionCalendars, scheduledTime)) == 0 {
+			scheduledTimes = append(scheduledTimes, scheduledTime)
+			excluded = 0
+		} else {
+			excluded++
+		}
+		from = scheduledTime
+	}
+	return scheduledTimes, nil
+}
+
+// GetKickoffTimeInputs returns the inputs which the native scheduler binds for an execution fired at scheduledTime.
+// Only cron schedules bind the kickoff time.
+func GetKickoffTimeInputs(s models.SchedulableEntity, scheduledTime time.Time) map[string]time.Time {
+	if len(s.CronExpression) == 0 || len(s.KickoffTimeInputArg) == 0 {
+		return nil
+	}
+	return map[string]time.Time{
+		s.KickoffTimeInputArg: scheduledTime,
+	}
+}
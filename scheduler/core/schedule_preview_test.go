@@ -0,0 +1,99 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteadmin/scheduler/calendar"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSchedulableEntity(t *testing.T) {
+	key := models.SchedulableEntityKey{Project: "project", Domain: "domain", Name: "lp", Version: "v1"}
+	entity, err := NewSchedulableEntity(key, admin.Schedule{
+		ScheduleExpression: &admin.Schedule_CronSchedule{
+			CronSchedule: &admin.CronSchedule{Schedule: "CRON_TZ=Europe/Berlin 0 9 * * *"},
+		},
+		KickoffTimeInputArg: "kickoff_time",
+	}, CatchupPolicyLatestOnly)
+	assert.NoError(t, err)
+	assert.Equal(t, key, entity.SchedulableEntityKey)
+	assert.Equal(t, "0 9 * * *", entity.CronExpression)
+	assert.Equal(t, "Europe/Berlin", entity.Timezone)
+	assert.Equal(t, "kickoff_time", entity.KickoffTimeInputArg)
+	assert.Equal(t, CatchupPolicyLatestOnly, entity.CatchupPolicy)
+	assert.True(t, *entity.Active)
+
+	invalidSchedules := []admin.Schedule{
+		{ScheduleExpression: &admin.Schedule_CronExpression{CronExpression: "0 9 * * ? *"}},
+		{ScheduleExpression: &admin.Schedule_CronSchedule{
+			CronSchedule: &admin.CronSchedule{Schedule: "CRON_TZ=Mars/Olympus_Mons 0 9 * * *"}}},
+	}
+	for _, schedule := range invalidSchedules {
+		_, err = NewSchedulableEntity(key, schedule, "")
+		assert.Error(t, err)
+	}
+	_, err = NewSchedulableEntity(key, admin.Schedule{
+		ScheduleExpression: &admin.Schedule_Rate{Rate: &admin.FixedRate{Value: 1, Unit: admin.FixedRateUnit_HOUR}},
+	}, "max-zero")
+	assert.Error(t, err)
+}
+
+func TestGetNextScheduledTimes(t *testing.T) {
+	hourly := models.SchedulableEntity{CronExpression: "0 * * * *", KickoffTimeInputArg: "kickoff_time"}
+	scheduledTimes, err := GetNextScheduledTimes(hourly, backfillStart, time.Time{}, 3, nil)
+	assert.NoError(t, err)
+	// The scheduler fires strictly after the time it starts from.
+	assert.Equal(t, hoursAfterStart(1, 2, 3), scheduledTimes)
+
+	scheduledTimes, err = GetNextScheduledTimes(hourly, backfillStart, backfillStart.Add(3*time.Hour), 10, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, hoursAfterStart(1, 2), scheduledTimes)
+
+	fixedRate := models.SchedulableEntity{FixedRateValue: 2, Unit: admin.FixedRateUnit_HOUR}
+	scheduledTimes, err = GetNextScheduledTimes(fixedRate, backfillStart, time.Time{}, 2, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, hoursAfterStart(2, 4), scheduledTimes)
+
+	// The 30th of February never comes.
+	scheduledTimes, err = GetNextScheduledTimes(models.SchedulableEntity{CronExpression: "0 0 30 2 *"},
+		backfillStart, time.Time{}, 1, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, scheduledTimes)
+
+	scheduledTimes, err = GetNextScheduledTimes(models.SchedulableEntity{Unit: admin.FixedRateUnit_HOUR},
+		backfillStart, time.Time{}, 1, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, scheduledTimes)
+}
+
+func TestGetNextScheduledTimes_ExclusionCalendars(t *testing.T) {
+	calendars, err := calendar.New(map[string]runtimeInterfaces.ScheduleCalendarConfig{
+		"weekends": {Weekdays: []string{"Saturday", "Sunday"}},
+		"always":   {Weekdays: []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}},
+	})
+	assert.NoError(t, err)
+	// The preview starts on a Friday.
+	daily := models.SchedulableEntity{CronExpression: "0 0 * * *", ExclusionCalendars: "weekends"}
+	scheduledTimes, err := GetNextScheduledTimes(daily, backfillStart, time.Time{}, 2, calendars)
+	assert.NoError(t, err)
+	assert.Equal(t, hoursAfterStart(72, 96), scheduledTimes)
+
+	// Schedules which are always excluded don't fire.
+	daily.ExclusionCalendars = "always"
+	scheduledTimes, err = GetNextScheduledTimes(daily, backfillStart, time.Time{}, 1, calendars)
+	assert.NoError(t, err)
+	assert.Empty(t, scheduledTimes)
+}
+
+func TestGetKickoffTimeInputs(t *testing.T) {
+	scheduledTime := hoursAfterStart(1)[0]
+	assert.Equal(t, map[string]time.Time{"kickoff_time": scheduledTime}, GetKickoffTimeInputs(
+		models.SchedulableEntity{CronExpression: "0 * * * *", KickoffTimeInputArg: "kickoff_time"}, scheduledTime))
+	assert.Nil(t, GetKickoffTimeInputs(models.SchedulableEntity{
+		FixedRateValue: 1, Unit: admin.FixedRateUnit_HOUR, KickoffTimeInputArg: "kickoff_time"}, scheduledTime))
+}
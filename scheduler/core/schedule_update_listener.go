@@ -0,0 +1,86 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flytestdlib/logger"
+
+	"github.com/lib/pq"
+)
+
+const scheduleUpdateListenerMinReconnectInterval = time.Second
+const scheduleUpdateListenerMaxReconnectInterval = time.Minute
+
+// Pings the otherwise idle connection so that a lost connection is noticed and reestablished.
+const scheduleUpdateListenerPingInterval = 90 * time.Second
+
+// ScheduleUpdateListener receives the notifications admin sends for the schedules it activates and deactivates.
+type ScheduleUpdateListener interface {
+	// Listen delivers the keys of the updated schedules until the context is done, when the channel is closed. A nil
+	// key is delivered when notifications may have been missed, e.g. while the connection was lost, which calls for
+	// reading all the schedules.
+	Listen(ctx context.Context) (<-chan *models.SchedulableEntityKey, error)
+}
+
+type postgresScheduleUpdateListener struct {
+	connectionArgs string
+}
+
+func (l postgresScheduleUpdateListener) Listen(ctx context.Context) (<-chan *models.SchedulableEntityKey, error) {
+	listener := pq.NewListener(l.connectionArgs, scheduleUpdateListenerMinReconnectInterval,
+		scheduleUpdateListenerMaxReconnectInterval, func(event pq.ListenerEventType, err error) {
+			if err != nil {
+				logger.Warnf(ctx, "Schedule update listener connection event [%v] due to %v", event, err)
+			}
+		})
+	if err := listener.Listen(models.ScheduleUpdatesChannel); err != nil {
+		_ = listener.Close()
+		return nil, fmt.Errorf("failed to listen on [%s]: %v", models.ScheduleUpdatesChannel, err)
+	}
+	updates := make(chan *models.SchedulableEntityKey)
+	go func() {
+		defer close(updates)
+		defer func() {
+			_ = listener.Close()
+		}()
+		ticker := time.NewTicker(scheduleUpdateListenerPingInterval)
+		defer ticker.Stop()
+		for {
+			var update *models.SchedulableEntityKey
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				go func() {
+					_ = listener.Ping()
+				}()
+				continue
+			case notification := <-listener.Notify:
+				// The listener sends a nil notification once it has reconnected.
+				if notification != nil {
+					ID, err := models.DecodeScheduleUpdate(notification.Extra)
+					if err != nil {
+						logger.Warnf(ctx, "Ignoring the invalid schedule update [%s] due to %v", notification.Extra, err)
+						continue
+					}
+					update = &ID
+				}
+			}
+			select {
+			case updates <- update:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return updates, nil
+}
+
+// NewPostgresScheduleUpdateListener returns a listener for the notifications sent through Postgres LISTEN/NOTIFY on
+// a dedicated connection to the database.
+func NewPostgresScheduleUpdateListener(connectionArgs string) ScheduleUpdateListener {
+	return postgresScheduleUpdateListener{connectionArgs: connectionArgs}
+}
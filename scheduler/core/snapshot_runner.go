@@ -6,7 +6,7 @@ import (
 	sImpl "github.com/flyteorg/flyteadmin/scheduler/snapshoter"
 )
 
-const snapShotVersion = 1
+const snapShotVersion = 2
 
 // Snapshotrunner allows the ability to snapshot the scheduler state and save it to the db.
 // Its invoked periodically from the scheduledExecutor
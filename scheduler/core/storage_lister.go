@@ -0,0 +1,98 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/flyteorg/flytestdlib/storage"
+
+	"github.com/graymeta/stow"
+	"github.com/graymeta/stow/s3"
+)
+
+const storageListPageSize = 1000
+
+// StorageObject is an object found under a storage prefix.
+type StorageObject struct {
+	Reference    storage.DataReference
+	LastModified time.Time
+}
+
+// StorageLister lists the objects under storage prefixes, which the storage.DataStore doesn't support.
+type StorageLister interface {
+	// List returns all the objects whose reference starts with the prefix.
+	List(ctx context.Context, prefix storage.DataReference) ([]StorageObject, error)
+}
+
+type stowStorageLister struct {
+	location stow.Location
+}
+
+func (l stowStorageLister) List(ctx context.Context, prefix storage.DataReference) ([]StorageObject, error) {
+	scheme, containerName, key, err := prefix.Split()
+	if err != nil {
+		return nil, err
+	}
+	container, err := l.location.Container(containerName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load container [%s]: %v", containerName, err)
+	}
+	var objects []StorageObject
+	err = stow.Walk(container, key, storageListPageSize, func(item stow.Item, err error) error {
+		if err != nil {
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		lastModified, err := item.LastMod()
+		if err != nil {
+			return err
+		}
+		objects = append(objects, StorageObject{
+			Reference:    storage.DataReference(fmt.Sprintf("%s://%s/%s", scheme, containerName, item.Name())),
+			LastModified: lastModified,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the objects under [%s]: %v", prefix, err)
+	}
+	return objects, nil
+}
+
+// NewStowStorageLister returns a lister of the storage configured for the data store. It connects to the storage the
+// same way the stow backed data store does and doesn't support the in-memory store.
+func NewStowStorageLister(cfg *storage.Config) (StorageLister, error) {
+	if cfg.Type == storage.TypeMemory {
+		return nil, fmt.Errorf("objects can't be listed in the [%s] storage", cfg.Type)
+	}
+	kind := cfg.Stow.Kind
+	configMap := stow.ConfigMap(cfg.Stow.Config)
+	if len(kind) == 0 || len(configMap) == 0 {
+		// Legacy configurations which configure S3 via the connection config
+		kind = s3.Kind
+		configMap = stow.ConfigMap{
+			s3.ConfigAuthType: cfg.Connection.AuthType,
+			s3.ConfigRegion:   cfg.Connection.Region,
+		}
+		if endpoint := cfg.Connection.Endpoint.String(); endpoint != "" {
+			configMap[s3.ConfigEndpoint] = endpoint
+		}
+		if cfg.Connection.AccessKey != "" {
+			configMap[s3.ConfigAccessKeyID] = cfg.Connection.AccessKey
+		}
+		if cfg.Connection.SecretKey != "" {
+			configMap[s3.ConfigSecretKey] = cfg.Connection.SecretKey
+		}
+		if cfg.Connection.DisableSSL {
+			configMap[s3.ConfigDisableSSL] = "True"
+		}
+	}
+	location, err := stow.Dial(kind, configMap)
+	if err != nil {
+		return nil, fmt.Errorf("unable to configure the storage for %s: %v", kind, err)
+	}
+	return stowStorageLister{location: location}, nil
+}
@@ -0,0 +1,58 @@
+package core
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flytestdlib/storage"
+
+	"github.com/graymeta/stow/local"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStowStorageLister(t *testing.T) {
+	root, err := ioutil.TempDir("", "storage_lister")
+	assert.NoError(t, err)
+	defer os.RemoveAll(root)
+	modified := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+	for _, key := range []string{"landing/a.csv", "landing/nested/b.csv", "other/c.csv"} {
+		filePath := filepath.Join(root, "bucket", filepath.FromSlash(key))
+		assert.NoError(t, os.MkdirAll(filepath.Dir(filePath), 0755))
+		assert.NoError(t, ioutil.WriteFile(filePath, []byte(key), 0644))
+		assert.NoError(t, os.Chtimes(filePath, modified, modified))
+	}
+
+	lister, err := NewStowStorageLister(&storage.Config{
+		Type: storage.TypeLocal,
+		Stow: storage.StowConfig{
+			Kind:   local.Kind,
+			Config: map[string]string{local.ConfigKeyPath: root},
+		},
+	})
+	assert.NoError(t, err)
+	objects, err := lister.List(context.Background(), "file://bucket/landing/")
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []StorageObject{
+		{Reference: "file://bucket/landing/a.csv", LastModified: modified},
+		{Reference: "file://bucket/landing/nested/b.csv", LastModified: modified},
+	}, normalizeLastModified(objects))
+
+	_, err = lister.List(context.Background(), "file://missing/landing/")
+	assert.Error(t, err)
+}
+
+func TestNewStowStorageLister_InMemory(t *testing.T) {
+	_, err := NewStowStorageLister(&storage.Config{Type: storage.TypeMemory})
+	assert.Error(t, err)
+}
+
+func normalizeLastModified(objects []StorageObject) []StorageObject {
+	for i := range objects {
+		objects[i].LastModified = objects[i].LastModified.UTC()
+	}
+	return objects
+}
@@ -0,0 +1,99 @@
+package core
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/storage"
+)
+
+// Launch plan annotations which declare a storage trigger, launching the launch plan once for every new object under a
+// storage prefix.
+const (
+	// The storage prefix which is polled for new objects, e.g. s3://bucket/landing/.
+	StorageTriggerPrefixAnnotation = "flyte.org/storage-trigger-prefix"
+	// Glob pattern, see path.Match, which the keys of new objects relative to the prefix must match. Defaults to *,
+	// which matches the objects directly under the prefix.
+	StorageTriggerPatternAnnotation = "flyte.org/storage-trigger-pattern"
+	// How often the prefix is polled, as a duration such as 5m. Defaults to a minute.
+	StorageTriggerIntervalAnnotation = "flyte.org/storage-trigger-interval"
+	// The launch plan input the new object is bound to. Either a string input, which is given the URI of the object,
+	// or a single blob input.
+	StorageTriggerInputAnnotation = "flyte.org/storage-trigger-input"
+)
+
+const defaultStorageTriggerPattern = "*"
+const defaultStorageTriggerInterval = time.Minute
+
+// NewStorageTrigger parses the storage trigger declared by the annotations of a launch plan, whose free inputs are
+// given. Returns nil when the launch plan doesn't declare one.
+func NewStorageTrigger(key models.SchedulableEntityKey, annotations map[string]string, inputs *core.ParameterMap) (
+	*models.StorageTrigger, error) {
+	prefix, ok := annotations[StorageTriggerPrefixAnnotation]
+	if !ok {
+		for _, annotation := range []string{StorageTriggerPatternAnnotation, StorageTriggerIntervalAnnotation,
+			StorageTriggerInputAnnotation} {
+			if _, ok := annotations[annotation]; ok {
+				return nil, fmt.Errorf("annotation [%s] requires the [%s] annotation", annotation,
+					StorageTriggerPrefixAnnotation)
+			}
+		}
+		return nil, nil
+	}
+	prefix = strings.TrimSpace(prefix)
+	if scheme, container, _, err := storage.DataReference(prefix).Split(); err != nil || scheme == "" || container == "" {
+		return nil, fmt.Errorf("invalid storage prefix [%s], expected scheme://container/key", prefix)
+	}
+
+	pattern := strings.TrimSpace(annotations[StorageTriggerPatternAnnotation])
+	if pattern == "" {
+		pattern = defaultStorageTriggerPattern
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, fmt.Errorf("invalid storage trigger pattern [%s]: %v", pattern, err)
+	}
+
+	interval := defaultStorageTriggerInterval
+	if value := strings.TrimSpace(annotations[StorageTriggerIntervalAnnotation]); value != "" {
+		var err error
+		if interval, err = time.ParseDuration(value); err != nil || interval <= 0 {
+			return nil, fmt.Errorf("invalid storage trigger interval [%s], expected a positive duration such as 5m",
+				value)
+		}
+	}
+
+	trigger := &models.StorageTrigger{
+		Project:      key.Project,
+		Domain:       key.Domain,
+		Name:         key.Name,
+		Version:      key.Version,
+		Prefix:       prefix,
+		Pattern:      pattern,
+		PollInterval: interval,
+		InputName:    strings.TrimSpace(annotations[StorageTriggerInputAnnotation]),
+	}
+	if trigger.InputName == "" {
+		return nil, fmt.Errorf("annotation [%s] is required to bind the new objects to an input",
+			StorageTriggerInputAnnotation)
+	}
+	input, ok := inputs.GetParameters()[trigger.InputName]
+	if !ok {
+		return nil, fmt.Errorf("input [%s] is not free or does not exist", trigger.InputName)
+	}
+	inputType := input.GetVar().GetType()
+	switch {
+	case inputType.GetSimple() == core.SimpleType_STRING:
+		trigger.InputType = models.StorageTriggerInputTypeString
+	case inputType.GetBlob() != nil && inputType.GetBlob().Dimensionality == core.BlobType_SINGLE:
+		trigger.InputType = models.StorageTriggerInputTypeBlob
+		trigger.BlobFormat = inputType.GetBlob().Format
+	default:
+		return nil, fmt.Errorf("input [%s] must be a string or a single blob, not a [%v]", trigger.InputName,
+			inputType)
+	}
+	return trigger, nil
+}
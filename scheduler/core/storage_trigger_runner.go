@@ -0,0 +1,234 @@
+package core
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteadmin/scheduler/executor"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/flyteorg/flytestdlib/storage"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+)
+
+type storageTriggerRunnerMetrics struct {
+	Scope                 promutils.Scope
+	PollFailures          prometheus.Counter
+	ExecutionsFired       prometheus.Counter
+	ExecutionFailures     prometheus.Counter
+	WatermarkUpdateErrors prometheus.Counter
+	ObjectRecordErrors    prometheus.Counter
+}
+
+// StorageTriggerRunner polls the prefixes of the active storage triggers and launches their launch plan once for every
+// new object. The watermark of a trigger is advanced past the objects launched for so that they aren't launched for
+// again, including after a restart or a change of leader. Objects which show up late with a last modified time within
+// the grace period before the watermark are compared against the recorded objects launched for instead.
+type StorageTriggerRunner struct {
+	db          repositories.SchedulerRepoInterface
+	lister      StorageLister
+	executor    executor.Executor
+	rateLimiter *rate.Limiter
+	config      runtimeInterfaces.StorageTriggersConfig
+	metrics     storageTriggerRunnerMetrics
+}
+
+// The database keeps timestamps to the microsecond so the last modified times are truncated to compare them with the
+// watermark.
+func getWatermarkTime(lastModified time.Time) time.Time {
+	return lastModified.UTC().Truncate(time.Microsecond)
+}
+
+// Returns whether the object comes after the watermark of the trigger, in the order objects are launched for.
+func isPastWatermark(trigger models.StorageTrigger, object StorageObject) bool {
+	watermarkTime := getWatermarkTime(trigger.WatermarkTime)
+	return object.LastModified.After(watermarkTime) ||
+		(object.LastModified.Equal(watermarkTime) && string(object.Reference) > trigger.WatermarkKey)
+}
+
+func getLaunchedObjectKey(reference string, lastModified time.Time) string {
+	return reference + "@" + strconv.FormatInt(getWatermarkTime(lastModified).UnixNano(), 10)
+}
+
+// GetNewStorageObjects returns the objects under the prefix of the trigger which match its pattern and are past its
+// watermark, in the order they are launched for. Objects last modified within the grace period before the watermark
+// are new too, unless they are among the objects the trigger already launched for.
+func GetNewStorageObjects(trigger models.StorageTrigger, objects []StorageObject, gracePeriod time.Duration,
+	launched []models.StorageTriggerObject) []StorageObject {
+	graceTime := getWatermarkTime(trigger.WatermarkTime).Add(-gracePeriod)
+	launchedKeys := make(map[string]bool, len(launched))
+	for _, object := range launched {
+		launchedKeys[getLaunchedObjectKey(object.Reference, object.LastModified)] = true
+	}
+	var newObjects []StorageObject
+	for _, object := range objects {
+		reference := string(object.Reference)
+		if !strings.HasPrefix(reference, trigger.Prefix) {
+			continue
+		}
+		relativeKey := strings.TrimPrefix(strings.TrimPrefix(reference, trigger.Prefix), "/")
+		if matched, err := path.Match(trigger.Pattern, relativeKey); err != nil || !matched {
+			continue
+		}
+		newObject := StorageObject{Reference: object.Reference, LastModified: getWatermarkTime(object.LastModified)}
+		if !isPastWatermark(trigger, newObject) && (gracePeriod <= 0 || newObject.LastModified.Before(graceTime)) {
+			continue
+		}
+		if launchedKeys[getLaunchedObjectKey(reference, newObject.LastModified)] {
+			continue
+		}
+		newObjects = append(newObjects, newObject)
+	}
+	sort.Slice(newObjects, func(i, j int) bool {
+		if !newObjects[i].LastModified.Equal(newObjects[j].LastModified) {
+			return newObjects[i].LastModified.Before(newObjects[j].LastModified)
+		}
+		return newObjects[i].Reference < newObjects[j].Reference
+	})
+	return newObjects
+}
+
+// Launches the launch plan of the trigger for the new objects under its prefix, oldest first, and advances the
+// watermark past those launched for. A failure stops the poll so that the remaining objects are retried by the next.
+// With a grace period, the objects launched for are recorded until they fall out of it. Objects launched for before
+// they were recorded, e.g. before the grace period was configured, have executions with the same deterministic name
+// and aren't launched for twice.
+func (r StorageTriggerRunner) poll(ctx context.Context, trigger models.StorageTrigger, now time.Time) {
+	objects, err := r.lister.List(ctx, storage.DataReference(trigger.Prefix))
+	if err != nil {
+		r.metrics.PollFailures.Inc()
+		logger.Errorf(ctx, "Failed to poll the prefix [%s] of the storage trigger of launch plan %s/%s/%s due to %v",
+			trigger.Prefix, trigger.Project, trigger.Domain, trigger.Name, err)
+		return
+	}
+	gracePeriod := r.config.LateObjectGracePeriod.Duration
+	var launched []models.StorageTriggerObject
+	if gracePeriod > 0 {
+		launched, err = r.db.StorageTriggerRepo().ListObjects(ctx, trigger.ID,
+			getWatermarkTime(trigger.WatermarkTime).Add(-gracePeriod))
+		if err != nil {
+			r.metrics.PollFailures.Inc()
+			logger.Errorf(ctx, "Failed to list the objects launched for by the storage trigger of launch plan "+
+				"%s/%s/%s due to %v", trigger.Project, trigger.Domain, trigger.Name, err)
+			return
+		}
+	}
+	newObjects := GetNewStorageObjects(trigger, objects, gracePeriod, launched)
+	if maxObjects := r.config.MaxObjectsPerPoll; maxObjects > 0 && len(newObjects) > maxObjects {
+		newObjects = newObjects[:maxObjects]
+	}
+	for _, object := range newObjects {
+		if err = r.rateLimiter.Wait(ctx); err != nil {
+			break
+		}
+		if err = r.executor.ExecuteForObject(ctx, trigger, string(object.Reference), object.LastModified); err != nil {
+			r.metrics.ExecutionFailures.Inc()
+			logger.Errorf(ctx, "Failed to launch %s/%s/%s for the object [%s] due to %v", trigger.Project,
+				trigger.Domain, trigger.Name, object.Reference, err)
+			break
+		}
+		r.metrics.ExecutionsFired.Inc()
+		if gracePeriod > 0 {
+			r.recordObject(ctx, trigger, object)
+		}
+		if isPastWatermark(trigger, object) {
+			trigger.WatermarkTime = object.LastModified
+			trigger.WatermarkKey = string(object.Reference)
+		}
+	}
+	trigger.LastPolledAt = now
+	updated, err := r.db.StorageTriggerRepo().UpdateWatermark(ctx, trigger)
+	if err != nil {
+		r.metrics.WatermarkUpdateErrors.Inc()
+		logger.Errorf(ctx, "Failed to record the watermark of the storage trigger of launch plan %s/%s/%s due to %v",
+			trigger.Project, trigger.Domain, trigger.Name, err)
+		return
+	}
+	if !updated {
+		logger.Infof(ctx, "Storage trigger of launch plan %s/%s/%s changed while it was polled", trigger.Project,
+			trigger.Domain, trigger.Name)
+		return
+	}
+	if gracePeriod > 0 {
+		if err := r.db.StorageTriggerRepo().DeleteObjects(ctx, trigger.ID,
+			getWatermarkTime(trigger.WatermarkTime).Add(-gracePeriod)); err != nil {
+			logger.Warnf(ctx, "Failed to delete the objects launched for by the storage trigger of launch plan "+
+				"%s/%s/%s due to %v", trigger.Project, trigger.Domain, trigger.Name, err)
+		}
+	}
+}
+
+// Records an object launched for. Should this fail, the execution for the object is found to exist when the object is
+// launched for again.
+func (r StorageTriggerRunner) recordObject(ctx context.Context, trigger models.StorageTrigger, object StorageObject) {
+	if err := r.db.StorageTriggerRepo().CreateObject(ctx, models.StorageTriggerObject{
+		StorageTriggerID: trigger.ID,
+		Reference:        string(object.Reference),
+		LastModified:     object.LastModified,
+	}); err != nil {
+		r.metrics.ObjectRecordErrors.Inc()
+		logger.Warnf(ctx, "Failed to record the object [%s] launched for by the storage trigger of launch plan "+
+			"%s/%s/%s due to %v", object.Reference, trigger.Project, trigger.Domain, trigger.Name, err)
+	}
+}
+
+// Run polls the active storage triggers whose poll interval has elapsed since they were last polled.
+func (r StorageTriggerRunner) Run(ctx context.Context) {
+	triggers, err := r.db.StorageTriggerRepo().GetAllActive(ctx)
+	if err != nil {
+		logger.Errorf(ctx, "Failed to fetch the storage triggers in this round due to %v", err)
+		return
+	}
+	now := time.Now()
+	for _, trigger := range triggers {
+		if ctx.Err() != nil {
+			return
+		}
+		interval := trigger.PollInterval
+		if interval < r.config.MinPollInterval.Duration {
+			interval = r.config.MinPollInterval.Duration
+		}
+		if now.Before(trigger.LastPolledAt.Add(interval)) {
+			continue
+		}
+		r.poll(ctx, trigger, now)
+	}
+}
+
+func NewStorageTriggerRunner(db repositories.SchedulerRepoInterface, lister StorageLister,
+	executor executor.Executor, rateLimiter *rate.Limiter, config runtimeInterfaces.StorageTriggersConfig,
+	scope promutils.Scope) StorageTriggerRunner {
+	return StorageTriggerRunner{
+		db:          db,
+		lister:      lister,
+		executor:    executor,
+		rateLimiter: rateLimiter,
+		config:      config,
+		metrics:     newStorageTriggerRunnerMetrics(scope.NewSubScope("storage_trigger")),
+	}
+}
+
+func newStorageTriggerRunnerMetrics(scope promutils.Scope) storageTriggerRunnerMetrics {
+	return storageTriggerRunnerMetrics{
+		Scope: scope,
+		PollFailures: scope.MustNewCounter("poll_failures",
+			"count of failures to list the objects under the prefix of a storage trigger"),
+		ExecutionsFired: scope.MustNewCounter("executions_fired",
+			"count of executions fired for new objects by storage triggers"),
+		ExecutionFailures: scope.MustNewCounter("execution_failures",
+			"count of failures to fire an execution for a new object"),
+		WatermarkUpdateErrors: scope.MustNewCounter("watermark_update_errors",
+			"count of failures to record the watermark of storage triggers"),
+		ObjectRecordErrors: scope.MustNewCounter("object_record_errors",
+			"count of failures to record the objects storage triggers launched for"),
+	}
+}
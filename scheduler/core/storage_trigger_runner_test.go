@@ -0,0 +1,238 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	schedMocks "github.com/flyteorg/flyteadmin/scheduler/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flytestdlib/config"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/flyteorg/flytestdlib/storage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/time/rate"
+)
+
+var watermark = time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+
+type fakeStorageLister struct {
+	objects []StorageObject
+	err     error
+}
+
+func (l fakeStorageLister) List(ctx context.Context, prefix storage.DataReference) ([]StorageObject, error) {
+	return l.objects, l.err
+}
+
+func landingTrigger() models.StorageTrigger {
+	active := true
+	return models.StorageTrigger{
+		ID:            1,
+		Project:       "project",
+		Domain:        "domain",
+		Name:          "lp",
+		Version:       "v1",
+		Prefix:        "s3://bucket/landing/",
+		Pattern:       "*.csv",
+		PollInterval:  time.Minute,
+		InputName:     "path",
+		InputType:     models.StorageTriggerInputTypeString,
+		Active:        &active,
+		WatermarkTime: watermark,
+	}
+}
+
+func landingObjects() []StorageObject {
+	return []StorageObject{
+		{Reference: "s3://bucket/landing/c.csv", LastModified: watermark.Add(2 * time.Minute)},
+		{Reference: "s3://bucket/landing/b.csv", LastModified: watermark.Add(time.Minute)},
+		{Reference: "s3://bucket/landing/a.csv", LastModified: watermark.Add(time.Minute)},
+		// Older than the watermark.
+		{Reference: "s3://bucket/landing/old.csv", LastModified: watermark.Add(-time.Minute)},
+		// Don't match the pattern.
+		{Reference: "s3://bucket/landing/d.json", LastModified: watermark.Add(time.Minute)},
+		{Reference: "s3://bucket/landing/nested/e.csv", LastModified: watermark.Add(time.Minute)},
+		{Reference: "s3://bucket/landing2/f.csv", LastModified: watermark.Add(time.Minute)},
+	}
+}
+
+func setupStorageTriggerRunner(trigger models.StorageTrigger, lister StorageLister, executor *recordingExecutor,
+	maxObjectsPerPoll int) (StorageTriggerRunner, *schedMocks.StorageTriggerRepoInterface) {
+	return setupStorageTriggerRunnerWithConfig(trigger, lister, executor, runtimeInterfaces.StorageTriggersConfig{
+		MinPollInterval:   config.Duration{Duration: 30 * time.Second},
+		MaxObjectsPerPoll: maxObjectsPerPoll,
+	})
+}
+
+func setupStorageTriggerRunnerWithConfig(trigger models.StorageTrigger, lister StorageLister,
+	executor *recordingExecutor, storageTriggersConfig runtimeInterfaces.StorageTriggersConfig) (
+	StorageTriggerRunner, *schedMocks.StorageTriggerRepoInterface) {
+	db := mocks.NewMockRepository()
+	storageTriggerRepo := db.StorageTriggerRepo().(*schedMocks.StorageTriggerRepoInterface)
+	storageTriggerRepo.OnGetAllActiveMatch(mock.Anything).Return([]models.StorageTrigger{trigger}, nil)
+	return NewStorageTriggerRunner(db, lister, executor, rate.NewLimiter(rate.Inf, 1), storageTriggersConfig,
+		promutils.NewTestScope()), storageTriggerRepo
+}
+
+func TestGetNewStorageObjects(t *testing.T) {
+	trigger := landingTrigger()
+	assert.Equal(t, []StorageObject{
+		{Reference: "s3://bucket/landing/a.csv", LastModified: watermark.Add(time.Minute)},
+		{Reference: "s3://bucket/landing/b.csv", LastModified: watermark.Add(time.Minute)},
+		{Reference: "s3://bucket/landing/c.csv", LastModified: watermark.Add(2 * time.Minute)},
+	}, GetNewStorageObjects(trigger, landingObjects(), 0, nil))
+
+	// Objects modified at the watermark time are only new if they come after the watermark object.
+	trigger.WatermarkTime = watermark.Add(time.Minute)
+	trigger.WatermarkKey = "s3://bucket/landing/a.csv"
+	assert.Equal(t, []StorageObject{
+		{Reference: "s3://bucket/landing/b.csv", LastModified: watermark.Add(time.Minute)},
+		{Reference: "s3://bucket/landing/c.csv", LastModified: watermark.Add(2 * time.Minute)},
+	}, GetNewStorageObjects(trigger, landingObjects(), 0, nil))
+
+	trigger.Pattern = "*/*.csv"
+	assert.Equal(t, []StorageObject{
+		{Reference: "s3://bucket/landing/nested/e.csv", LastModified: watermark.Add(time.Minute)},
+	}, GetNewStorageObjects(trigger, landingObjects(), 0, nil))
+}
+
+func TestGetNewStorageObjects_GracePeriod(t *testing.T) {
+	trigger := landingTrigger()
+	trigger.WatermarkTime = watermark.Add(time.Minute)
+	trigger.WatermarkKey = "s3://bucket/landing/b.csv"
+	launched := []models.StorageTriggerObject{
+		{StorageTriggerID: 1, Reference: "s3://bucket/landing/a.csv", LastModified: watermark.Add(time.Minute)},
+		{StorageTriggerID: 1, Reference: "s3://bucket/landing/b.csv", LastModified: watermark.Add(time.Minute)},
+	}
+	// Objects modified within the grace period before the watermark are new unless they were launched for.
+	assert.Equal(t, []StorageObject{
+		{Reference: "s3://bucket/landing/old.csv", LastModified: watermark.Add(-time.Minute)},
+		{Reference: "s3://bucket/landing/c.csv", LastModified: watermark.Add(2 * time.Minute)},
+	}, GetNewStorageObjects(trigger, landingObjects(), 5*time.Minute, launched))
+
+	assert.Equal(t, []StorageObject{
+		{Reference: "s3://bucket/landing/c.csv", LastModified: watermark.Add(2 * time.Minute)},
+	}, GetNewStorageObjects(trigger, landingObjects(), time.Minute, launched))
+
+	// An object launched for is new again once it's modified.
+	launched[1].LastModified = watermark
+	assert.Equal(t, []StorageObject{
+		{Reference: "s3://bucket/landing/b.csv", LastModified: watermark.Add(time.Minute)},
+		{Reference: "s3://bucket/landing/c.csv", LastModified: watermark.Add(2 * time.Minute)},
+	}, GetNewStorageObjects(trigger, landingObjects(), time.Minute, launched))
+}
+
+func TestStorageTriggerRunner(t *testing.T) {
+	executor := &recordingExecutor{}
+	runner, storageTriggerRepo := setupStorageTriggerRunner(landingTrigger(),
+		fakeStorageLister{objects: landingObjects()}, executor, 0)
+	var updated []models.StorageTrigger
+	storageTriggerRepo.OnUpdateWatermarkMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		updated = append(updated, args.Get(1).(models.StorageTrigger))
+	}).Return(true, nil)
+
+	runner.Run(context.Background())
+	assert.Equal(t, []string{
+		"s3://bucket/landing/a.csv", "s3://bucket/landing/b.csv", "s3://bucket/landing/c.csv",
+	}, executor.objects)
+	assert.Len(t, updated, 1)
+	assert.Equal(t, watermark.Add(2*time.Minute), updated[0].WatermarkTime)
+	assert.Equal(t, "s3://bucket/landing/c.csv", updated[0].WatermarkKey)
+	assert.False(t, updated[0].LastPolledAt.IsZero())
+}
+
+func TestStorageTriggerRunner_MaxObjectsPerPoll(t *testing.T) {
+	executor := &recordingExecutor{}
+	runner, storageTriggerRepo := setupStorageTriggerRunner(landingTrigger(),
+		fakeStorageLister{objects: landingObjects()}, executor, 2)
+	var updated []models.StorageTrigger
+	storageTriggerRepo.OnUpdateWatermarkMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		updated = append(updated, args.Get(1).(models.StorageTrigger))
+	}).Return(true, nil)
+
+	runner.Run(context.Background())
+	assert.Equal(t, []string{"s3://bucket/landing/a.csv", "s3://bucket/landing/b.csv"}, executor.objects)
+	assert.Equal(t, "s3://bucket/landing/b.csv", updated[0].WatermarkKey)
+}
+
+func TestStorageTriggerRunner_ExecutionFailure(t *testing.T) {
+	executor := &recordingExecutor{failAfter: 1}
+	runner, storageTriggerRepo := setupStorageTriggerRunner(landingTrigger(),
+		fakeStorageLister{objects: landingObjects()}, executor, 0)
+	var updated []models.StorageTrigger
+	storageTriggerRepo.OnUpdateWatermarkMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		updated = append(updated, args.Get(1).(models.StorageTrigger))
+	}).Return(true, nil)
+
+	runner.Run(context.Background())
+	// The watermark only moves past the objects launched for, the others are retried by the next poll.
+	assert.Equal(t, []string{"s3://bucket/landing/a.csv"}, executor.objects)
+	assert.Equal(t, watermark.Add(time.Minute), updated[0].WatermarkTime)
+	assert.Equal(t, "s3://bucket/landing/a.csv", updated[0].WatermarkKey)
+}
+
+func TestStorageTriggerRunner_NotDue(t *testing.T) {
+	trigger := landingTrigger()
+	// Polled recently, within the minimum poll interval which raises the poll interval of the trigger.
+	trigger.PollInterval = time.Second
+	trigger.LastPolledAt = time.Now().Add(-10 * time.Second)
+	executor := &recordingExecutor{}
+	runner, storageTriggerRepo := setupStorageTriggerRunner(trigger, fakeStorageLister{objects: landingObjects()},
+		executor, 0)
+
+	runner.Run(context.Background())
+	assert.Empty(t, executor.objects)
+	storageTriggerRepo.AssertNotCalled(t, "UpdateWatermark", mock.Anything, mock.Anything)
+}
+
+func TestStorageTriggerRunner_ListFailure(t *testing.T) {
+	executor := &recordingExecutor{}
+	runner, storageTriggerRepo := setupStorageTriggerRunner(landingTrigger(),
+		fakeStorageLister{err: errors.New("access denied")}, executor, 0)
+
+	runner.Run(context.Background())
+	assert.Empty(t, executor.objects)
+	storageTriggerRepo.AssertNotCalled(t, "UpdateWatermark", mock.Anything, mock.Anything)
+}
+
+func TestStorageTriggerRunner_GracePeriod(t *testing.T) {
+	trigger := landingTrigger()
+	trigger.WatermarkTime = watermark.Add(time.Minute)
+	trigger.WatermarkKey = "s3://bucket/landing/b.csv"
+	executor := &recordingExecutor{}
+	runner, storageTriggerRepo := setupStorageTriggerRunnerWithConfig(trigger,
+		fakeStorageLister{objects: landingObjects()}, executor, runtimeInterfaces.StorageTriggersConfig{
+			LateObjectGracePeriod: config.Duration{Duration: 5 * time.Minute},
+		})
+	storageTriggerRepo.OnListObjectsMatch(mock.Anything, uint(1), watermark.Add(-4*time.Minute)).Return(
+		[]models.StorageTriggerObject{
+			{StorageTriggerID: 1, Reference: "s3://bucket/landing/a.csv", LastModified: watermark.Add(time.Minute)},
+			{StorageTriggerID: 1, Reference: "s3://bucket/landing/b.csv", LastModified: watermark.Add(time.Minute)},
+		}, nil)
+	var created []models.StorageTriggerObject
+	storageTriggerRepo.OnCreateObjectMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		created = append(created, args.Get(1).(models.StorageTriggerObject))
+	}).Return(nil)
+	var updated []models.StorageTrigger
+	storageTriggerRepo.OnUpdateWatermarkMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		updated = append(updated, args.Get(1).(models.StorageTrigger))
+	}).Return(true, nil)
+	storageTriggerRepo.OnDeleteObjectsMatch(mock.Anything, uint(1), watermark.Add(-3*time.Minute)).Return(nil)
+
+	runner.Run(context.Background())
+	// The late object is launched for without moving the watermark back.
+	assert.Equal(t, []string{"s3://bucket/landing/old.csv", "s3://bucket/landing/c.csv"}, executor.objects)
+	assert.Equal(t, []models.StorageTriggerObject{
+		{StorageTriggerID: 1, Reference: "s3://bucket/landing/old.csv", LastModified: watermark.Add(-time.Minute)},
+		{StorageTriggerID: 1, Reference: "s3://bucket/landing/c.csv", LastModified: watermark.Add(2 * time.Minute)},
+	}, created)
+	assert.Equal(t, watermark.Add(2*time.Minute), updated[0].WatermarkTime)
+	assert.Equal(t, "s3://bucket/landing/c.csv", updated[0].WatermarkKey)
+	storageTriggerRepo.AssertExpectations(t)
+}
@@ -0,0 +1,93 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var storageTriggerInputs = &core.ParameterMap{
+	Parameters: map[string]*core.Parameter{
+		"path": {
+			Var: &core.Variable{
+				Type: &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_STRING}},
+			},
+		},
+		"file": {
+			Var: &core.Variable{
+				Type: &core.LiteralType{Type: &core.LiteralType_Blob{Blob: &core.BlobType{
+					Format:         "csv",
+					Dimensionality: core.BlobType_SINGLE,
+				}}},
+			},
+		},
+		"files": {
+			Var: &core.Variable{
+				Type: &core.LiteralType{Type: &core.LiteralType_Blob{Blob: &core.BlobType{
+					Dimensionality: core.BlobType_MULTIPART,
+				}}},
+			},
+		},
+	},
+}
+
+func TestNewStorageTrigger(t *testing.T) {
+	key := models.SchedulableEntityKey{Project: "project", Domain: "domain", Name: "lp", Version: "v1"}
+	trigger, err := NewStorageTrigger(key, map[string]string{
+		StorageTriggerPrefixAnnotation: "s3://bucket/landing/",
+		StorageTriggerInputAnnotation:  "path",
+	}, storageTriggerInputs)
+	assert.NoError(t, err)
+	assert.Equal(t, &models.StorageTrigger{
+		Project:      "project",
+		Domain:       "domain",
+		Name:         "lp",
+		Version:      "v1",
+		Prefix:       "s3://bucket/landing/",
+		Pattern:      "*",
+		PollInterval: time.Minute,
+		InputName:    "path",
+		InputType:    models.StorageTriggerInputTypeString,
+	}, trigger)
+
+	trigger, err = NewStorageTrigger(key, map[string]string{
+		StorageTriggerPrefixAnnotation:   "gs://bucket/landing/",
+		StorageTriggerPatternAnnotation:  "*/*.csv",
+		StorageTriggerIntervalAnnotation: "5m",
+		StorageTriggerInputAnnotation:    "file",
+	}, storageTriggerInputs)
+	assert.NoError(t, err)
+	assert.Equal(t, "*/*.csv", trigger.Pattern)
+	assert.Equal(t, 5*time.Minute, trigger.PollInterval)
+	assert.Equal(t, models.StorageTriggerInputTypeBlob, trigger.InputType)
+	assert.Equal(t, "csv", trigger.BlobFormat)
+}
+
+func TestNewStorageTrigger_None(t *testing.T) {
+	trigger, err := NewStorageTrigger(models.SchedulableEntityKey{}, map[string]string{}, storageTriggerInputs)
+	assert.NoError(t, err)
+	assert.Nil(t, trigger)
+}
+
+func TestNewStorageTrigger_Invalid(t *testing.T) {
+	for _, annotations := range []map[string]string{
+		{StorageTriggerInputAnnotation: "path"},
+		{StorageTriggerPrefixAnnotation: "landing/", StorageTriggerInputAnnotation: "path"},
+		{StorageTriggerPrefixAnnotation: "s3://bucket/landing/"},
+		{StorageTriggerPrefixAnnotation: "s3://bucket/landing/", StorageTriggerInputAnnotation: "missing"},
+		{StorageTriggerPrefixAnnotation: "s3://bucket/landing/", StorageTriggerInputAnnotation: "files"},
+		{StorageTriggerPrefixAnnotation: "s3://bucket/landing/", StorageTriggerInputAnnotation: "path",
+			StorageTriggerPatternAnnotation: "[a-"},
+		{StorageTriggerPrefixAnnotation: "s3://bucket/landing/", StorageTriggerInputAnnotation: "path",
+			StorageTriggerIntervalAnnotation: "-1m"},
+		{StorageTriggerPrefixAnnotation: "s3://bucket/landing/", StorageTriggerInputAnnotation: "path",
+			StorageTriggerIntervalAnnotation: "hourly"},
+	} {
+		_, err := NewStorageTrigger(models.SchedulableEntityKey{}, annotations, storageTriggerInputs)
+		assert.Error(t, err, annotations)
+	}
+}
@@ -2,8 +2,10 @@ package core
 
 import (
 	"context"
+	"sync"
 
 	"github.com/flyteorg/flyteadmin/scheduler/repositories"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
 	"github.com/flyteorg/flytestdlib/logger"
 )
 
@@ -11,9 +13,14 @@ import (
 type Updater struct {
 	db        repositories.SchedulerRepoInterface
 	scheduler Scheduler
+	// Serializes the periodic updates with the notified ones. It's held while the schedules are read, so that a full read
+	// which started before a notified update can't be applied after it.
+	lock *sync.Mutex
 }
 
 func (u Updater) UpdateGoCronSchedules(ctx context.Context) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
 	schedules, err := u.db.SchedulableEntityRepo().GetAll(ctx)
 	if err != nil {
 		logger.Errorf(ctx, "Failed to fetch the schedules in this round due to %v", err)
@@ -22,7 +29,32 @@ func (u Updater) UpdateGoCronSchedules(ctx context.Context) {
 	u.scheduler.UpdateSchedules(ctx, schedules)
 }
 
+// UpdateSchedule updates the scheduler with the latest state of a single schedule, e.g. once notified that it was
+// activated or deactivated.
+func (u Updater) UpdateSchedule(ctx context.Context, ID models.SchedulableEntityKey) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	schedule, err := u.db.SchedulableEntityRepo().Get(ctx, ID)
+	if err != nil {
+		logger.Errorf(ctx, "Failed to fetch the updated schedule %+v due to %v", ID, err)
+		return
+	}
+	u.scheduler.UpdateSchedules(ctx, []models.SchedulableEntity{schedule})
+}
+
+// ApplyUpdates applies the schedule updates until the channel is closed. A nil update, sent when updates may have been
+// missed, updates all the schedules.
+func (u Updater) ApplyUpdates(ctx context.Context, updates <-chan *models.SchedulableEntityKey) {
+	for update := range updates {
+		if update == nil {
+			u.UpdateGoCronSchedules(ctx)
+		} else {
+			u.UpdateSchedule(ctx, *update)
+		}
+	}
+}
+
 func NewUpdater(db repositories.SchedulerRepoInterface,
 	scheduler Scheduler) Updater {
-	return Updater{db: db, scheduler: scheduler}
+	return Updater{db: db, scheduler: scheduler, lock: &sync.Mutex{}}
 }
@@ -0,0 +1,105 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	schedMocks "github.com/flyteorg/flyteadmin/scheduler/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type recordingScheduler struct {
+	Scheduler
+	updates [][]models.SchedulableEntity
+}
+
+func (r *recordingScheduler) UpdateSchedules(ctx context.Context, s []models.SchedulableEntity) {
+	r.updates = append(r.updates, s)
+}
+
+func TestUpdater_ApplyUpdates(t *testing.T) {
+	db := mocks.NewMockRepository()
+	active := true
+	updated := models.SchedulableEntity{
+		SchedulableEntityKey: models.SchedulableEntityKey{Project: "p", Domain: "d", Name: "updated", Version: "v1"},
+		CronExpression:       "0 * * * *",
+		Active:               &active,
+	}
+	other := models.SchedulableEntity{
+		SchedulableEntityKey: models.SchedulableEntityKey{Project: "p", Domain: "d", Name: "other", Version: "v1"},
+		CronExpression:       "0 * * * *",
+		Active:               &active,
+	}
+	entityRepo := db.SchedulableEntityRepo().(*schedMocks.SchedulableEntityRepoInterface)
+	entityRepo.OnGetMatch(mock.Anything, updated.SchedulableEntityKey).Return(updated, nil)
+	entityRepo.OnGetAllMatch(mock.Anything).Return([]models.SchedulableEntity{updated, other}, nil)
+	scheduler := &recordingScheduler{}
+
+	updates := make(chan *models.SchedulableEntityKey, 2)
+	updates <- &updated.SchedulableEntityKey
+	// Updates which may have been missed update all the schedules.
+	updates <- nil
+	close(updates)
+	NewUpdater(db, scheduler).ApplyUpdates(context.Background(), updates)
+
+	assert.Equal(t, [][]models.SchedulableEntity{{updated}, {updated, other}}, scheduler.updates)
+	entityRepo.AssertNumberOfCalls(t, "Get", 1)
+	entityRepo.AssertNumberOfCalls(t, "GetAll", 1)
+}
+
+func TestUpdater_NotifiedUpdateAfterFullRead(t *testing.T) {
+	db := mocks.NewMockRepository()
+	active := true
+	stale := models.SchedulableEntity{
+		SchedulableEntityKey: models.SchedulableEntityKey{Project: "p", Domain: "d", Name: "updated", Version: "v1"},
+		CronExpression:       "0 * * * *",
+		Active:               &active,
+	}
+	inactive := false
+	updated := stale
+	updated.Active = &inactive
+	entityRepo := db.SchedulableEntityRepo().(*schedMocks.SchedulableEntityRepoInterface)
+	reading := make(chan struct{})
+	release := make(chan struct{})
+	entityRepo.OnGetAllMatch(mock.Anything).Run(func(args mock.Arguments) {
+		close(reading)
+		<-release
+	}).Return([]models.SchedulableEntity{stale}, nil)
+	entityRepo.OnGetMatch(mock.Anything, updated.SchedulableEntityKey).Return(updated, nil)
+	scheduler := &recordingScheduler{}
+	updater := NewUpdater(db, scheduler)
+
+	fullUpdateDone := make(chan struct{})
+	go func() {
+		updater.UpdateGoCronSchedules(context.Background())
+		close(fullUpdateDone)
+	}()
+	<-reading
+	// The schedule is updated while the full read is in flight, so its update has to be applied last.
+	updateDone := make(chan struct{})
+	go func() {
+		updater.UpdateSchedule(context.Background(), updated.SchedulableEntityKey)
+		close(updateDone)
+	}()
+	close(release)
+	<-fullUpdateDone
+	<-updateDone
+
+	assert.Equal(t, [][]models.SchedulableEntity{{stale}, {updated}}, scheduler.updates)
+}
+
+func TestScheduleUpdatePayload(t *testing.T) {
+	key := models.SchedulableEntityKey{Project: "p", Domain: "d", Name: "n", Version: "v1"}
+	payload, err := models.EncodeScheduleUpdate(key)
+	assert.NoError(t, err)
+	decoded, err := models.DecodeScheduleUpdate(payload)
+	assert.NoError(t, err)
+	assert.Equal(t, key, decoded)
+
+	_, err = models.DecodeScheduleUpdate("not json")
+	assert.Error(t, err)
+}
@@ -0,0 +1,108 @@
+package core
+
+import (
+	"strings"
+	"time"
+	// Embeds the IANA timezone database so that schedule timezones resolve regardless of the image's tzdata.
+	_ "time/tzdata"
+
+	"github.com/robfig/cron/v3"
+)
+
+var cronTimezonePrefixes = []string{"CRON_TZ=", "TZ="}
+
+// zonedCronSchedule evaluates a cron schedule on the wall clock of a timezone. Every matching wall clock time fires
+// once: times which don't exist because the clocks move forward fire when the clocks have moved, and times which occur
+// twice because the clocks move back fire at their first occurrence.
+type zonedCronSchedule struct {
+	schedule cron.Schedule
+	location *time.Location
+}
+
+func (s zonedCronSchedule) Next(t time.Time) time.Time {
+	wall := wallClock(t, s.location)
+	for {
+		wall = s.schedule.Next(wall)
+		if wall.IsZero() {
+			return wall
+		}
+		// Skips the second occurrence of a wall clock time which already fired before the clocks moved back.
+		if next := firstInstant(wall, s.location); next.After(t) {
+			return next.In(t.Location())
+		}
+	}
+}
+
+// Returns the reading of the wall clock of location at t as a UTC time, which has no DST transitions.
+func wallClock(t time.Time, location *time.Location) time.Time {
+	t = t.In(location)
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// Returns the first instant at which the wall clock of location reads wall, or the end of the DST gap if the wall
+// clock skips it.
+func firstInstant(wall time.Time, location *time.Location) time.Time {
+	// Transitions are months apart, so the offsets a day either side are the ones in effect around wall.
+	_, offsetBefore := wall.Add(-24 * time.Hour).In(location).Zone()
+	_, offsetAfter := wall.Add(24 * time.Hour).In(location).Zone()
+	var first time.Time
+	for _, offset := range []int{offsetBefore, offsetAfter} {
+		instant := wall.Add(-time.Duration(offset) * time.Second)
+		if wallClock(instant, location).Equal(wall) && (first.IsZero() || instant.Before(first)) {
+			first = instant
+		}
+	}
+	if !first.IsZero() {
+		return first
+	}
+	// The wall clock reads before wall at lo and after it at hi, search for the instant it jumps.
+	lo := wall.Add(-time.Duration(offsetAfter) * time.Second)
+	hi := wall.Add(-time.Duration(offsetBefore) * time.Second)
+	for hi.Sub(lo) > time.Nanosecond {
+		mid := lo.Add(hi.Sub(lo) / 2)
+		if wallClock(mid, location).Before(wall) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return hi
+}
+
+// SplitCronTimezone separates the optional CRON_TZ= or TZ= prefix of a cron schedule, e.g.
+// "CRON_TZ=America/New_York 0 9 * * 1-5", and returns the cron expression and the IANA timezone.
+func SplitCronTimezone(schedule string) (expression string, timezone string) {
+	schedule = strings.TrimSpace(schedule)
+	for _, prefix := range cronTimezonePrefixes {
+		if !strings.HasPrefix(schedule, prefix) {
+			continue
+		}
+		end := strings.Index(schedule, " ")
+		if end < 0 {
+			return "", schedule[len(prefix):]
+		}
+		return strings.TrimSpace(schedule[end+1:]), schedule[len(prefix):end]
+	}
+	return schedule, ""
+}
+
+// Parses a cron expression which is evaluated in the given timezone. A timezone prefix in the expression applies
+// when no timezone is given, and expressions without either are evaluated in the zone of the times passed to Next.
+func parseCronSchedule(cronExpression string, timezone string) (cron.Schedule, error) {
+	expression, expressionTimezone := SplitCronTimezone(cronExpression)
+	if len(timezone) == 0 {
+		timezone = expressionTimezone
+	}
+	schedule, err := cron.ParseStandard(expression)
+	if err != nil {
+		return nil, err
+	}
+	if len(timezone) == 0 {
+		return schedule, nil
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, err
+	}
+	return zonedCronSchedule{schedule: schedule, location: location}, nil
+}
@@ -0,0 +1,106 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func utcTime(month time.Month, day, hour, minute int) time.Time {
+	return time.Date(2021, month, day, hour, minute, 0, 0, time.UTC)
+}
+
+func TestGetScheduledTime_Timezone(t *testing.T) {
+	testCases := []struct {
+		name     string
+		schedule models.SchedulableEntity
+		from     time.Time
+		to       time.Time
+		expected []time.Time
+	}{
+		{
+			name:     "business days across the start of DST",
+			schedule: models.SchedulableEntity{CronExpression: "0 9 * * 1-5", Timezone: "Europe/Berlin"},
+			from:     utcTime(time.March, 26, 0, 0),
+			to:       utcTime(time.March, 30, 0, 0),
+			expected: []time.Time{utcTime(time.March, 26, 8, 0), utcTime(time.March, 29, 7, 0)},
+		},
+		{
+			name:     "timezone prefix in the cron expression",
+			schedule: models.SchedulableEntity{CronExpression: "CRON_TZ=Europe/Berlin 0 9 * * 1-5"},
+			from:     utcTime(time.March, 26, 0, 0),
+			to:       utcTime(time.March, 30, 0, 0),
+			expected: []time.Time{utcTime(time.March, 26, 8, 0), utcTime(time.March, 29, 7, 0)},
+		},
+		{
+			name:     "skipped wall clock time fires when the clocks have moved forward",
+			schedule: models.SchedulableEntity{CronExpression: "30 2 * * *", Timezone: "America/New_York"},
+			from:     utcTime(time.March, 13, 0, 0),
+			to:       utcTime(time.March, 16, 0, 0),
+			expected: []time.Time{utcTime(time.March, 13, 7, 30), utcTime(time.March, 14, 7, 0),
+				utcTime(time.March, 15, 6, 30)},
+		},
+		{
+			name:     "skipped wall clock times fire once",
+			schedule: models.SchedulableEntity{CronExpression: "*/30 * * * *", Timezone: "America/New_York"},
+			from:     utcTime(time.March, 14, 6, 0),
+			to:       utcTime(time.March, 14, 8, 0),
+			expected: []time.Time{utcTime(time.March, 14, 6, 0), utcTime(time.March, 14, 6, 30),
+				utcTime(time.March, 14, 7, 0), utcTime(time.March, 14, 7, 30)},
+		},
+		{
+			name:     "repeated wall clock time fires at its first occurrence",
+			schedule: models.SchedulableEntity{CronExpression: "30 1 * * *", Timezone: "America/New_York"},
+			from:     utcTime(time.November, 6, 0, 0),
+			to:       utcTime(time.November, 9, 0, 0),
+			expected: []time.Time{utcTime(time.November, 6, 5, 30), utcTime(time.November, 7, 5, 30),
+				utcTime(time.November, 8, 6, 30)},
+		},
+		{
+			name:     "without a timezone",
+			schedule: models.SchedulableEntity{CronExpression: "0 9 * * 1-5"},
+			from:     utcTime(time.March, 26, 0, 0),
+			to:       utcTime(time.March, 30, 0, 0),
+			expected: []time.Time{utcTime(time.March, 26, 9, 0), utcTime(time.March, 29, 9, 0)},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			scheduledTimes, err := GetBackfillTimes(tc.schedule, tc.from, tc.to, 0, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, scheduledTimes)
+
+			// Catching up from a fired time continues with the next time.
+			scheduledTime, err := GetScheduledTime(tc.schedule, tc.expected[0])
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected[1], scheduledTime)
+		})
+	}
+}
+
+func TestGetScheduledTime_UnknownTimezone(t *testing.T) {
+	_, err := GetScheduledTime(models.SchedulableEntity{CronExpression: "0 9 * * *", Timezone: "Mars/Olympus_Mons"},
+		utcTime(time.March, 26, 0, 0))
+	assert.Error(t, err)
+}
+
+func TestSplitCronTimezone(t *testing.T) {
+	testCases := []struct {
+		schedule   string
+		expression string
+		timezone   string
+	}{
+		{schedule: "0 9 * * 1-5", expression: "0 9 * * 1-5"},
+		{schedule: "CRON_TZ=Asia/Kolkata 0 9 * * 1-5", expression: "0 9 * * 1-5", timezone: "Asia/Kolkata"},
+		{schedule: "TZ=UTC  @daily", expression: "@daily", timezone: "UTC"},
+		{schedule: "CRON_TZ=UTC", timezone: "UTC"},
+	}
+	for _, tc := range testCases {
+		expression, timezone := SplitCronTimezone(tc.schedule)
+		assert.Equal(t, tc.expression, expression)
+		assert.Equal(t, tc.timezone, timezone)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/async/schedule/interfaces"
 	scheduleInterfaces "github.com/flyteorg/flyteadmin/pkg/async/schedule/interfaces"
 	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	schedulerCore "github.com/flyteorg/flyteadmin/scheduler/core"
 	"github.com/flyteorg/flyteadmin/scheduler/repositories"
 	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
@@ -17,6 +18,19 @@ import (
 // eventScheduler used for saving the scheduler entries after launch plans are enabled or disabled.
 type eventScheduler struct {
 	db repositories.SchedulerRepoInterface
+	// Whether the scheduler is notified of the schedules activated and deactivated.
+	notifyUpdates bool
+}
+
+// Notifies the scheduler of the update of a schedule. Failures are only logged since the scheduler still applies the
+// update once it reads all the schedules.
+func (s *eventScheduler) notifyUpdate(ctx context.Context, ID models.SchedulableEntityKey) {
+	if !s.notifyUpdates {
+		return
+	}
+	if err := s.db.ScheduleUpdateRepo().Notify(ctx, ID); err != nil {
+		logger.Warnf(ctx, "Failed to notify the scheduler of the update of schedule %+v due to %v", ID, err)
+	}
 }
 
 func (s *eventScheduler) CreateScheduleInput(ctx context.Context, appConfig *runtimeInterfaces.SchedulerConfig,
@@ -31,36 +45,21 @@ func (s *eventScheduler) CreateScheduleInput(ctx context.Context, appConfig *run
 
 func (s *eventScheduler) AddSchedule(ctx context.Context, input interfaces.AddScheduleInput) error {
 	logger.Infof(ctx, "Received call to add schedule [%+v]", input)
-	var cronString string
-	var fixedRateValue uint32
-	var fixedRateUnit admin.FixedRateUnit
-	switch v := input.ScheduleExpression.GetScheduleExpression().(type) {
-	case *admin.Schedule_Rate:
-		fixedRateValue = v.Rate.Value
-		fixedRateUnit = v.Rate.Unit
-	case *admin.Schedule_CronSchedule:
-		cronString = v.CronSchedule.Schedule
-	default:
-		return fmt.Errorf("failed adding schedule for unknown schedule expression type %v", v)
-	}
-	active := true
-	modelInput := models.SchedulableEntity{
-		CronExpression:      cronString,
-		FixedRateValue:      fixedRateValue,
-		Unit:                fixedRateUnit,
-		KickoffTimeInputArg: input.ScheduleExpression.KickoffTimeInputArg,
-		Active:              &active,
-		SchedulableEntityKey: models.SchedulableEntityKey{
-			Project: input.Identifier.Project,
-			Domain:  input.Identifier.Domain,
-			Name:    input.Identifier.Name,
-			Version: input.Identifier.Version,
-		},
+	modelInput, err := schedulerCore.NewSchedulableEntity(models.SchedulableEntityKey{
+		Project: input.Identifier.Project,
+		Domain:  input.Identifier.Domain,
+		Name:    input.Identifier.Name,
+		Version: input.Identifier.Version,
+	}, input.ScheduleExpression, input.CatchupPolicy)
+	if err != nil {
+		return fmt.Errorf("failed adding schedule: %v", err)
 	}
-	err := s.db.SchedulableEntityRepo().Activate(ctx, modelInput)
+	modelInput.ExclusionCalendars = input.ExclusionCalendars
+	err = s.db.SchedulableEntityRepo().Activate(ctx, modelInput)
 	if err != nil {
 		return err
 	}
+	s.notifyUpdate(ctx, modelInput.SchedulableEntityKey)
 	logger.Infof(ctx, "Activated scheduled entity for %v ", input)
 	return nil
 }
@@ -68,20 +67,28 @@ func (s *eventScheduler) AddSchedule(ctx context.Context, input interfaces.AddSc
 func (s *eventScheduler) RemoveSchedule(ctx context.Context, input interfaces.RemoveScheduleInput) error {
 	logger.Infof(ctx, "Received call to remove schedule [%+v]. Will deactivate it in the scheduler", input.Identifier)
 
-	err := s.db.SchedulableEntityRepo().Deactivate(ctx, models.SchedulableEntityKey{
+	ID := models.SchedulableEntityKey{
 		Project: input.Identifier.Project,
 		Domain:  input.Identifier.Domain,
 		Name:    input.Identifier.Name,
 		Version: input.Identifier.Version,
-	})
+	}
+	err := s.db.SchedulableEntityRepo().Deactivate(ctx, ID)
 
 	if err != nil {
 		return err
 	}
+	s.notifyUpdate(ctx, ID)
 	logger.Infof(ctx, "Deactivated the schedule %v in the scheduler", input)
 	return nil
 }
 
-func New(db repositories.SchedulerRepoInterface) interfaces.EventScheduler {
-	return &eventScheduler{db: db}
+// New returns the event scheduler of the native scheduler. The scheduler is notified of the schedule updates when the
+// config names a notifier.
+func New(db repositories.SchedulerRepoInterface,
+	scheduleUpdatesConfig runtimeInterfaces.ScheduleUpdatesConfig) interfaces.EventScheduler {
+	return &eventScheduler{
+		db:            db,
+		notifyUpdates: scheduleUpdatesConfig.Notifier == runtimeInterfaces.ScheduleUpdatesNotifierPostgres,
+	}
 }
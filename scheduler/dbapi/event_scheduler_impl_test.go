@@ -2,12 +2,15 @@ package dbapi
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/flyteorg/flyteadmin/pkg/async/schedule/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	schedMocks "github.com/flyteorg/flyteadmin/scheduler/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 
@@ -21,7 +24,7 @@ var (
 
 func setupEventScheduler() interfaces.EventScheduler {
 	db = mocks.NewMockRepository()
-	return New(db)
+	return New(db, runtimeInterfaces.ScheduleUpdatesConfig{})
 }
 
 func TestCreateScheduleInput(t *testing.T) {
@@ -115,6 +118,91 @@ func TestAddSchedule(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("cron_schedule_timezone", func(t *testing.T) {
+		eventScheduler := setupEventScheduler()
+		schedule := admin.Schedule{
+			ScheduleExpression: &admin.Schedule_CronSchedule{
+				CronSchedule: &admin.CronSchedule{
+					Schedule: "CRON_TZ=America/New_York 0 9 * * 1-5",
+				},
+			},
+			KickoffTimeInputArg: "kickoff_time",
+		}
+
+		scheduleEntitiesRepo := db.SchedulableEntityRepo().(*schedMocks.SchedulableEntityRepoInterface)
+		scheduleEntitiesRepo.OnActivateMatch(mock.Anything, mock.MatchedBy(func(s models.SchedulableEntity) bool {
+			return s.CronExpression == "0 9 * * 1-5" && s.Timezone == "America/New_York" &&
+				s.CatchupPolicy == "latest-only"
+		})).Return(nil)
+
+		err := eventScheduler.AddSchedule(context.Background(), interfaces.AddScheduleInput{
+			Identifier: core.Identifier{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "scheduled_wroflow",
+				Version: "v1",
+			},
+			ScheduleExpression: schedule,
+			CatchupPolicy:      "latest-only",
+		})
+		assert.Nil(t, err)
+	})
+
+	t.Run("cron_schedule_unknown_timezone", func(t *testing.T) {
+		eventScheduler := setupEventScheduler()
+		schedule := admin.Schedule{
+			ScheduleExpression: &admin.Schedule_CronSchedule{
+				CronSchedule: &admin.CronSchedule{
+					Schedule: "CRON_TZ=Mars/Olympus_Mons 0 9 * * 1-5",
+				},
+			},
+			KickoffTimeInputArg: "kickoff_time",
+		}
+
+		scheduleEntitiesRepo := db.SchedulableEntityRepo().(*schedMocks.SchedulableEntityRepoInterface)
+		scheduleEntitiesRepo.OnActivateMatch(mock.Anything, mock.Anything).Return(nil)
+
+		err := eventScheduler.AddSchedule(context.Background(), interfaces.AddScheduleInput{
+			Identifier: core.Identifier{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "scheduled_wroflow",
+				Version: "v1",
+			},
+			ScheduleExpression: schedule,
+		})
+		assert.NotNil(t, err)
+		scheduleEntitiesRepo.AssertNotCalled(t, "Activate", mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid_catchup_policy", func(t *testing.T) {
+		eventScheduler := setupEventScheduler()
+		schedule := admin.Schedule{
+			ScheduleExpression: &admin.Schedule_CronSchedule{
+				CronSchedule: &admin.CronSchedule{
+					Schedule: "0 * * * *",
+				},
+			},
+			KickoffTimeInputArg: "kickoff_time",
+		}
+
+		scheduleEntitiesRepo := db.SchedulableEntityRepo().(*schedMocks.SchedulableEntityRepoInterface)
+		scheduleEntitiesRepo.OnActivateMatch(mock.Anything, mock.Anything).Return(nil)
+
+		err := eventScheduler.AddSchedule(context.Background(), interfaces.AddScheduleInput{
+			Identifier: core.Identifier{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "scheduled_wroflow",
+				Version: "v1",
+			},
+			ScheduleExpression: schedule,
+			CatchupPolicy:      "max-0",
+		})
+		assert.NotNil(t, err)
+		scheduleEntitiesRepo.AssertNotCalled(t, "Activate", mock.Anything, mock.Anything)
+	})
+
 	t.Run("cron_expression_unsupported", func(t *testing.T) {
 		eventScheduler := setupEventScheduler()
 		schedule := admin.Schedule{
@@ -139,3 +227,46 @@ func TestAddSchedule(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 }
+
+func TestScheduleUpdateNotifications(t *testing.T) {
+	db = mocks.NewMockRepository()
+	eventScheduler := New(db, runtimeInterfaces.ScheduleUpdatesConfig{
+		Notifier: runtimeInterfaces.ScheduleUpdatesNotifierPostgres,
+	})
+	identifier := core.Identifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "scheduled_wroflow",
+		Version: "v1",
+	}
+	key := models.SchedulableEntityKey{Project: "project", Domain: "domain", Name: "scheduled_wroflow", Version: "v1"}
+	scheduleEntitiesRepo := db.SchedulableEntityRepo().(*schedMocks.SchedulableEntityRepoInterface)
+	scheduleEntitiesRepo.OnActivateMatch(mock.Anything, mock.Anything).Return(nil)
+	scheduleEntitiesRepo.OnDeactivateMatch(mock.Anything, mock.Anything).Return(nil)
+	scheduleUpdateRepo := db.ScheduleUpdateRepo().(*schedMocks.ScheduleUpdateRepoInterface)
+	// Failing notifications don't fail the update, the scheduler still reads it when it polls the schedules.
+	scheduleUpdateRepo.OnNotifyMatch(mock.Anything, key).Return(errors.New("notify failed"))
+
+	err := eventScheduler.AddSchedule(context.Background(), interfaces.AddScheduleInput{
+		Identifier: identifier,
+		ScheduleExpression: admin.Schedule{
+			ScheduleExpression: &admin.Schedule_CronSchedule{
+				CronSchedule: &admin.CronSchedule{Schedule: "0 * * * *"},
+			},
+		},
+	})
+	assert.Nil(t, err)
+	err = eventScheduler.RemoveSchedule(context.Background(), interfaces.RemoveScheduleInput{Identifier: identifier})
+	assert.Nil(t, err)
+	scheduleUpdateRepo.AssertNumberOfCalls(t, "Notify", 2)
+
+	// Without a notifier the scheduler is left to poll the schedules.
+	db = mocks.NewMockRepository()
+	eventScheduler = New(db, runtimeInterfaces.ScheduleUpdatesConfig{})
+	db.SchedulableEntityRepo().(*schedMocks.SchedulableEntityRepoInterface).OnDeactivateMatch(
+		mock.Anything, mock.Anything).Return(nil)
+	err = eventScheduler.RemoveSchedule(context.Background(), interfaces.RemoveScheduleInput{Identifier: identifier})
+	assert.Nil(t, err)
+	db.ScheduleUpdateRepo().(*schedMocks.ScheduleUpdateRepoInterface).AssertNotCalled(t, "Notify",
+		mock.Anything, mock.Anything)
+}
@@ -36,6 +36,8 @@
 // 			does not expose the last considered time, we just calculate our own watermark per schedule.
 // 		b) CatchupAll-System :
 //			This component runs at bootup and catches up all the schedules to there current time.Now()
+//			Which of the missed times are fired is decided by the catch-up policy of the schedule (all, latest-only,
+//			none or max-N) and the configured lookback window.
 //			The scheduler is not run until all the schedules have been caught up.
 //			The current design is also not to snapshot until all the schedules are caught up.
 //			This might be drawback in case catch up runs for a long time and hasn't been snapshotted.(reassess)
@@ -1,5 +1,6 @@
 // Package executor
 // This package provides an interface to talk to admin for scheduled executions.
 // The implementation constructs a request using the schedule details and the passed in schedule time to be sent to admin
-// for execution
+// for execution. Every time a schedule fires is recorded in the schedule_runs table along with its outcome, which admin
+// serves under /api/v1/schedule_runs.
 package executor
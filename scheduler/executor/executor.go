@@ -11,4 +11,7 @@ import (
 type Executor interface {
 	// Execute sends a scheduled execution request to admin
 	Execute(ctx context.Context, scheduledTime time.Time, s models.SchedulableEntity) error
+	// ExecuteForObject sends an execution request to admin for a new object under the prefix of a storage trigger,
+	// binding the object to the input of the trigger
+	ExecuteForObject(ctx context.Context, trigger models.StorageTrigger, objectURI string, lastModified time.Time) error
 }
@@ -2,10 +2,13 @@ package executor
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"time"
 
+	"github.com/flyteorg/flyteadmin/scheduler/calendar"
 	"github.com/flyteorg/flyteadmin/scheduler/identifier"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/interfaces"
 	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
@@ -24,6 +27,8 @@ import (
 // executor allows to call the admin with scheduled execution
 type executor struct {
 	adminServiceClient service.AdminServiceClient
+	scheduleRunRepo    interfaces.ScheduleRunRepoInterface
+	calendars          calendar.Calendars
 	metrics            executorMetrics
 }
 
@@ -31,9 +36,29 @@ type executorMetrics struct {
 	Scope                      promutils.Scope
 	FailedExecutionCounter     prometheus.Counter
 	SuccessfulExecutionCounter prometheus.Counter
+	FailedRunRecordCounter     prometheus.Counter
+	ExcludedExecutionCounter   prometheus.Counter
+}
+
+// recordRun stores the outcome of firing the schedule for the scheduled time. The run history is best effort and
+// failing to record it doesn't fail the execution.
+func (w *executor) recordRun(ctx context.Context, run models.ScheduleRun) {
+	if err := w.scheduleRunRepo.Create(ctx, run); err != nil {
+		w.metrics.FailedRunRecordCounter.Inc()
+		logger.Errorf(ctx, "failed to record the %v run of schedule %+v for time %v due to %v", run.Outcome,
+			run.Project+"/"+run.Domain+"/"+run.Name+"/"+run.Version, run.ScheduledTime, err)
+	}
 }
 
 func (w *executor) Execute(ctx context.Context, scheduledTime time.Time, s models.SchedulableEntity) error {
+	run := models.ScheduleRun{
+		Project:       s.Project,
+		Domain:        s.Domain,
+		Name:          s.Name,
+		Version:       s.Version,
+		ScheduledTime: scheduledTime,
+		FiredAt:       time.Now(),
+	}
 
 	literalsInputMap := map[string]*core.Literal{}
 	// Only add kickoff time input arg for cron based schedules
@@ -63,6 +88,9 @@ func (w *executor) Execute(ctx context.Context, scheduledTime time.Time, s model
 
 	if err != nil {
 		logger.Error(ctx, "failed to generate execution identifier for schedule %+v due to %v", s, err)
+		run.Outcome = models.ScheduleRunOutcomeFailed
+		run.Error = err.Error()
+		w.recordRun(ctx, run)
 		return err
 	}
 
@@ -92,18 +120,131 @@ func (w *executor) Execute(ctx context.Context, scheduledTime time.Time, s model
 	if !*s.Active {
 		// no longer active
 		logger.Debugf(ctx, "schedule %+v is no longer active", s)
+		run.Outcome = models.ScheduleRunOutcomeSkippedInactive
+		w.recordRun(ctx, run)
 		return nil
 	}
+	if calendarName := w.calendars.GetExcludingCalendar(s.ExclusionCalendars, scheduledTime); len(calendarName) > 0 {
+		logger.Infof(ctx, "skipping schedule %+v for time %v excluded by calendar %v", s, scheduledTime, calendarName)
+		w.metrics.ExcludedExecutionCounter.Inc()
+		run.Outcome = models.ScheduleRunOutcomeSkippedExcluded
+		run.Error = fmt.Sprintf("excluded by calendar [%s]", calendarName)
+		w.recordRun(ctx, run)
+		return nil
+	}
+	if err = w.createExecution(ctx, executionRequest, &run); err != nil {
+		return err
+	}
+	logger.Infof(ctx, "successfully fired the request for schedule %+v for time %v", s, scheduledTime)
+	return nil
+}
+
+func (w *executor) ExecuteForObject(ctx context.Context, trigger models.StorageTrigger, objectURI string,
+	lastModified time.Time) error {
+	// The run of an object is recorded against the time the object was last modified.
+	run := models.ScheduleRun{
+		Project:       trigger.Project,
+		Domain:        trigger.Domain,
+		Name:          trigger.Name,
+		Version:       trigger.Version,
+		ScheduledTime: lastModified,
+		FiredAt:       time.Now(),
+	}
+
+	literal := &core.Literal{
+		Value: &core.Literal_Scalar{
+			Scalar: &core.Scalar{
+				Value: &core.Scalar_Primitive{
+					Primitive: &core.Primitive{
+						Value: &core.Primitive_StringValue{
+							StringValue: objectURI,
+						},
+					},
+				},
+			},
+		},
+	}
+	if trigger.InputType == models.StorageTriggerInputTypeBlob {
+		literal = &core.Literal{
+			Value: &core.Literal_Scalar{
+				Scalar: &core.Scalar{
+					Value: &core.Scalar_Blob{
+						Blob: &core.Blob{
+							Metadata: &core.BlobMetadata{
+								Type: &core.BlobType{
+									Format:         trigger.BlobFormat,
+									Dimensionality: core.BlobType_SINGLE,
+								},
+							},
+							Uri: objectURI,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	// Making the identifier deterministic using the hash of the launch plan name and the object
+	executionIdentifier, err := identifier.GetStorageObjectExecutionIdentifier(ctx, core.Identifier{
+		Project: trigger.Project,
+		Domain:  trigger.Domain,
+		Name:    trigger.Name,
+	}, objectURI, lastModified)
+	if err != nil {
+		logger.Error(ctx, "failed to generate execution identifier for object %v of storage trigger %+v due to %v",
+			objectURI, trigger, err)
+		run.Outcome = models.ScheduleRunOutcomeFailed
+		run.Error = err.Error()
+		w.recordRun(ctx, run)
+		return err
+	}
+
+	executionRequest := &admin.ExecutionCreateRequest{
+		Project: trigger.Project,
+		Domain:  trigger.Domain,
+		Name:    "s" + strings.ReplaceAll(executionIdentifier.String(), "-", "")[:19],
+		Spec: &admin.ExecutionSpec{
+			LaunchPlan: &core.Identifier{
+				ResourceType: core.ResourceType_LAUNCH_PLAN,
+				Project:      trigger.Project,
+				Domain:       trigger.Domain,
+				Name:         trigger.Name,
+				Version:      trigger.Version,
+			},
+			Metadata: &admin.ExecutionMetadata{
+				Mode:        admin.ExecutionMetadata_SCHEDULED,
+				ScheduledAt: timestamppb.New(lastModified),
+			},
+		},
+		// Only the object is bound, the launch plan provides all the other inputs.
+		Inputs: &core.LiteralMap{
+			Literals: map[string]*core.Literal{
+				trigger.InputName: literal,
+			},
+		},
+	}
+	if err = w.createExecution(ctx, executionRequest, &run); err != nil {
+		return err
+	}
+	logger.Infof(ctx, "successfully fired the request for object %v of storage trigger %+v", objectURI, trigger)
+	return nil
+}
+
+// createExecution creates the execution on admin, retrying failures, and records the run. Executions are named
+// deterministically so an execution which already exists was created by an earlier attempt and counts as a success.
+func (w *executor) createExecution(ctx context.Context, executionRequest *admin.ExecutionCreateRequest,
+	run *models.ScheduleRun) error {
+	run.ExecutionName = executionRequest.Name
 
 	// Do maximum of 30 retries on failures with constant backoff factor
 	opts := wait.Backoff{Duration: 3000, Factor: 2.0, Steps: 30}
-	err = retry.OnError(opts,
+	err := retry.OnError(opts,
 		func(err error) bool {
 			// For idempotent behavior ignore the AlreadyExists error which happens if we try to schedule a launchplan
 			// for execution at the same time which is already available in admin.
 			// This is possible since idempotency guarantees are using the schedule time and the identifier
 			if grpcError := status.Code(err); grpcError == codes.AlreadyExists {
-				logger.Debugf(ctx, "duplicate schedule %+v already exists for schedule", s)
+				logger.Debugf(ctx, "duplicate execution %v already exists", executionRequest.Name)
 				return false
 			}
 			w.metrics.FailedExecutionCounter.Inc()
@@ -113,24 +254,35 @@ func (w *executor) Execute(ctx context.Context, scheduledTime time.Time, s model
 			return true
 		},
 		func() error {
+			run.Attempts++
 			_, execErr := w.adminServiceClient.CreateExecution(context.Background(), executionRequest)
 			return execErr
 		},
 	)
 	if err != nil && status.Code(err) != codes.AlreadyExists {
 		logger.Error(ctx, "failed to create execution create request %+v due to %v after all retries", executionRequest, err)
+		run.Outcome = models.ScheduleRunOutcomeFailed
+		run.Error = err.Error()
+		w.recordRun(ctx, *run)
 		return err
 	}
+	run.Outcome = models.ScheduleRunOutcomeFired
+	if err != nil {
+		run.Outcome = models.ScheduleRunOutcomeAlreadyExists
+	}
+	w.recordRun(ctx, *run)
 	w.metrics.SuccessfulExecutionCounter.Inc()
-	logger.Infof(ctx, "successfully fired the request for schedule %+v for time %v", s, scheduledTime)
 	return nil
 }
 
 func New(scope promutils.Scope,
-	adminServiceClient service.AdminServiceClient) Executor {
+	adminServiceClient service.AdminServiceClient, scheduleRunRepo interfaces.ScheduleRunRepoInterface,
+	calendars calendar.Calendars) Executor {
 
 	return &executor{
 		adminServiceClient: adminServiceClient,
+		scheduleRunRepo:    scheduleRunRepo,
+		calendars:          calendars,
 		metrics:            getExecutorMetrics(scope),
 	}
 }
@@ -142,5 +294,9 @@ func getExecutorMetrics(scope promutils.Scope) executorMetrics {
 			"count of unsuccessful attempts to fire execution for a schedules"),
 		SuccessfulExecutionCounter: scope.MustNewCounter("successful_execution_counter",
 			"count of successful attempts to fire execution for a schedules"),
+		FailedRunRecordCounter: scope.MustNewCounter("failed_run_record_counter",
+			"count of schedule runs which failed to be recorded in the run history"),
+		ExcludedExecutionCounter: scope.MustNewCounter("excluded_execution_counter",
+			"count of schedule times skipped because a calendar of the schedule excludes them"),
 	}
 }
@@ -6,6 +6,9 @@ import (
 	"time"
 
 	"github.com/flyteorg/flyteadmin/pkg/errors"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteadmin/scheduler/calendar"
+	schedMocks "github.com/flyteorg/flyteadmin/scheduler/repositories/mocks"
 	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
 	adminMocks "github.com/flyteorg/flyteidl/clients/go/admin/mocks"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
@@ -18,11 +21,18 @@ import (
 
 var (
 	mockAdminClient *adminMocks.AdminServiceClient
+	mockRunRepo     *schedMocks.ScheduleRunRepoInterface
+	recordedRuns    []models.ScheduleRun
 )
 
 func setupExecutor(scope string) Executor {
 	mockAdminClient = new(adminMocks.AdminServiceClient)
-	return New(promutils.NewScope(scope), mockAdminClient)
+	mockRunRepo = new(schedMocks.ScheduleRunRepoInterface)
+	recordedRuns = nil
+	mockRunRepo.OnCreateMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		recordedRuns = append(recordedRuns, args.Get(1).(models.ScheduleRun))
+	}).Return(nil)
+	return New(promutils.NewScope(scope), mockAdminClient, mockRunRepo, nil)
 }
 
 func TestExecutor(t *testing.T) {
@@ -40,8 +50,20 @@ func TestExecutor(t *testing.T) {
 		Active:              &active,
 	}
 	mockAdminClient.OnCreateExecutionMatch(context.Background(), mock.Anything).Return(&admin.ExecutionCreateResponse{}, nil)
-	err := executor.Execute(context.Background(), time.Now(), schedule)
+	scheduledTime := time.Now()
+	err := executor.Execute(context.Background(), scheduledTime, schedule)
 	assert.Nil(t, err)
+	assert.Len(t, recordedRuns, 1)
+	assert.Equal(t, schedule.SchedulableEntityKey, models.SchedulableEntityKey{
+		Project: recordedRuns[0].Project,
+		Domain:  recordedRuns[0].Domain,
+		Name:    recordedRuns[0].Name,
+		Version: recordedRuns[0].Version,
+	})
+	assert.Equal(t, scheduledTime, recordedRuns[0].ScheduledTime)
+	assert.Equal(t, models.ScheduleRunOutcomeFired, recordedRuns[0].Outcome)
+	assert.Equal(t, uint32(1), recordedRuns[0].Attempts)
+	assert.Len(t, recordedRuns[0].ExecutionName, 20)
 }
 
 func TestExecutorAlreadyExists(t *testing.T) {
@@ -62,6 +84,8 @@ func TestExecutorAlreadyExists(t *testing.T) {
 		errors.NewFlyteAdminErrorf(codes.AlreadyExists, "Already exists"))
 	err := executor.Execute(context.Background(), time.Now(), schedule)
 	assert.Nil(t, err)
+	assert.Len(t, recordedRuns, 1)
+	assert.Equal(t, models.ScheduleRunOutcomeAlreadyExists, recordedRuns[0].Outcome)
 }
 
 func TestExecutorInactiveSchedule(t *testing.T) {
@@ -81,4 +105,116 @@ func TestExecutorInactiveSchedule(t *testing.T) {
 	mockAdminClient.OnCreateExecutionMatch(context.Background(), mock.Anything).Return(&admin.ExecutionCreateResponse{}, nil)
 	err := executor.Execute(context.Background(), time.Now(), schedule)
 	assert.Nil(t, err)
+	assert.Len(t, recordedRuns, 1)
+	assert.Equal(t, models.ScheduleRunOutcomeSkippedInactive, recordedRuns[0].Outcome)
+	assert.Empty(t, recordedRuns[0].ExecutionName)
+	mockAdminClient.AssertNotCalled(t, "CreateExecution", mock.Anything, mock.Anything)
+}
+
+func TestExecutorExcludedSchedule(t *testing.T) {
+	calendars, err := calendar.New(map[string]runtimeInterfaces.ScheduleCalendarConfig{
+		"holidays": {Dates: []string{"2021-12-24"}},
+	})
+	assert.Nil(t, err)
+	mockAdminClient = new(adminMocks.AdminServiceClient)
+	mockRunRepo = new(schedMocks.ScheduleRunRepoInterface)
+	recordedRuns = nil
+	mockRunRepo.OnCreateMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		recordedRuns = append(recordedRuns, args.Get(1).(models.ScheduleRun))
+	}).Return(nil)
+	executor := New(promutils.NewScope("testExecutor6"), mockAdminClient, mockRunRepo, calendars)
+	active := true
+	schedule := models.SchedulableEntity{
+		SchedulableEntityKey: models.SchedulableEntityKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "cron_schedule",
+			Version: "v1",
+		},
+		CronExpression:      "0 * * * *",
+		KickoffTimeInputArg: "kickoff_time",
+		ExclusionCalendars:  "weekends, holidays",
+		Active:              &active,
+	}
+	mockAdminClient.OnCreateExecutionMatch(context.Background(), mock.Anything).Return(&admin.ExecutionCreateResponse{}, nil)
+	err = executor.Execute(context.Background(), time.Date(2021, 12, 24, 10, 0, 0, 0, time.UTC), schedule)
+	assert.Nil(t, err)
+	assert.Len(t, recordedRuns, 1)
+	assert.Equal(t, models.ScheduleRunOutcomeSkippedExcluded, recordedRuns[0].Outcome)
+	assert.Contains(t, recordedRuns[0].Error, "holidays")
+	mockAdminClient.AssertNotCalled(t, "CreateExecution", mock.Anything, mock.Anything)
+
+	// The next day isn't excluded, the unknown weekends calendar is ignored.
+	err = executor.Execute(context.Background(), time.Date(2021, 12, 25, 10, 0, 0, 0, time.UTC), schedule)
+	assert.Nil(t, err)
+	assert.Len(t, recordedRuns, 2)
+	assert.Equal(t, models.ScheduleRunOutcomeFired, recordedRuns[1].Outcome)
+}
+
+func TestExecutorRunRecordFailure(t *testing.T) {
+	mockAdminClient = new(adminMocks.AdminServiceClient)
+	mockRunRepo = new(schedMocks.ScheduleRunRepoInterface)
+	mockRunRepo.OnCreateMatch(mock.Anything, mock.Anything).Return(
+		errors.NewFlyteAdminErrorf(codes.Internal, "db is down"))
+	executor := New(promutils.NewScope("testExecutor4"), mockAdminClient, mockRunRepo, nil)
+	active := true
+	schedule := models.SchedulableEntity{
+		SchedulableEntityKey: models.SchedulableEntityKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "cron_schedule",
+			Version: "v1",
+		},
+		CronExpression:      "*/1 * * * *",
+		KickoffTimeInputArg: "kickoff_time",
+		Active:              &active,
+	}
+	mockAdminClient.OnCreateExecutionMatch(context.Background(), mock.Anything).Return(&admin.ExecutionCreateResponse{}, nil)
+	// The run history is best effort and doesn't fail the execution.
+	err := executor.Execute(context.Background(), time.Now(), schedule)
+	assert.Nil(t, err)
+	mockRunRepo.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func TestExecutorExecuteForObject(t *testing.T) {
+	executor := setupExecutor("testExecutor5")
+	active := true
+	trigger := models.StorageTrigger{
+		Project:    "project",
+		Domain:     "domain",
+		Name:       "lp",
+		Version:    "v1",
+		Prefix:     "s3://bucket/landing/",
+		Pattern:    "*",
+		InputName:  "file",
+		InputType:  models.StorageTriggerInputTypeBlob,
+		BlobFormat: "csv",
+		Active:     &active,
+	}
+	var requests []*admin.ExecutionCreateRequest
+	mockAdminClient.OnCreateExecutionMatch(context.Background(), mock.Anything).Run(func(args mock.Arguments) {
+		requests = append(requests, args.Get(1).(*admin.ExecutionCreateRequest))
+	}).Return(&admin.ExecutionCreateResponse{}, nil)
+	lastModified := time.Now()
+	err := executor.ExecuteForObject(context.Background(), trigger, "s3://bucket/landing/a.csv", lastModified)
+	assert.Nil(t, err)
+	assert.Len(t, requests, 1)
+	blob := requests[0].Inputs.Literals["file"].GetScalar().GetBlob()
+	assert.Equal(t, "s3://bucket/landing/a.csv", blob.Uri)
+	assert.Equal(t, "csv", blob.Metadata.Type.Format)
+	assert.Equal(t, "v1", requests[0].Spec.LaunchPlan.Version)
+	assert.Len(t, recordedRuns, 1)
+	assert.Equal(t, lastModified, recordedRuns[0].ScheduledTime)
+	assert.Equal(t, requests[0].Name, recordedRuns[0].ExecutionName)
+
+	// The execution is named after the launch plan and object so launching for the object again is idempotent, also
+	// for another version of the launch plan.
+	trigger.Version = "v2"
+	trigger.InputType = models.StorageTriggerInputTypeString
+	trigger.InputName = "path"
+	err = executor.ExecuteForObject(context.Background(), trigger, "s3://bucket/landing/a.csv", lastModified)
+	assert.Nil(t, err)
+	assert.Equal(t, requests[0].Name, requests[1].Name)
+	assert.Equal(t, "s3://bucket/landing/a.csv",
+		requests[1].Inputs.Literals["path"].GetScalar().GetPrimitive().GetStringValue())
 }
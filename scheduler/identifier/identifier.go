@@ -18,8 +18,9 @@ import (
 // Utility functions used by the flyte native scheduler
 
 const (
-	scheduleNameInputsFormat = "%s:%s:%s:%s"
-	executionIDInputsFormat  = scheduleNameInputsFormat + ":%d"
+	scheduleNameInputsFormat  = "%s:%s:%s:%s"
+	executionIDInputsFormat   = scheduleNameInputsFormat + ":%d"
+	storageObjectInputsFormat = "%s:%s:%s:%s:%d"
 )
 
 // GetScheduleName generate the schedule name to be used as unique identification string within the scheduler
@@ -40,6 +41,17 @@ func GetExecutionIdentifier(ctx context.Context, identifier core.Identifier, sch
 	return uuid.FromBytes(b)
 }
 
+// GetStorageObjectExecutionIdentifier returns UUID using the hashed value of the launch plan name and the storage object.
+// The version is left out so that activating another version of the launch plan doesn't launch for an object again,
+// while an object which is overwritten is launched for once more.
+func GetStorageObjectExecutionIdentifier(ctx context.Context, identifier core.Identifier, objectURI string,
+	lastModified time.Time) (uuid.UUID, error) {
+	hashValue := hashStorageObject(ctx, identifier, objectURI, lastModified)
+	b := make([]byte, 16)
+	binary.LittleEndian.PutUint64(b, hashValue)
+	return uuid.FromBytes(b)
+}
+
 // hashIdentifier returns the hash of the identifier
 func hashIdentifier(ctx context.Context, identifier core.Identifier) uint64 {
 	h := fnv.New64()
@@ -69,3 +81,18 @@ func hashScheduledTimeStamp(ctx context.Context, identifier core.Identifier, sch
 	logger.Debugf(ctx, "Returning hash for [%+v] %v: %d", identifier, scheduledTime, h.Sum64())
 	return h.Sum64()
 }
+
+// hashStorageObject return the hash of the launch plan name and the storage object
+func hashStorageObject(ctx context.Context, identifier core.Identifier, objectURI string, lastModified time.Time) uint64 {
+	h := fnv.New64()
+	_, err := h.Write([]byte(fmt.Sprintf(storageObjectInputsFormat,
+		identifier.Project, identifier.Domain, identifier.Name, objectURI, lastModified.UnixNano())))
+	if err != nil {
+		// This shouldn't occur.
+		logger.Errorf(ctx,
+			"failed to hash launch plan identifier: %+v with object %v to get execution identifier with err: %v", identifier, objectURI, err)
+		return 0
+	}
+	logger.Debugf(ctx, "Returning hash for [%+v] %v: %d", identifier, objectURI, h.Sum64())
+	return h.Sum64()
+}
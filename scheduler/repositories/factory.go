@@ -27,6 +27,12 @@ var RepositoryConfigurationName = map[int32]string{
 type SchedulerRepoInterface interface {
 	SchedulableEntityRepo() interfaces.SchedulableEntityRepoInterface
 	ScheduleEntitiesSnapshotRepo() interfaces.ScheduleEntitiesSnapShotRepoInterface
+	SchedulerLeaseRepo() interfaces.SchedulerLeaseRepoInterface
+	ScheduleBackfillRepo() interfaces.ScheduleBackfillRepoInterface
+	ScheduleRunRepo() interfaces.ScheduleRunRepoInterface
+	ScheduleLastFireRepo() interfaces.ScheduleLastFireRepoInterface
+	StorageTriggerRepo() interfaces.StorageTriggerRepoInterface
+	ScheduleUpdateRepo() interfaces.ScheduleUpdateRepoInterface
 }
 
 func GetRepository(repoType RepoConfig, dbConfig config.DbConfig, scope promutils.Scope) SchedulerRepoInterface {
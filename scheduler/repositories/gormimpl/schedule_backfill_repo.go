@@ -0,0 +1,102 @@
+package gormimpl
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	interfaces2 "github.com/flyteorg/flyteadmin/scheduler/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+
+	"github.com/jinzhu/gorm"
+)
+
+const scheduleBackfillEntity = "schedule backfill"
+
+// ScheduleBackfillRepo Implementation of ScheduleBackfillRepoInterface.
+type ScheduleBackfillRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *ScheduleBackfillRepo) Create(ctx context.Context, input models.ScheduleBackfill) (
+	models.ScheduleBackfill, error) {
+	timer := r.metrics.CreateDuration.Start()
+	tx := r.db.Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return models.ScheduleBackfill{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return input, nil
+}
+
+func (r *ScheduleBackfillRepo) Get(ctx context.Context, id uint) (models.ScheduleBackfill, error) {
+	var backfill models.ScheduleBackfill
+	timer := r.metrics.GetDuration.Start()
+	tx := r.db.Where("id = ?", id).Take(&backfill)
+	timer.Stop()
+	if tx.Error != nil {
+		if tx.RecordNotFound() {
+			return models.ScheduleBackfill{}, errors.GetMissingEntityByIDError(scheduleBackfillEntity)
+		}
+		return models.ScheduleBackfill{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return backfill, nil
+}
+
+func (r *ScheduleBackfillRepo) List(ctx context.Context, input interfaces2.ListScheduleBackfillsInput) (
+	[]models.ScheduleBackfill, error) {
+	var backfills []models.ScheduleBackfill
+	tx := r.db.Where(&models.ScheduleBackfill{
+		Project: input.Project,
+		Domain:  input.Domain,
+		Name:    input.Name,
+	})
+	if len(input.Phases) > 0 {
+		tx = tx.Where("phase IN (?)", input.Phases)
+	}
+	if input.Limit > 0 {
+		tx = tx.Limit(input.Limit)
+	}
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Offset(input.Offset).Order("id").Find(&backfills)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return backfills, nil
+}
+
+func (r *ScheduleBackfillRepo) Update(ctx context.Context, input models.ScheduleBackfill) (bool, error) {
+	lastFiredTime := gorm.Expr("GREATEST(last_fired_time, ?)", input.LastFiredTime)
+	if input.Order == models.ScheduleBackfillOrderDescending {
+		lastFiredTime = gorm.Expr("LEAST(last_fired_time, ?)", input.LastFiredTime)
+	}
+	timer := r.metrics.UpdateDuration.Start()
+	tx := r.db.Model(&models.ScheduleBackfill{}).Where("id = ? AND phase IN (?)", input.ID, []string{
+		models.ScheduleBackfillPhasePending, models.ScheduleBackfillPhaseRunning,
+	}).Updates(map[string]interface{}{
+		"phase": input.Phase,
+		// Progress is only ever moved forward, e.g. cancelling a backfill with a stale copy keeps its progress.
+		"completed_executions": gorm.Expr("GREATEST(completed_executions, ?)", input.CompletedExecutions),
+		"last_fired_time":      lastFiredTime,
+		"error":                input.Error,
+	})
+	timer.Stop()
+	if tx.Error != nil {
+		return false, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return tx.RowsAffected > 0, nil
+}
+
+// NewScheduleBackfillRepo Returns an instance of ScheduleBackfillRepoInterface
+func NewScheduleBackfillRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces2.ScheduleBackfillRepoInterface {
+	metrics := newMetrics(scope)
+	return &ScheduleBackfillRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
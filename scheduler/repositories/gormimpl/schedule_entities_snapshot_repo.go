@@ -45,6 +45,16 @@ func (r *ScheduleEntitiesSnapshotRepo) Read(ctx context.Context) (models.Schedul
 	return schedulableEntitiesSnapshot, nil
 }
 
+func (r *ScheduleEntitiesSnapshotRepo) DeleteAll(ctx context.Context) error {
+	timer := r.metrics.DeleteDuration.Start()
+	tx := r.db.Delete(&models.ScheduleEntitiesSnapshot{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
 // NewScheduleEntitiesSnapshotRepo Returns an instance of ScheduleEntitiesSnapshotRepoInterface
 func NewScheduleEntitiesSnapshotRepo(
 	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces2.ScheduleEntitiesSnapShotRepoInterface {
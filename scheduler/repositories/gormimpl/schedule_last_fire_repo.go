@@ -0,0 +1,75 @@
+package gormimpl
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	interfaces2 "github.com/flyteorg/flyteadmin/scheduler/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+
+	"github.com/jinzhu/gorm"
+)
+
+// Fires which are recorded out of order, e.g. a catch up racing the cron scheduler, leave the later time in place.
+const upsertLastFireQuery = `INSERT INTO schedule_last_fire (schedule_key, project, domain, name, version,
+last_fire_time, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (schedule_key) DO UPDATE SET project = EXCLUDED.project, domain = EXCLUDED.domain,
+name = EXCLUDED.name, version = EXCLUDED.version, last_fire_time = EXCLUDED.last_fire_time,
+updated_at = EXCLUDED.updated_at
+WHERE schedule_last_fire.last_fire_time < EXCLUDED.last_fire_time`
+
+// ScheduleLastFireRepo Implementation of ScheduleLastFireRepoInterface.
+type ScheduleLastFireRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *ScheduleLastFireRepo) Upsert(ctx context.Context, input models.ScheduleLastFire) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := r.db.Exec(upsertLastFireQuery, input.ScheduleKey, input.Project, input.Domain, input.Name, input.Version,
+		input.LastFireTime, input.UpdatedAt)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *ScheduleLastFireRepo) GetAll(ctx context.Context) ([]models.ScheduleLastFire, error) {
+	var lastFires []models.ScheduleLastFire
+	timer := r.metrics.ListDuration.Start()
+	tx := r.db.Find(&lastFires)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return lastFires, nil
+}
+
+func (r *ScheduleLastFireRepo) Prune(ctx context.Context, keepScheduleKeys []string) error {
+	tx := r.db
+	if len(keepScheduleKeys) > 0 {
+		tx = tx.Where("schedule_key NOT IN (?)", keepScheduleKeys)
+	}
+	timer := r.metrics.DeleteDuration.Start()
+	tx = tx.Delete(&models.ScheduleLastFire{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+// NewScheduleLastFireRepo Returns an instance of ScheduleLastFireRepoInterface
+func NewScheduleLastFireRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces2.ScheduleLastFireRepoInterface {
+	metrics := newMetrics(scope)
+	return &ScheduleLastFireRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
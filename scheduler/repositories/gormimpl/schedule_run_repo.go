@@ -0,0 +1,64 @@
+package gormimpl
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	interfaces2 "github.com/flyteorg/flyteadmin/scheduler/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+
+	"github.com/jinzhu/gorm"
+)
+
+// ScheduleRunRepo Implementation of ScheduleRunRepoInterface.
+type ScheduleRunRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *ScheduleRunRepo) Create(ctx context.Context, input models.ScheduleRun) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := r.db.Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *ScheduleRunRepo) List(ctx context.Context, input interfaces2.ListScheduleRunsInput) (
+	[]models.ScheduleRun, error) {
+	var runs []models.ScheduleRun
+	tx := r.db.Where(&models.ScheduleRun{
+		Project: input.Project,
+		Domain:  input.Domain,
+		Name:    input.Name,
+		Version: input.Version,
+	})
+	if len(input.Outcomes) > 0 {
+		tx = tx.Where("outcome IN (?)", input.Outcomes)
+	}
+	if input.Limit > 0 {
+		tx = tx.Limit(input.Limit)
+	}
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Offset(input.Offset).Order("scheduled_time DESC, id DESC").Find(&runs)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return runs, nil
+}
+
+// NewScheduleRunRepo Returns an instance of ScheduleRunRepoInterface
+func NewScheduleRunRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces2.ScheduleRunRepoInterface {
+	metrics := newMetrics(scope)
+	return &ScheduleRunRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
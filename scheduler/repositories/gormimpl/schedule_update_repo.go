@@ -0,0 +1,46 @@
+package gormimpl
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	interfaces2 "github.com/flyteorg/flyteadmin/scheduler/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+
+	"github.com/jinzhu/gorm"
+)
+
+const notifyScheduleUpdateQuery = "SELECT pg_notify(?, ?)"
+
+// ScheduleUpdateRepo Implementation of ScheduleUpdateRepoInterface using Postgres notifications.
+type ScheduleUpdateRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *ScheduleUpdateRepo) Notify(ctx context.Context, ID models.SchedulableEntityKey) error {
+	payload, err := models.EncodeScheduleUpdate(ID)
+	if err != nil {
+		return err
+	}
+	timer := r.metrics.CreateDuration.Start()
+	tx := r.db.Exec(notifyScheduleUpdateQuery, models.ScheduleUpdatesChannel, payload)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+// NewScheduleUpdateRepo Returns an instance of ScheduleUpdateRepoInterface
+func NewScheduleUpdateRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces2.ScheduleUpdateRepoInterface {
+	metrics := newMetrics(scope)
+	return &ScheduleUpdateRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
@@ -0,0 +1,59 @@
+package gormimpl
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	interfaces2 "github.com/flyteorg/flyteadmin/scheduler/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+
+	"github.com/jinzhu/gorm"
+)
+
+// The lease row is only overwritten when it's already held by the same holder or when it has expired, which makes
+// acquiring the lease a single atomic statement.
+const acquireLeaseQuery = `INSERT INTO scheduler_leases (name, holder_id, expires_at, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT (name) DO UPDATE SET holder_id = EXCLUDED.holder_id, expires_at = EXCLUDED.expires_at,
+updated_at = EXCLUDED.updated_at
+WHERE scheduler_leases.holder_id = EXCLUDED.holder_id OR scheduler_leases.expires_at < ?`
+
+// SchedulerLeaseRepo Implementation of SchedulerLeaseRepoInterface.
+type SchedulerLeaseRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *SchedulerLeaseRepo) Acquire(ctx context.Context, input interfaces2.AcquireLeaseInput) (bool, error) {
+	timer := r.metrics.UpdateDuration.Start()
+	expiresAt := input.Now.Add(input.Duration)
+	tx := r.db.Exec(acquireLeaseQuery, input.Name, input.HolderID, expiresAt, input.Now, input.Now, input.Now)
+	timer.Stop()
+	if tx.Error != nil {
+		return false, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return tx.RowsAffected == 1, nil
+}
+
+func (r *SchedulerLeaseRepo) Release(ctx context.Context, name, holderID string) error {
+	timer := r.metrics.DeleteDuration.Start()
+	tx := r.db.Where(&models.SchedulerLease{Name: name, HolderID: holderID}).Delete(&models.SchedulerLease{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+// NewSchedulerLeaseRepo Returns an instance of SchedulerLeaseRepoInterface
+func NewSchedulerLeaseRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces2.SchedulerLeaseRepoInterface {
+	metrics := newMetrics(scope)
+	return &SchedulerLeaseRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
@@ -0,0 +1,137 @@
+package gormimpl
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	interfaces2 "github.com/flyteorg/flyteadmin/scheduler/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+
+	"github.com/jinzhu/gorm"
+)
+
+// The watermark is carried over when another version of the launch plan is activated while the trigger is active and
+// keeps watching the same objects. A trigger which was inactive starts over from the new watermark instead of
+// launching for all the objects which arrived in the meantime.
+const activateStorageTriggerQuery = `INSERT INTO storage_triggers (created_at, updated_at, project, domain, name,
+version, prefix, pattern, poll_interval, input_name, input_type, blob_format, active, last_polled_at, watermark_time,
+watermark_key)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, true, ?, ?, ?)
+ON CONFLICT (project, domain, name) DO UPDATE SET updated_at = EXCLUDED.updated_at, version = EXCLUDED.version,
+prefix = EXCLUDED.prefix, pattern = EXCLUDED.pattern, poll_interval = EXCLUDED.poll_interval,
+input_name = EXCLUDED.input_name, input_type = EXCLUDED.input_type, blob_format = EXCLUDED.blob_format, active = true,
+watermark_time = CASE WHEN storage_triggers.active AND storage_triggers.prefix = EXCLUDED.prefix
+AND storage_triggers.pattern = EXCLUDED.pattern THEN storage_triggers.watermark_time ELSE EXCLUDED.watermark_time END,
+watermark_key = CASE WHEN storage_triggers.active AND storage_triggers.prefix = EXCLUDED.prefix
+AND storage_triggers.pattern = EXCLUDED.pattern THEN storage_triggers.watermark_key ELSE EXCLUDED.watermark_key END`
+
+// StorageTriggerRepo Implementation of StorageTriggerRepoInterface.
+type StorageTriggerRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *StorageTriggerRepo) Activate(ctx context.Context, input models.StorageTrigger) error {
+	now := time.Now()
+	timer := r.metrics.CreateDuration.Start()
+	tx := r.db.Exec(activateStorageTriggerQuery, now, now, input.Project, input.Domain, input.Name, input.Version,
+		input.Prefix, input.Pattern, input.PollInterval, input.InputName, input.InputType, input.BlobFormat,
+		input.LastPolledAt, input.WatermarkTime, input.WatermarkKey)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *StorageTriggerRepo) Deactivate(ctx context.Context, id models.SchedulableEntityKey) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := r.db.Model(&models.StorageTrigger{}).Where(&models.StorageTrigger{
+		Project: id.Project,
+		Domain:  id.Domain,
+		Name:    id.Name,
+		Version: id.Version,
+	}).Updates(map[string]interface{}{
+		"active":     false,
+		"updated_at": time.Now(),
+	})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *StorageTriggerRepo) GetAllActive(ctx context.Context) ([]models.StorageTrigger, error) {
+	var storageTriggers []models.StorageTrigger
+	timer := r.metrics.ListDuration.Start()
+	tx := r.db.Where("active = ?", true).Order("id").Find(&storageTriggers)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return storageTriggers, nil
+}
+
+func (r *StorageTriggerRepo) UpdateWatermark(ctx context.Context, input models.StorageTrigger) (bool, error) {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := r.db.Model(&models.StorageTrigger{}).Where("id = ? AND version = ? AND prefix = ? AND pattern = ? AND active",
+		input.ID, input.Version, input.Prefix, input.Pattern).Updates(map[string]interface{}{
+		"updated_at":     time.Now(),
+		"last_polled_at": input.LastPolledAt,
+		"watermark_time": input.WatermarkTime,
+		"watermark_key":  input.WatermarkKey,
+	})
+	timer.Stop()
+	if tx.Error != nil {
+		return false, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return tx.RowsAffected > 0, nil
+}
+
+func (r *StorageTriggerRepo) CreateObject(ctx context.Context, input models.StorageTriggerObject) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := r.db.Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *StorageTriggerRepo) ListObjects(ctx context.Context, storageTriggerID uint, modifiedSince time.Time) (
+	[]models.StorageTriggerObject, error) {
+	var objects []models.StorageTriggerObject
+	timer := r.metrics.ListDuration.Start()
+	tx := r.db.Where("storage_trigger_id = ? AND last_modified >= ?", storageTriggerID, modifiedSince).Find(&objects)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return objects, nil
+}
+
+func (r *StorageTriggerRepo) DeleteObjects(ctx context.Context, storageTriggerID uint, modifiedBefore time.Time) error {
+	timer := r.metrics.DeleteDuration.Start()
+	tx := r.db.Where("storage_trigger_id = ? AND last_modified < ?", storageTriggerID, modifiedBefore).Delete(
+		&models.StorageTriggerObject{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+// NewStorageTriggerRepo Returns an instance of StorageTriggerRepoInterface
+func NewStorageTriggerRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces2.StorageTriggerRepoInterface {
+	metrics := newMetrics(scope)
+	return &StorageTriggerRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
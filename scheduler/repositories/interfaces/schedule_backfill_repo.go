@@ -0,0 +1,36 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+)
+
+//go:generate mockery -name=ScheduleBackfillRepoInterface -output=../mocks -case=underscore
+
+// ListScheduleBackfillsInput filters the backfills to list. Empty fields match all backfills.
+type ListScheduleBackfillsInput struct {
+	Project string
+	Domain  string
+	Name    string
+	Phases  []models.ScheduleBackfillPhase
+	Limit   int
+	Offset  int
+}
+
+// ScheduleBackfillRepoInterface : An Interface for interacting with the schedule backfills in the database
+type ScheduleBackfillRepoInterface interface {
+
+	// Inserts a backfill into the database store and returns it with its assigned id.
+	Create(ctx context.Context, input models.ScheduleBackfill) (models.ScheduleBackfill, error)
+
+	// Returns the backfill with the given id.
+	Get(ctx context.Context, id uint) (models.ScheduleBackfill, error)
+
+	// Returns the backfills matching the input, oldest first.
+	List(ctx context.Context, input ListScheduleBackfillsInput) ([]models.ScheduleBackfill, error)
+
+	// Updates the phase, progress, last fired time and error of a backfill which is still pending or running. Returns false when the
+	// backfill has already finished, e.g. because it was cancelled.
+	Update(ctx context.Context, input models.ScheduleBackfill) (bool, error)
+}
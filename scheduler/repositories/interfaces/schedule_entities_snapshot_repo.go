@@ -16,4 +16,7 @@ type ScheduleEntitiesSnapShotRepoInterface interface {
 
 	// Get the latest snapshot from the database store.
 	Read(ctx context.Context) (models.ScheduleEntitiesSnapshot, error)
+
+	// Deletes all the snapshots once their last execution times have been migrated.
+	DeleteAll(ctx context.Context) error
 }
@@ -0,0 +1,22 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+)
+
+//go:generate mockery -name=ScheduleLastFireRepoInterface -output=../mocks -case=underscore
+
+// ScheduleLastFireRepoInterface : An Interface for interacting with the last fire times of the schedules in the database
+type ScheduleLastFireRepoInterface interface {
+
+	// Inserts the last fire time of a schedule or advances the existing one. The time is never moved backwards.
+	Upsert(ctx context.Context, input models.ScheduleLastFire) error
+
+	// Returns the last fire times of all the schedules.
+	GetAll(ctx context.Context) ([]models.ScheduleLastFire, error)
+
+	// Deletes the last fire times of all the schedules but the given ones.
+	Prune(ctx context.Context, keepScheduleKeys []string) error
+}
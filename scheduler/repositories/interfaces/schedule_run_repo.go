@@ -0,0 +1,30 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+)
+
+//go:generate mockery -name=ScheduleRunRepoInterface -output=../mocks -case=underscore
+
+// ListScheduleRunsInput filters the schedule runs of a launch plan to list. Empty fields match all runs.
+type ListScheduleRunsInput struct {
+	Project  string
+	Domain   string
+	Name     string
+	Version  string
+	Outcomes []models.ScheduleRunOutcome
+	Limit    int
+	Offset   int
+}
+
+// ScheduleRunRepoInterface : An Interface for interacting with the history of schedule runs in the database
+type ScheduleRunRepoInterface interface {
+
+	// Inserts a schedule run into the database store.
+	Create(ctx context.Context, input models.ScheduleRun) error
+
+	// Returns the runs matching the input, most recently scheduled first.
+	List(ctx context.Context, input ListScheduleRunsInput) ([]models.ScheduleRun, error)
+}
@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+)
+
+//go:generate mockery -name=ScheduleUpdateRepoInterface -output=../mocks -case=underscore
+
+// ScheduleUpdateRepoInterface : An Interface for notifying the scheduler of the schedules activated or deactivated in
+// the database
+type ScheduleUpdateRepoInterface interface {
+
+	// Notify notifies the listening scheduler that the schedulable entity was activated or deactivated. Notifications
+	// aren't persisted, a scheduler which isn't listening misses them.
+	Notify(ctx context.Context, ID models.SchedulableEntityKey) error
+}
@@ -0,0 +1,32 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockery -name=SchedulerLeaseRepoInterface -output=../mocks -case=underscore
+
+// AcquireLeaseInput describes an attempt to acquire or renew a lease.
+type AcquireLeaseInput struct {
+	// Name of the lease being acquired.
+	Name string
+	// Identity of the replica attempting to acquire the lease.
+	HolderID string
+	// Time the attempt is made at.
+	Now time.Time
+	// Duration for which the lease is held when acquired.
+	Duration time.Duration
+}
+
+// SchedulerLeaseRepoInterface : An Interface for interacting with the scheduler leases in the database
+type SchedulerLeaseRepoInterface interface {
+
+	// Acquires the lease when it isn't held or has expired, or renews it when it's already held by the same holder.
+	// Returns whether the lease is held by the holder after the call.
+	Acquire(ctx context.Context, input AcquireLeaseInput) (bool, error)
+
+	// Releases the lease if it's held by the holder, so that another replica can acquire it without waiting for
+	// it to expire.
+	Release(ctx context.Context, name, holderID string) error
+}
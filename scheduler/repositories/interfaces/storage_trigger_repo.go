@@ -0,0 +1,38 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+)
+
+//go:generate mockery -name=StorageTriggerRepoInterface -output=../mocks -case=underscore
+
+// StorageTriggerRepoInterface : An Interface for interacting with the storage triggers in the database
+type StorageTriggerRepoInterface interface {
+
+	// Activates the storage trigger of a launch plan version, replacing that of any other version. The watermark of an
+	// active trigger watching the same prefix and pattern is kept so that objects aren't launched for twice, otherwise
+	// the watermark starts at the given one.
+	Activate(ctx context.Context, input models.StorageTrigger) error
+
+	// Deactivates the storage trigger of the launch plan version.
+	Deactivate(ctx context.Context, id models.SchedulableEntityKey) error
+
+	// Returns all the active storage triggers.
+	GetAllActive(ctx context.Context) ([]models.StorageTrigger, error)
+
+	// Records a poll of the storage trigger and advances its watermark. Returns false without updating anything when
+	// the trigger was deactivated or activated for another version since it was read.
+	UpdateWatermark(ctx context.Context, input models.StorageTrigger) (bool, error)
+
+	// Records an object the storage trigger launched for.
+	CreateObject(ctx context.Context, input models.StorageTriggerObject) error
+
+	// Returns the objects the storage trigger launched for which were last modified at or after the given time.
+	ListObjects(ctx context.Context, storageTriggerID uint, modifiedSince time.Time) ([]models.StorageTriggerObject, error)
+
+	// Deletes the objects the storage trigger launched for which were last modified before the given time.
+	DeleteObjects(ctx context.Context, storageTriggerID uint, modifiedBefore time.Time) error
+}
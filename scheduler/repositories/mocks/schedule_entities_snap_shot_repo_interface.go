@@ -15,6 +15,38 @@ type ScheduleEntitiesSnapShotRepoInterface struct {
 	mock.Mock
 }
 
+type ScheduleEntitiesSnapShotRepoInterface_DeleteAll struct {
+	*mock.Call
+}
+
+func (_m ScheduleEntitiesSnapShotRepoInterface_DeleteAll) Return(_a0 error) *ScheduleEntitiesSnapShotRepoInterface_DeleteAll {
+	return &ScheduleEntitiesSnapShotRepoInterface_DeleteAll{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *ScheduleEntitiesSnapShotRepoInterface) OnDeleteAll(ctx context.Context) *ScheduleEntitiesSnapShotRepoInterface_DeleteAll {
+	c := _m.On("DeleteAll", ctx)
+	return &ScheduleEntitiesSnapShotRepoInterface_DeleteAll{Call: c}
+}
+
+func (_m *ScheduleEntitiesSnapShotRepoInterface) OnDeleteAllMatch(matchers ...interface{}) *ScheduleEntitiesSnapShotRepoInterface_DeleteAll {
+	c := _m.On("DeleteAll", matchers...)
+	return &ScheduleEntitiesSnapShotRepoInterface_DeleteAll{Call: c}
+}
+
+// DeleteAll provides a mock function with given fields: ctx
+func (_m *ScheduleEntitiesSnapShotRepoInterface) DeleteAll(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type ScheduleEntitiesSnapShotRepoInterface_Read struct {
 	*mock.Call
 }
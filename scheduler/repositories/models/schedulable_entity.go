@@ -9,11 +9,18 @@ import (
 type SchedulableEntity struct {
 	models.BaseModel
 	SchedulableEntityKey
-	CronExpression      string
+	CronExpression string
+	// IANA timezone on whose wall clock the cron expression is evaluated. Empty for the scheduler's local zone.
+	Timezone            string
 	FixedRateValue      uint32
 	Unit                admin.FixedRateUnit
 	KickoffTimeInputArg string
-	Active              *bool
+	// Which of the schedule times missed while the scheduler was down are fired, e.g. latest-only. Empty for the
+	// scheduler's default policy.
+	CatchupPolicy string
+	// Comma separated names of the calendars whose days the schedule doesn't fire on.
+	ExclusionCalendars string
+	Active             *bool
 }
 
 // Schedulable entity primary key
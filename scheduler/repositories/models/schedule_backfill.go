@@ -0,0 +1,62 @@
+package models
+
+import (
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+type ScheduleBackfillPhase = string
+
+const (
+	// Created and waiting to be picked up by the scheduler.
+	ScheduleBackfillPhasePending ScheduleBackfillPhase = "PENDING"
+	// Executions are being created. Running backfills are resumed from their progress when the scheduler restarts.
+	ScheduleBackfillPhaseRunning   ScheduleBackfillPhase = "RUNNING"
+	ScheduleBackfillPhaseSucceeded ScheduleBackfillPhase = "SUCCEEDED"
+	ScheduleBackfillPhaseFailed    ScheduleBackfillPhase = "FAILED"
+	ScheduleBackfillPhaseCancelled ScheduleBackfillPhase = "CANCELLED"
+)
+
+type ScheduleBackfillOrder = string
+
+const (
+	// Fires the oldest schedule times first.
+	ScheduleBackfillOrderAscending ScheduleBackfillOrder = "ASCENDING"
+	// Fires the most recent schedule times first.
+	ScheduleBackfillOrderDescending ScheduleBackfillOrder = "DESCENDING"
+)
+
+// Database model for a backfill of a launch plan schedule over the time range [StartTime, EndTime).
+type ScheduleBackfill struct {
+	ID        uint `gorm:"AUTO_INCREMENT;column:id;primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// The backfilled launch plan.
+	Project string `gorm:"index:schedule_backfills_launch_plan_idx"`
+	Domain  string `gorm:"index:schedule_backfills_launch_plan_idx"`
+	Name    string `gorm:"index:schedule_backfills_launch_plan_idx"`
+	Version string
+	// The schedule of the launch plan at the time the backfill was created.
+	CronExpression      string
+	Timezone            string
+	FixedRateValue      uint32
+	Unit                admin.FixedRateUnit
+	KickoffTimeInputArg string
+	StartTime           time.Time
+	EndTime             time.Time
+	// Catch-up policy which selects the schedule times in the range that are fired. Empty to fire all of them.
+	CatchupPolicy string
+	// Comma separated names of the calendars whose days are skipped.
+	ExclusionCalendars string
+	// Number of executions created concurrently.
+	Parallelism uint32
+	Order       ScheduleBackfillOrder
+	Phase       ScheduleBackfillPhase `gorm:"index:schedule_backfills_phase_idx"`
+	// Number of schedule times in the range and the number of them which were fired so far, in the backfill order.
+	TotalExecutions     uint32
+	CompletedExecutions uint32
+	// The last schedule time fired, in the backfill order. Interrupted backfills are resumed from the times after it.
+	LastFiredTime *time.Time
+	Error         string
+}
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+)
+
+// Database model recording the last time the native scheduler fired each schedule. It replaces the serialized
+// ScheduleEntitiesSnapshot and is read on startup to catch up on the schedule times missed since.
+type ScheduleLastFire struct {
+	// Name of the schedule within the scheduler, see identifier.GetScheduleName.
+	ScheduleKey string `gorm:"primary_key"`
+	// The scheduled launch plan. Empty for rows migrated from a v1 snapshot of a schedule which no longer exists.
+	Project      string
+	Domain       string
+	Name         string
+	Version      string
+	LastFireTime time.Time
+	UpdatedAt    time.Time
+}
+
+func (ScheduleLastFire) TableName() string {
+	return "schedule_last_fire"
+}
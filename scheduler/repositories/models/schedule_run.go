@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+)
+
+type ScheduleRunOutcome = string
+
+const (
+	// An execution was created for the scheduled time.
+	ScheduleRunOutcomeFired ScheduleRunOutcome = "FIRED"
+	// The schedule was deactivated by the time it fired, no execution was created.
+	ScheduleRunOutcomeSkippedInactive ScheduleRunOutcome = "SKIPPED_INACTIVE"
+	// The scheduled time fell on a day excluded by one of the calendars of the schedule, no execution was created.
+	ScheduleRunOutcomeSkippedExcluded ScheduleRunOutcome = "SKIPPED_EXCLUDED"
+	// An execution for the scheduled time already existed, e.g. because it was fired before the scheduler restarted.
+	ScheduleRunOutcomeAlreadyExists ScheduleRunOutcome = "ALREADY_EXISTS"
+	// Admin failed to create the execution on all attempts.
+	ScheduleRunOutcomeFailed ScheduleRunOutcome = "FAILED"
+)
+
+// Database model recording each time the native scheduler fired a schedule.
+type ScheduleRun struct {
+	ID        uint `gorm:"AUTO_INCREMENT;column:id;primary_key"`
+	CreatedAt time.Time
+	// The scheduled launch plan.
+	Project string `gorm:"index:schedule_runs_launch_plan_idx"`
+	Domain  string `gorm:"index:schedule_runs_launch_plan_idx"`
+	Name    string `gorm:"index:schedule_runs_launch_plan_idx"`
+	Version string
+	// The time the schedule fired for and the time the scheduler fired it at, which is later during a catch up.
+	ScheduledTime time.Time `gorm:"index:schedule_runs_launch_plan_idx"`
+	FiredAt       time.Time
+	// Name of the execution, in the project and domain of the launch plan, which was created for the scheduled time.
+	ExecutionName string
+	Outcome       ScheduleRunOutcome
+	// Number of attempts to create the execution.
+	Attempts uint32
+	// Why the execution couldn't be created, or the calendar which excluded the scheduled time.
+	Error string
+}
@@ -0,0 +1,23 @@
+package models
+
+import "encoding/json"
+
+// ScheduleUpdatesChannel is the Postgres notification channel on which the keys of the schedulable entities which were
+// activated or deactivated are published.
+const ScheduleUpdatesChannel = "flyte_schedule_updates"
+
+// EncodeScheduleUpdate returns the notification payload for an update of the schedulable entity.
+func EncodeScheduleUpdate(ID SchedulableEntityKey) (string, error) {
+	payload, err := json.Marshal(ID)
+	if err != nil {
+		return "", err
+	}
+	return string(payload), nil
+}
+
+// DecodeScheduleUpdate returns the key of the schedulable entity a notification payload is for.
+func DecodeScheduleUpdate(payload string) (SchedulableEntityKey, error) {
+	var ID SchedulableEntityKey
+	err := json.Unmarshal([]byte(payload), &ID)
+	return ID, err
+}
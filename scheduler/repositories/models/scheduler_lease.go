@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// Database model for the lease held by the scheduler replica which is currently the leader.
+// A lease which isn't renewed before it expires can be taken over by any other replica.
+type SchedulerLease struct {
+	Name      string `gorm:"primary_key"`
+	HolderID  string `gorm:"not null"`
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
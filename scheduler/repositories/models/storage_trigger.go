@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+)
+
+type StorageTriggerInputType = string
+
+const (
+	// The URI of the new object is bound to a string input.
+	StorageTriggerInputTypeString StorageTriggerInputType = "STRING"
+	// The new object is bound to a single blob input.
+	StorageTriggerInputTypeBlob StorageTriggerInputType = "BLOB"
+)
+
+// Database model of a storage trigger, which launches the active version of a launch plan once for every new object
+// under a storage prefix. There is a single storage trigger per launch plan, across its versions.
+type StorageTrigger struct {
+	ID        uint `gorm:"AUTO_INCREMENT;column:id;primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// The triggered launch plan.
+	Project string `gorm:"unique_index:storage_triggers_launch_plan_idx"`
+	Domain  string `gorm:"unique_index:storage_triggers_launch_plan_idx"`
+	Name    string `gorm:"unique_index:storage_triggers_launch_plan_idx"`
+	Version string
+	// The storage prefix which is polled, e.g. s3://bucket/landing/.
+	Prefix string
+	// Glob pattern, see path.Match, which the keys of new objects relative to the prefix must match.
+	Pattern      string
+	PollInterval time.Duration
+	// The launch plan input the new object is bound to.
+	InputName  string
+	InputType  StorageTriggerInputType
+	BlobFormat string
+	Active     *bool
+	// The time the prefix was last polled at.
+	LastPolledAt time.Time
+	// Objects are launched for in the order of their last modified time and reference. The watermark is the last
+	// modified time and reference of the last object which was launched for, only later objects are new.
+	WatermarkTime time.Time
+	WatermarkKey  string
+}
@@ -0,0 +1,15 @@
+package models
+
+import (
+	"time"
+)
+
+// Database model recording an object a storage trigger launched for. Objects can show up with a last modified time
+// before the watermark, e.g. multipart uploads which carry the time the upload started, so the objects modified within
+// the grace period before the watermark are compared against those launched for instead of being skipped.
+type StorageTriggerObject struct {
+	StorageTriggerID uint      `gorm:"primary_key"`
+	Reference        string    `gorm:"primary_key"`
+	LastModified     time.Time `gorm:"primary_key"`
+	CreatedAt        time.Time
+}
@@ -11,6 +11,12 @@ import (
 type PostgresRepo struct {
 	schedulableEntityRepo        interfaces.SchedulableEntityRepoInterface
 	scheduleEntitiesSnapshotRepo interfaces.ScheduleEntitiesSnapShotRepoInterface
+	schedulerLeaseRepo           interfaces.SchedulerLeaseRepoInterface
+	scheduleBackfillRepo         interfaces.ScheduleBackfillRepoInterface
+	scheduleRunRepo              interfaces.ScheduleRunRepoInterface
+	scheduleLastFireRepo         interfaces.ScheduleLastFireRepoInterface
+	storageTriggerRepo           interfaces.StorageTriggerRepoInterface
+	scheduleUpdateRepo           interfaces.ScheduleUpdateRepoInterface
 }
 
 func (p *PostgresRepo) SchedulableEntityRepo() interfaces.SchedulableEntityRepoInterface {
@@ -21,9 +27,39 @@ func (p *PostgresRepo) ScheduleEntitiesSnapshotRepo() interfaces.ScheduleEntitie
 	return p.scheduleEntitiesSnapshotRepo
 }
 
+func (p *PostgresRepo) SchedulerLeaseRepo() interfaces.SchedulerLeaseRepoInterface {
+	return p.schedulerLeaseRepo
+}
+
+func (p *PostgresRepo) ScheduleBackfillRepo() interfaces.ScheduleBackfillRepoInterface {
+	return p.scheduleBackfillRepo
+}
+
+func (p *PostgresRepo) ScheduleRunRepo() interfaces.ScheduleRunRepoInterface {
+	return p.scheduleRunRepo
+}
+
+func (p *PostgresRepo) ScheduleLastFireRepo() interfaces.ScheduleLastFireRepoInterface {
+	return p.scheduleLastFireRepo
+}
+
+func (p *PostgresRepo) StorageTriggerRepo() interfaces.StorageTriggerRepoInterface {
+	return p.storageTriggerRepo
+}
+
+func (p *PostgresRepo) ScheduleUpdateRepo() interfaces.ScheduleUpdateRepoInterface {
+	return p.scheduleUpdateRepo
+}
+
 func NewPostgresRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) SchedulerRepoInterface {
 	return &PostgresRepo{
 		schedulableEntityRepo:        gormimpl.NewSchedulableEntityRepo(db, errorTransformer, scope.NewSubScope("schedulable_entity")),
 		scheduleEntitiesSnapshotRepo: gormimpl.NewScheduleEntitiesSnapshotRepo(db, errorTransformer, scope.NewSubScope("schedule_entities_snapshot")),
+		schedulerLeaseRepo:           gormimpl.NewSchedulerLeaseRepo(db, errorTransformer, scope.NewSubScope("scheduler_lease")),
+		scheduleBackfillRepo:         gormimpl.NewScheduleBackfillRepo(db, errorTransformer, scope.NewSubScope("schedule_backfill")),
+		scheduleRunRepo:              gormimpl.NewScheduleRunRepo(db, errorTransformer, scope.NewSubScope("schedule_run")),
+		scheduleLastFireRepo:         gormimpl.NewScheduleLastFireRepo(db, errorTransformer, scope.NewSubScope("schedule_last_fire")),
+		storageTriggerRepo:           gormimpl.NewStorageTriggerRepo(db, errorTransformer, scope.NewSubScope("storage_trigger")),
+		scheduleUpdateRepo:           gormimpl.NewScheduleUpdateRepo(db, errorTransformer, scope.NewSubScope("schedule_update")),
 	}
 }
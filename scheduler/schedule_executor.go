@@ -5,23 +5,28 @@ import (
 	"time"
 
 	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteadmin/scheduler/calendar"
 	"github.com/flyteorg/flyteadmin/scheduler/core"
 	"github.com/flyteorg/flyteadmin/scheduler/executor"
 	"github.com/flyteorg/flyteadmin/scheduler/repositories"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
 	"github.com/flyteorg/flyteadmin/scheduler/snapshoter"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/service"
 	"github.com/flyteorg/flytestdlib/futures"
 	"github.com/flyteorg/flytestdlib/logger"
 	"github.com/flyteorg/flytestdlib/promutils"
 
+	"github.com/benbjohnson/clock"
 	"golang.org/x/time/rate"
 	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 const snapshotWriterDuration = 30 * time.Second
 const scheduleUpdaterDuration = 30 * time.Second
+const backfillRunnerDuration = 30 * time.Second
+const storageTriggerRunnerDuration = 5 * time.Second
 
-const snapShotVersion = 1
+const snapShotVersion = 2
 
 // ScheduledExecutor used for executing the schedules saved by the native flyte scheduler in the database.
 type ScheduledExecutor struct {
@@ -31,9 +36,38 @@ type ScheduledExecutor struct {
 	scope                  promutils.Scope
 	adminServiceClient     service.AdminServiceClient
 	workflowExecutorConfig *runtimeInterfaces.FlyteWorkflowExecutorConfig
+	leaderElector          *core.LeaderElector
+	catchupConfig          runtimeInterfaces.ScheduleCatchupConfig
+	storageLister          core.StorageLister
+	storageTriggersConfig  runtimeInterfaces.StorageTriggersConfig
+	calendarConfigs        map[string]runtimeInterfaces.ScheduleCalendarConfig
+	scheduleUpdatesConfig  runtimeInterfaces.ScheduleUpdatesConfig
+	scheduleUpdateListener core.ScheduleUpdateListener
 }
 
+// Run runs the scheduler until the context is done. With leader election enabled the scheduler only runs once this
+// replica becomes the leader and returns an error if leadership is lost. A replica taking over reads the latest
+// snapshot written by the previous leader and catches up from it.
 func (w *ScheduledExecutor) Run(ctx context.Context) error {
+	if w.leaderElector == nil {
+		return w.run(ctx)
+	}
+	return w.leaderElector.Run(ctx, w.run)
+}
+
+// Returns how often all the schedules are read. With a listener this only reconciles the missed notifications.
+func (w *ScheduledExecutor) getScheduleUpdaterInterval() time.Duration {
+	interval := w.scheduleUpdatesConfig.PollInterval.Duration
+	if w.scheduleUpdateListener != nil {
+		interval = w.scheduleUpdatesConfig.ReconcileInterval.Duration
+	}
+	if interval <= 0 {
+		return scheduleUpdaterDuration
+	}
+	return interval
+}
+
+func (w *ScheduledExecutor) run(ctx context.Context) error {
 	logger.Infof(ctx, "Flyte native scheduler started successfully")
 
 	defer logger.Infof(ctx, "Flyte native scheduler shutdown")
@@ -48,6 +82,18 @@ func (w *ScheduledExecutor) Run(ctx context.Context) error {
 		return err
 	}
 
+	// Listen for the schedule updates before reading the schedules so that no update made after the read is missed
+	listenerCtx, listenerCancel := context.WithCancel(ctx)
+	defer listenerCancel()
+	var scheduleUpdates <-chan *models.SchedulableEntityKey
+	if w.scheduleUpdateListener != nil {
+		scheduleUpdates, err = w.scheduleUpdateListener.Listen(listenerCtx)
+		if err != nil {
+			logger.Errorf(ctx, "unable to listen for the schedule updates due to %v. Aborting", err)
+			return err
+		}
+	}
+
 	// Read all the schedules from the DB
 	schedules, err := w.db.SchedulableEntityRepo().GetAll(ctx)
 	if err != nil {
@@ -55,26 +101,39 @@ func (w *ScheduledExecutor) Run(ctx context.Context) error {
 		return err
 	}
 	logger.Infof(ctx, "Number of schedules retrieved %v", len(schedules))
+
+	// Parse the calendars the schedules can exclude their ticks by
+	calendars, err := calendar.New(w.calendarConfigs)
+	if err != nil {
+		logger.Errorf(ctx, "unable to parse the calendars due to %v. Aborting", err)
+		return err
+	}
 	adminRateLimit := w.workflowExecutorConfig.GetAdminRateLimit()
 
 	// Set the rate limit on the admin
 	rateLimiter := rate.NewLimiter(adminRateLimit.GetTps(), adminRateLimit.GetBurst())
 
 	// Set the executor to send executions to admin
-	executor := executor.New(w.scope, w.adminServiceClient)
+	executor := executor.New(w.scope, w.adminServiceClient, w.db.ScheduleRunRepo(), calendars)
 
 	// Create the scheduler using GoCronScheduler implementation
 	// Also Bootstrap the schedules from the snapshot
 	bootStrapCtx, bootStrapCancel := context.WithCancel(ctx)
 	defer bootStrapCancel()
-	gcronScheduler := core.NewGoCronScheduler(bootStrapCtx, schedules, w.scope, snapshot, rateLimiter, executor)
+	gcronScheduler := core.NewGoCronScheduler(bootStrapCtx, schedules, w.scope, snapshot, w.snapshoter, rateLimiter,
+		executor, w.catchupConfig)
 	w.scheduler = gcronScheduler
 
 	// Start the go routine to write the update schedules periodically
 	updaterCtx, updaterCancel := context.WithCancel(ctx)
 	defer updaterCancel()
 	gcronUpdater := core.NewUpdater(w.db, gcronScheduler)
-	go wait.UntilWithContext(updaterCtx, gcronUpdater.UpdateGoCronSchedules, scheduleUpdaterDuration)
+	go wait.UntilWithContext(updaterCtx, gcronUpdater.UpdateGoCronSchedules, w.getScheduleUpdaterInterval())
+
+	// Apply the notified schedule updates as they come
+	if scheduleUpdates != nil {
+		go gcronUpdater.ApplyUpdates(updaterCtx, scheduleUpdates)
+	}
 
 	// Catch up simulataneously on all the schedules in the scheduler
 	currTime := time.Now()
@@ -92,6 +151,21 @@ func (w *ScheduledExecutor) Run(ctx context.Context) error {
 		return err
 	}
 
+	// Start the go routine to run the schedule backfills periodically. Backfills share the rate limit on the admin.
+	backfillCtx, backfillCancel := context.WithCancel(ctx)
+	defer backfillCancel()
+	backfillRunner := core.NewBackfillRunner(w.db, executor, rateLimiter, calendars, w.scope)
+	go wait.UntilWithContext(backfillCtx, backfillRunner.Run, backfillRunnerDuration)
+
+	// Start the go routine to poll the prefixes of the storage triggers. These too share the rate limit on the admin.
+	if w.storageLister != nil {
+		storageTriggerCtx, storageTriggerCancel := context.WithCancel(ctx)
+		defer storageTriggerCancel()
+		storageTriggerRunner := core.NewStorageTriggerRunner(w.db, w.storageLister, executor, rateLimiter,
+			w.storageTriggersConfig, w.scope)
+		go wait.UntilWithContext(storageTriggerCtx, storageTriggerRunner.Run, storageTriggerRunnerDuration)
+	}
+
 	snapshotRunner := core.NewSnapshotRunner(w.snapshoter, w.scheduler)
 	// Start the go routine to write the snapshot periodically
 	snapshoterCtx, snapshoterCancel := context.WithCancel(ctx)
@@ -102,14 +176,39 @@ func (w *ScheduledExecutor) Run(ctx context.Context) error {
 	return nil
 }
 
+// NewScheduledExecutor returns the executor of the schedules. The storage triggers are only polled when given a
+// storage lister, and the schedule updates are only applied as they are notified when given a listener.
 func NewScheduledExecutor(db repositories.SchedulerRepoInterface,
 	workflowExecutorConfig runtimeInterfaces.WorkflowExecutorConfig,
-	scope promutils.Scope, adminServiceClient service.AdminServiceClient) ScheduledExecutor {
+	flyteSchedulerConfig *runtimeInterfaces.FlyteSchedulerConfig,
+	scope promutils.Scope, adminServiceClient service.AdminServiceClient,
+	storageLister core.StorageLister, scheduleUpdateListener core.ScheduleUpdateListener) ScheduledExecutor {
+	var leaderElector *core.LeaderElector
+	var catchupConfig runtimeInterfaces.ScheduleCatchupConfig
+	var storageTriggersConfig runtimeInterfaces.StorageTriggersConfig
+	var calendarConfigs map[string]runtimeInterfaces.ScheduleCalendarConfig
+	var scheduleUpdatesConfig runtimeInterfaces.ScheduleUpdatesConfig
+	if flyteSchedulerConfig != nil {
+		if flyteSchedulerConfig.GetLeaderElection().Enabled {
+			leaderElector = core.NewLeaderElector(db, flyteSchedulerConfig.GetLeaderElection(), scope, clock.New())
+		}
+		catchupConfig = flyteSchedulerConfig.GetCatchup()
+		storageTriggersConfig = flyteSchedulerConfig.GetStorageTriggers()
+		calendarConfigs = flyteSchedulerConfig.GetCalendars()
+		scheduleUpdatesConfig = flyteSchedulerConfig.GetScheduleUpdates()
+	}
 	return ScheduledExecutor{
 		db:                     db,
 		scope:                  scope,
 		adminServiceClient:     adminServiceClient,
 		workflowExecutorConfig: workflowExecutorConfig.GetFlyteWorkflowExecutorConfig(),
 		snapshoter:             snapshoter.New(scope, db),
+		leaderElector:          leaderElector,
+		catchupConfig:          catchupConfig,
+		storageLister:          storageLister,
+		storageTriggersConfig:  storageTriggersConfig,
+		calendarConfigs:        calendarConfigs,
+		scheduleUpdatesConfig:  scheduleUpdatesConfig,
+		scheduleUpdateListener: scheduleUpdateListener,
 	}
 }
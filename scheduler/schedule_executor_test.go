@@ -58,10 +58,18 @@ func setupScheduleExecutor(t *testing.T, s string) ScheduledExecutor {
 	}
 	snapshotRepo.OnReadMatch(mock.Anything).Return(snapshotModel, nil)
 	snapshotRepo.OnWriteMatch(mock.Anything, mock.Anything).Return(nil)
+	backfillRepo := db.ScheduleBackfillRepo().(*schedMocks.ScheduleBackfillRepoInterface)
+	backfillRepo.OnListMatch(mock.Anything, mock.Anything).Return(nil, nil)
+	runRepo := db.ScheduleRunRepo().(*schedMocks.ScheduleRunRepoInterface)
+	runRepo.OnCreateMatch(mock.Anything, mock.Anything).Return(nil)
+	lastFireRepo := db.ScheduleLastFireRepo().(*schedMocks.ScheduleLastFireRepoInterface)
+	lastFireRepo.OnGetAllMatch(mock.Anything).Return(nil, nil)
+	lastFireRepo.OnUpsertMatch(mock.Anything, mock.Anything).Return(nil)
+	lastFireRepo.OnPruneMatch(mock.Anything, mock.Anything).Return(nil)
 	mockAdminClient.OnCreateExecutionMatch(context.Background(), mock.Anything).
 		Return(&admin.ExecutionCreateResponse{}, nil)
-	return NewScheduledExecutor(db, scheduleExecutorConfig,
-		scope, mockAdminClient)
+	return NewScheduledExecutor(db, scheduleExecutorConfig, nil,
+		scope, mockAdminClient, nil, nil)
 }
 
 func TestSuccessfulSchedulerExec(t *testing.T) {
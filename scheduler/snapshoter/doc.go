@@ -1,5 +1,8 @@
 // Package snapshoter
-// This package provides the ability to snapshot all the schedules in the scheduler job store and persist them in the DB
-// in GOB binary format. Also it provides ability to bootstrap the scheduler from this snapshot so that the scheduler
+// This package provides the ability to snapshot all the schedules in the scheduler job store and persist them in the DB.
+// Also it provides ability to bootstrap the scheduler from this snapshot so that the scheduler
 // can run catchup for all the schedules from the snapshoted time.
+// The v1 snapshot is persisted as a single row in GOB binary format. The v2 snapshot is persisted as one
+// schedule_last_fire row per schedule, which is upserted each time the schedule fires. A v1 snapshot is migrated to
+// v2 rows the first time the scheduler reads it, after which the v1 snapshot rows are deleted.
 package snapshoter
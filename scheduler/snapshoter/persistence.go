@@ -2,6 +2,9 @@ package snapshoter
 
 import (
 	"context"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
 )
 
 // Persistence allows to read and save the serialized form of the snapshot from a storage.
@@ -12,4 +15,6 @@ type Persistence interface {
 	Save(ctx context.Context, writer Writer, snapshot Snapshot)
 	// Read reads the serialized snapshot from the storage and deserializes to its in memory format.
 	Read(ctx context.Context, reader Reader) (Snapshot, error)
+	// SaveLastExecutionTime saves the time a schedule fired as soon as it fires.
+	SaveLastExecutionTime(ctx context.Context, schedule models.SchedulableEntity, lastExecTime time.Time)
 }
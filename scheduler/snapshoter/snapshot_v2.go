@@ -0,0 +1,33 @@
+package snapshoter
+
+import (
+	"time"
+)
+
+// SnapshotV2 holds the same last execution times as SnapshotV1 but isn't persisted as a serialized blob. Each schedule
+// has its own row in the schedule_last_fire table which is upserted as soon as the schedule fires, and the periodic
+// snapshot only prunes the rows of the schedules which are no longer active.
+type SnapshotV2 struct {
+	SnapshotV1
+}
+
+func (s *SnapshotV2) GetVersion() int {
+	return 2
+}
+
+func (s *SnapshotV2) Create() Snapshot {
+	return &SnapshotV2{
+		SnapshotV1: SnapshotV1{LastTimes: map[string]*time.Time{}},
+	}
+}
+
+// upgradeSnapshot converts a snapshot of any older version to a SnapshotV2.
+func upgradeSnapshot(snapshot Snapshot) *SnapshotV2 {
+	switch s := snapshot.(type) {
+	case *SnapshotV2:
+		return s
+	case *SnapshotV1:
+		return &SnapshotV2{SnapshotV1: *s}
+	}
+	return (&SnapshotV2{}).Create().(*SnapshotV2)
+}
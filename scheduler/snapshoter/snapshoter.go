@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/scheduler/identifier"
 	"github.com/flyteorg/flyteadmin/scheduler/repositories"
 	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
 	"github.com/flyteorg/flytestdlib/logger"
@@ -16,9 +17,11 @@ import (
 )
 
 type Metrics struct {
-	Scope                      promutils.Scope
-	SnapshotSaveErrCounter     prometheus.Counter
-	SnapshotCreationErrCounter prometheus.Counter
+	Scope                           promutils.Scope
+	SnapshotSaveErrCounter          prometheus.Counter
+	SnapshotCreationErrCounter      prometheus.Counter
+	SnapshotPruneErrCounter         prometheus.Counter
+	LastExecutionTimeSaveErrCounter prometheus.Counter
 }
 
 type snapshoter struct {
@@ -27,6 +30,11 @@ type snapshoter struct {
 }
 
 func (w *snapshoter) Save(ctx context.Context, writer Writer, snapshot Snapshot) {
+	// The rows of a v2 snapshot are saved as the schedules fire, only the ones of inactive schedules are left to prune.
+	if snapshotV2, ok := snapshot.(*SnapshotV2); ok {
+		w.prune(ctx, snapshotV2)
+		return
+	}
 	var bytesArray []byte
 	f := bytes.NewBuffer(bytesArray)
 	// Only write if the snapshot has contents and not equal to the previous snapshot
@@ -47,7 +55,109 @@ func (w *snapshoter) Save(ctx context.Context, writer Writer, snapshot Snapshot)
 	}
 }
 
+func (w *snapshoter) prune(ctx context.Context, snapshot *SnapshotV2) {
+	scheduleKeys := make([]string, 0, len(snapshot.LastTimes))
+	for scheduleKey := range snapshot.LastTimes {
+		scheduleKeys = append(scheduleKeys, scheduleKey)
+	}
+	if err := w.db.ScheduleLastFireRepo().Prune(ctx, scheduleKeys); err != nil {
+		w.metrics.SnapshotPruneErrCounter.Inc()
+		logger.Errorf(ctx, "unable to prune the last fire times of inactive schedules due to %v", err)
+	}
+}
+
+func (w *snapshoter) SaveLastExecutionTime(ctx context.Context, schedule models.SchedulableEntity,
+	lastExecTime time.Time) {
+	err := w.db.ScheduleLastFireRepo().Upsert(ctx, models.ScheduleLastFire{
+		ScheduleKey:  identifier.GetScheduleName(ctx, schedule),
+		Project:      schedule.Project,
+		Domain:       schedule.Domain,
+		Name:         schedule.Name,
+		Version:      schedule.Version,
+		LastFireTime: lastExecTime,
+		UpdatedAt:    time.Now(),
+	})
+	// Just log the error, the schedule catches up from an earlier time after a restart and the executions it already
+	// created are skipped.
+	if err != nil {
+		w.metrics.LastExecutionTimeSaveErrCounter.Inc()
+		logger.Errorf(ctx, "unable to save the last execution time %v of schedule %+v due to %v", lastExecTime,
+			schedule, err)
+	}
+}
+
+// Read returns a v2 snapshot of the last fire times of the schedules. Until any schedule has fired after the upgrade
+// to v2, the times are migrated once from the latest serialized snapshot.
 func (w *snapshoter) Read(ctx context.Context, reader Reader) (Snapshot, error) {
+	lastFires, err := w.db.ScheduleLastFireRepo().GetAll(ctx)
+	if err != nil {
+		logger.Errorf(ctx, "unable to read the last fire times of the schedules from the DB due to %v", err)
+		return nil, err
+	}
+	if len(lastFires) > 0 {
+		snapshot := (&SnapshotV2{}).Create()
+		for idx := range lastFires {
+			snapshot.UpdateLastExecutionTime(lastFires[idx].ScheduleKey, &lastFires[idx].LastFireTime)
+		}
+		return snapshot, nil
+	}
+
+	serialized, err := w.readSerialized(ctx, reader)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := upgradeSnapshot(serialized)
+	if snapshot.IsEmpty() {
+		return snapshot, nil
+	}
+	if err = w.migrate(ctx, snapshot); err != nil {
+		return nil, err
+	}
+	logger.Infof(ctx, "migrated the last execution times of %d schedules from the v%d snapshot",
+		len(snapshot.LastTimes), serialized.GetVersion())
+	return snapshot, nil
+}
+
+// migrate saves the last fire time of each schedule of the snapshot and then deletes the serialized snapshots, so that
+// they aren't migrated again once every last fire time has been pruned.
+func (w *snapshoter) migrate(ctx context.Context, snapshot *SnapshotV2) error {
+	schedules, err := w.db.SchedulableEntityRepo().GetAll(ctx)
+	if err != nil {
+		logger.Errorf(ctx, "unable to read the schedules to migrate the snapshot due to %v", err)
+		return err
+	}
+	schedulesByKey := make(map[string]models.SchedulableEntity, len(schedules))
+	for _, schedule := range schedules {
+		schedulesByKey[identifier.GetScheduleName(ctx, schedule)] = schedule
+	}
+	for scheduleKey, lastTime := range snapshot.LastTimes {
+		if lastTime == nil {
+			continue
+		}
+		// The keys of schedules which no longer exist are kept as is until they are pruned.
+		schedule := schedulesByKey[scheduleKey]
+		err = w.db.ScheduleLastFireRepo().Upsert(ctx, models.ScheduleLastFire{
+			ScheduleKey:  scheduleKey,
+			Project:      schedule.Project,
+			Domain:       schedule.Domain,
+			Name:         schedule.Name,
+			Version:      schedule.Version,
+			LastFireTime: *lastTime,
+			UpdatedAt:    time.Now(),
+		})
+		if err != nil {
+			logger.Errorf(ctx, "unable to migrate the snapshot of schedule %v to the DB due to %v", scheduleKey, err)
+			return err
+		}
+	}
+	if err = w.db.ScheduleEntitiesSnapshotRepo().DeleteAll(ctx); err != nil {
+		logger.Errorf(ctx, "unable to delete the migrated snapshots from the DB due to %v", err)
+		return err
+	}
+	return nil
+}
+
+func (w *snapshoter) readSerialized(ctx context.Context, reader Reader) (Snapshot, error) {
 	scheduleEntitiesSnapShot, err := w.db.ScheduleEntitiesSnapshotRepo().Read(ctx)
 	var snapshot Snapshot
 	snapshot = &SnapshotV1{LastTimes: map[string]*time.Time{}}
@@ -84,5 +194,9 @@ func getSnapshoterMetrics(scope promutils.Scope) Metrics {
 			"count of unsuccessful attempts to save the created snapshot to the DB"),
 		SnapshotCreationErrCounter: scope.MustNewCounter("checkpoint_creation_error_counter",
 			"count of unsuccessful attempts to create the snapshot from the inmemory map"),
+		SnapshotPruneErrCounter: scope.MustNewCounter("checkpoint_prune_error_counter",
+			"count of unsuccessful attempts to prune the last fire times of inactive schedules from the DB"),
+		LastExecutionTimeSaveErrCounter: scope.MustNewCounter("last_execution_time_save_error_counter",
+			"count of unsuccessful attempts to save the last fire time of a schedule to the DB"),
 	}
 }
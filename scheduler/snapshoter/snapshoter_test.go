@@ -10,11 +10,13 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
 	adminModels "github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteadmin/scheduler/identifier"
 	schedMocks "github.com/flyteorg/flyteadmin/scheduler/repositories/mocks"
 	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
 	"github.com/flyteorg/flytestdlib/promutils"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 var (
@@ -26,6 +28,10 @@ func setupSnapShoter(scope string) Persistence {
 	return New(promutils.NewScope(scope), db)
 }
 
+func getMockLastFireRepo() *schedMocks.ScheduleLastFireRepoInterface {
+	return db.ScheduleLastFireRepo().(*schedMocks.ScheduleLastFireRepoInterface)
+}
+
 func TestSnapShoterRead(t *testing.T) {
 
 	t.Run("successful read", func(t *testing.T) {
@@ -38,6 +44,17 @@ func TestSnapShoterRead(t *testing.T) {
 		}
 		currTime := time.Now()
 		snapshot.LastTimes["schedule1"] = &currTime
+		// The launch plan of a migrated schedule is looked up from its key.
+		schedule := models.SchedulableEntity{
+			SchedulableEntityKey: models.SchedulableEntityKey{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "lp",
+				Version: "v1",
+			},
+		}
+		scheduleKey := identifier.GetScheduleName(context.Background(), schedule)
+		snapshot.LastTimes[scheduleKey] = &currTime
 		err := writer.WriteSnapshot(f, snapshot)
 		assert.Nil(t, err)
 
@@ -51,11 +68,76 @@ func TestSnapShoterRead(t *testing.T) {
 			Snapshot: f.Bytes(),
 		}
 		snapshotRepo.OnRead(context.Background()).Return(snapshotModel, nil)
+		// The v1 snapshot is migrated to per schedule rows.
+		db.SchedulableEntityRepo().(*schedMocks.SchedulableEntityRepoInterface).OnGetAll(context.Background()).Return(
+			[]models.SchedulableEntity{schedule}, nil)
+		lastFireRepo := getMockLastFireRepo()
+		lastFireRepo.OnGetAll(context.Background()).Return(nil, nil)
+		lastFireRepo.OnUpsertMatch(context.Background(), mock.MatchedBy(func(lastFire models.ScheduleLastFire) bool {
+			return lastFire.ScheduleKey == "schedule1" && lastFire.Project == "" && lastFire.LastFireTime.Equal(currTime)
+		})).Return(nil).Once()
+		lastFireRepo.OnUpsertMatch(context.Background(), mock.MatchedBy(func(lastFire models.ScheduleLastFire) bool {
+			return lastFire.ScheduleKey == scheduleKey && lastFire.Project == "project" &&
+				lastFire.Domain == "domain" && lastFire.Name == "lp" && lastFire.Version == "v1" &&
+				lastFire.LastFireTime.Equal(currTime)
+		})).Return(nil).Once()
+		// The v1 snapshot is deleted so that it isn't migrated again.
+		snapshotRepo.OnDeleteAll(context.Background()).Return(nil).Once()
 
 		reader := &VersionedSnapshot{}
 		snapshotVal, err := snapshoter.Read(context.Background(), reader)
 		assert.Nil(t, err)
-		assert.NotNil(t, snapshotVal)
+		assert.Equal(t, 2, snapshotVal.GetVersion())
+		assert.True(t, currTime.Equal(*snapshotVal.GetLastExecutionTime("schedule1")))
+		assert.True(t, currTime.Equal(*snapshotVal.GetLastExecutionTime(scheduleKey)))
+		lastFireRepo.AssertExpectations(t)
+		snapshotRepo.AssertExpectations(t)
+	})
+
+	t.Run("unsuccessful delete of the migrated snapshot", func(t *testing.T) {
+		snapshoter := setupSnapShoter("TestSnapShoterReadDeleteError")
+		var bytesArray []byte
+		f := bytes.NewBuffer(bytesArray)
+		currTime := time.Now()
+		assert.Nil(t, (&VersionedSnapshot{}).WriteSnapshot(f, &SnapshotV1{
+			LastTimes: map[string]*time.Time{"schedule1": &currTime},
+		}))
+		snapshotRepo := db.ScheduleEntitiesSnapshotRepo().(*schedMocks.ScheduleEntitiesSnapShotRepoInterface)
+		snapshotRepo.OnRead(context.Background()).Return(models.ScheduleEntitiesSnapshot{Snapshot: f.Bytes()}, nil)
+		snapshotRepo.OnDeleteAll(context.Background()).Return(errors.GetInvalidInputError("invalid input"))
+		db.SchedulableEntityRepo().(*schedMocks.SchedulableEntityRepoInterface).OnGetAll(context.Background()).Return(
+			nil, nil)
+		getMockLastFireRepo().OnGetAll(context.Background()).Return(nil, nil)
+		getMockLastFireRepo().OnUpsertMatch(context.Background(), mock.Anything).Return(nil)
+
+		_, err := snapshoter.Read(context.Background(), &VersionedSnapshot{})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("successful read of the last fire times", func(t *testing.T) {
+		snapshoter := setupSnapShoter("TestSnapShoterReadLastFireTimes")
+		currTime := time.Now()
+		getMockLastFireRepo().OnGetAll(context.Background()).Return([]models.ScheduleLastFire{
+			{ScheduleKey: "schedule1", LastFireTime: currTime},
+			{ScheduleKey: "schedule2", LastFireTime: currTime.Add(time.Minute)},
+		}, nil)
+
+		snapshotVal, err := snapshoter.Read(context.Background(), &VersionedSnapshot{})
+		assert.Nil(t, err)
+		assert.Equal(t, 2, snapshotVal.GetVersion())
+		assert.True(t, currTime.Equal(*snapshotVal.GetLastExecutionTime("schedule1")))
+		assert.True(t, currTime.Add(time.Minute).Equal(*snapshotVal.GetLastExecutionTime("schedule2")))
+		// The serialized snapshot is no longer read.
+		db.ScheduleEntitiesSnapshotRepo().(*schedMocks.ScheduleEntitiesSnapShotRepoInterface).AssertNotCalled(
+			t, "Read", mock.Anything)
+	})
+
+	t.Run("unsuccessful read of the last fire times", func(t *testing.T) {
+		snapshoter := setupSnapShoter("TestSnapShoterReadLastFireTimesError")
+		getMockLastFireRepo().OnGetAll(context.Background()).Return(nil, errors.GetInvalidInputError("invalid input"))
+
+		_, err := snapshoter.Read(context.Background(), &VersionedSnapshot{})
+		assert.NotNil(t, err)
 	})
 
 	t.Run("unsuccessful read ignore error", func(t *testing.T) {
@@ -63,6 +145,7 @@ func TestSnapShoterRead(t *testing.T) {
 		snapshotRepo := db.ScheduleEntitiesSnapshotRepo().(*schedMocks.ScheduleEntitiesSnapShotRepoInterface)
 
 		snapshotRepo.OnRead(context.Background()).Return(models.ScheduleEntitiesSnapshot{}, errors.GetSingletonMissingEntityError("schedule_entities_snapshots"))
+		getMockLastFireRepo().OnGetAll(context.Background()).Return(nil, nil)
 
 		reader := &VersionedSnapshot{}
 		snapshotVal, err := snapshoter.Read(context.Background(), reader)
@@ -75,6 +158,7 @@ func TestSnapShoterRead(t *testing.T) {
 		snapshotRepo := db.ScheduleEntitiesSnapshotRepo().(*schedMocks.ScheduleEntitiesSnapShotRepoInterface)
 
 		snapshotRepo.OnRead(context.Background()).Return(models.ScheduleEntitiesSnapshot{}, errors.GetInvalidInputError("invalid input"))
+		getMockLastFireRepo().OnGetAll(context.Background()).Return(nil, nil)
 
 		reader := &VersionedSnapshot{}
 		_, err := snapshoter.Read(context.Background(), reader)
@@ -108,3 +192,38 @@ func TestSnapShoterSave(t *testing.T) {
 
 	snapshoter.Save(context.Background(), writer, snapshot)
 }
+
+func TestSnapShoterSaveV2(t *testing.T) {
+	snapshoter := setupSnapShoter("TestSnapShoterSaveV2")
+	snapshot := (&SnapshotV2{}).Create()
+	currTime := time.Now()
+	snapshot.UpdateLastExecutionTime("schedule1", &currTime)
+	getMockLastFireRepo().OnPrune(context.Background(), []string{"schedule1"}).Return(nil)
+
+	// Only the rows of inactive schedules are pruned, the snapshot itself is not serialized.
+	snapshoter.Save(context.Background(), &VersionedSnapshot{}, snapshot)
+	getMockLastFireRepo().AssertExpectations(t)
+	db.ScheduleEntitiesSnapshotRepo().(*schedMocks.ScheduleEntitiesSnapShotRepoInterface).AssertNotCalled(
+		t, "Write", mock.Anything, mock.Anything)
+}
+
+func TestSnapShoterSaveLastExecutionTime(t *testing.T) {
+	snapshoter := setupSnapShoter("TestSnapShoterSaveLastExecutionTime")
+	schedule := models.SchedulableEntity{
+		SchedulableEntityKey: models.SchedulableEntityKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "lp",
+			Version: "v1",
+		},
+	}
+	currTime := time.Now()
+	getMockLastFireRepo().OnUpsertMatch(context.Background(), mock.MatchedBy(func(lastFire models.ScheduleLastFire) bool {
+		return lastFire.ScheduleKey == identifier.GetScheduleName(context.Background(), schedule) &&
+			lastFire.Project == "project" && lastFire.Name == "lp" && lastFire.Version == "v1" &&
+			lastFire.LastFireTime.Equal(currTime)
+	})).Return(nil).Once()
+
+	snapshoter.SaveLastExecutionTime(context.Background(), schedule, currTime)
+	getMockLastFireRepo().AssertExpectations(t)
+}
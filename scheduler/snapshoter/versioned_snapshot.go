@@ -39,5 +39,13 @@ func (s *VersionedSnapshot) ReadSnapshot(r io.Reader) (Snapshot, error) {
 		}
 		return &snapShotV1, nil
 	}
+	if s.Version == 2 {
+		snapShotV2 := SnapshotV2{SnapshotV1: SnapshotV1{LastTimes: map[string]*time.Time{}}}
+		err = snapShotV2.Deserialize(s.Ser)
+		if err != nil {
+			return nil, err
+		}
+		return &snapShotV2, nil
+	}
 	return nil, fmt.Errorf("unsupported version %v", s.Version)
 }
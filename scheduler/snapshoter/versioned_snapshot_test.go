@@ -28,6 +28,22 @@ func TestReadWriteSnapshot(t *testing.T) {
 		assert.NotNil(t, s.GetLastExecutionTime("schedule1"))
 	})
 
+	t.Run("successful read write v2", func(t *testing.T) {
+		var bytesArray []byte
+		f := bytes.NewBuffer(bytesArray)
+		writer := VersionedSnapshot{}
+		snapshot := (&SnapshotV2{}).Create()
+		currTime := time.Now()
+		snapshot.UpdateLastExecutionTime("schedule1", &currTime)
+		err := writer.WriteSnapshot(f, snapshot)
+		assert.Nil(t, err)
+		reader := VersionedSnapshot{}
+		s, err := reader.ReadSnapshot(bytes.NewReader(f.Bytes()))
+		assert.Nil(t, err)
+		assert.Equal(t, 2, s.GetVersion())
+		assert.NotNil(t, s.GetLastExecutionTime("schedule1"))
+	})
+
 	t.Run("successful write unsuccessful read", func(t *testing.T) {
 		var bytesArray []byte
 		f := bytes.NewBuffer(bytesArray)